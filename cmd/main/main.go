@@ -2,11 +2,27 @@ package main
 
 import (
 	"log/slog"
+	"os"
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/app"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		if err := app.RunImport(os.Args[2:]); err != nil {
+			slog.Error("Error importing movies. ", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "reconcile-stats" {
+		if err := app.RunReconcileStats(os.Args[2:]); err != nil {
+			slog.Error("Error reconciling stats. ", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	application, err := app.NewApp()
 	if err != nil {
 		slog.Error("Error creating application. ", "error", err)
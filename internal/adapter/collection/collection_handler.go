@@ -0,0 +1,241 @@
+package collection
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	collectionrequest "github.com/Vlad-Ali/Movies-service-back/internal/adapter/collection/request"
+	collectionresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/collection/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	collectiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/collection/object"
+	movieerror "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+)
+
+type CollectionHandler struct {
+	collectionService collectiondomain.Service
+}
+
+func NewCollectionHandler(collectionService collectiondomain.Service) *CollectionHandler {
+	return &CollectionHandler{collectionService: collectionService}
+}
+
+func (c *CollectionHandler) GetCollections(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("CollectionHandler.GetCollections called")
+
+	collections, err := c.collectionService.GetAll(r.Context())
+	if err != nil {
+		slog.Error("CollectionHandler.GetCollections error getting collections", "error", err)
+		http.Error(w, "Failed to get collections", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(collectionresponse.NewCollectionsResponse(collections)); err != nil {
+		slog.Error("CollectionHandler.GetCollections error encoding response", "error", err)
+		return
+	}
+}
+
+func (c *CollectionHandler) GetCollection(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("CollectionHandler.GetCollection called")
+
+	order, err := object.NewCollectionOrder(r.URL.Query().Get("order"))
+	if err != nil {
+		slog.Error("CollectionHandler.GetCollection error getting order", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	collection, err := c.collectionService.FindBySlug(r.Context(), r.PathValue("slug"), order)
+	if err != nil {
+		slog.Error("CollectionHandler.GetCollection error finding collection", "error", err)
+		writeCollectionError(w, err, "Failed to get collection")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(collectionresponse.NewCollectionResponse(collection)); err != nil {
+		slog.Error("CollectionHandler.GetCollection error encoding response", "error", err)
+		return
+	}
+}
+
+func (c *CollectionHandler) GetCompletion(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("CollectionHandler.GetCompletion called")
+
+	userID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("CollectionHandler.GetCompletion error extracting user id", "error", err)
+		http.Error(w, "Failed to get collection progress", http.StatusUnauthorized)
+		return
+	}
+
+	order, err := object.NewCollectionOrder(r.URL.Query().Get("order"))
+	if err != nil {
+		slog.Error("CollectionHandler.GetCompletion error getting order", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	completion, err := c.collectionService.FindCompletion(r.Context(), userID, r.PathValue("slug"), order)
+	if err != nil {
+		slog.Error("CollectionHandler.GetCompletion error finding completion", "error", err)
+		writeCollectionError(w, err, "Failed to get collection progress")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(collectionresponse.NewCompletionResponse(completion)); err != nil {
+		slog.Error("CollectionHandler.GetCompletion error encoding response", "error", err)
+		return
+	}
+}
+
+func (c *CollectionHandler) CreateCollection(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("CollectionHandler.CreateCollection called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("CollectionHandler.CreateCollection error extracting user id", "error", err)
+		http.Error(w, "Failed to create collection", http.StatusUnauthorized)
+		return
+	}
+
+	saveRequest, movies, ok := readSaveRequest(w, r, "CollectionHandler.CreateCollection", "Failed to create collection")
+	if !ok {
+		return
+	}
+
+	collection := collectiondomain.NewCollection(saveRequest.Name, saveRequest.Slug, saveRequest.Description)
+	collection, err = c.collectionService.CreateCollection(r.Context(), actorID, collection, movies)
+	if err != nil {
+		slog.Error("CollectionHandler.CreateCollection error creating collection", "error", err)
+		writeCollectionError(w, err, "Failed to create collection")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(collectionresponse.NewCollectionResponse(collection)); err != nil {
+		slog.Error("CollectionHandler.CreateCollection error encoding response", "error", err)
+		return
+	}
+}
+
+func (c *CollectionHandler) UpdateCollection(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("CollectionHandler.UpdateCollection called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("CollectionHandler.UpdateCollection error extracting user id", "error", err)
+		http.Error(w, "Failed to update collection", http.StatusUnauthorized)
+		return
+	}
+
+	saveRequest, movies, ok := readSaveRequest(w, r, "CollectionHandler.UpdateCollection", "Failed to update collection")
+	if !ok {
+		return
+	}
+
+	collection := collectiondomain.NewCollection(saveRequest.Name, saveRequest.Slug, saveRequest.Description)
+	collection, err = c.collectionService.UpdateCollection(r.Context(), actorID, r.PathValue("slug"), collection, movies)
+	if err != nil {
+		slog.Error("CollectionHandler.UpdateCollection error updating collection", "error", err)
+		writeCollectionError(w, err, "Failed to update collection")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(collectionresponse.NewCollectionResponse(collection)); err != nil {
+		slog.Error("CollectionHandler.UpdateCollection error encoding response", "error", err)
+		return
+	}
+}
+
+func (c *CollectionHandler) DeleteCollection(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("CollectionHandler.DeleteCollection called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("CollectionHandler.DeleteCollection error extracting user id", "error", err)
+		http.Error(w, "Failed to delete collection", http.StatusUnauthorized)
+		return
+	}
+
+	err = c.collectionService.DeleteCollection(r.Context(), actorID, r.PathValue("slug"))
+	if err != nil {
+		slog.Error("CollectionHandler.DeleteCollection error deleting collection", "error", err)
+		writeCollectionError(w, err, "Failed to delete collection")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write([]byte("Successfully deleted collection"))
+	if err != nil {
+		slog.Error("CollectionHandler.DeleteCollection error writing body", "error", err)
+		return
+	}
+}
+
+func readSaveRequest(w http.ResponseWriter, r *http.Request, op string, message string) (collectionrequest.SaveCollectionRequest, []movieobject.MovieRef, bool) {
+	var saveRequest collectionrequest.SaveCollectionRequest
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error(op+" error reading body", "error", err)
+		http.Error(w, message, http.StatusInternalServerError)
+		return saveRequest, nil, false
+	}
+	defer r.Body.Close()
+
+	err = json.Unmarshal(body, &saveRequest)
+	if err != nil {
+		slog.Error(op+" error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return saveRequest, nil, false
+	}
+
+	movies := make([]movieobject.MovieRef, 0, len(saveRequest.Movies))
+	for _, movieRequest := range saveRequest.Movies {
+		if movieRequest.MovieID == "" && movieRequest.MovieSlug == "" {
+			slog.Error(op + " movie reference is empty")
+			http.Error(w, "Invalid parameters", http.StatusBadRequest)
+			return saveRequest, nil, false
+		}
+		ref, err := movieobject.NewMovieRef(movieRequest.MovieID, movieRequest.MovieSlug, movieobject.MovieInfo{})
+		if err != nil {
+			slog.Error(op+" invalid movie reference", "error", err)
+			http.Error(w, "Invalid parameters", http.StatusBadRequest)
+			return saveRequest, nil, false
+		}
+		movies = append(movies, ref)
+	}
+	return saveRequest, movies, true
+}
+
+func writeCollectionError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, error2.ErrCollectionIsNotFound) {
+		http.Error(w, "Collection is not found", http.StatusNotFound)
+	} else if errors.Is(err, error2.ErrCollectionAlreadyExists) {
+		http.Error(w, "Collection with this slug already exists", http.StatusConflict)
+	} else if errors.Is(err, error2.ErrCollectionDataValidationFailed) {
+		http.Error(w, "Invalid collection data", http.StatusBadRequest)
+	} else if errors.Is(err, movieerror.ErrMovieIsNotFound) {
+		http.Error(w, "Movie is not found", http.StatusNotFound)
+	} else if errors.Is(err, usererror.ErrPermissionDenied) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	} else {
+		http.Error(w, message, http.StatusInternalServerError)
+	}
+}
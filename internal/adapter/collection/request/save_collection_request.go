@@ -0,0 +1,13 @@
+package collectionrequest
+
+type SaveCollectionRequest struct {
+	Name        string                   `json:"name"`
+	Slug        string                   `json:"slug"`
+	Description string                   `json:"description"`
+	Movies      []CollectionMovieRequest `json:"movies"`
+}
+
+type CollectionMovieRequest struct {
+	MovieID   string `json:"movie_id"`
+	MovieSlug string `json:"movie_slug"`
+}
@@ -0,0 +1,62 @@
+package collectionresponse
+
+import collectiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection"
+
+type CollectionItemResponse struct {
+	Position int     `json:"position"`
+	MovieID  string  `json:"movie_id"`
+	Slug     string  `json:"slug"`
+	Title    string  `json:"title"`
+	Year     int     `json:"year"`
+	Month    int     `json:"month"`
+	Day      int     `json:"day"`
+	Rating   float64 `json:"rating"`
+}
+
+type CollectionResponse struct {
+	ID          string                   `json:"id"`
+	Slug        string                   `json:"slug"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	MovieCount  int                      `json:"movie_count"`
+	Movies      []CollectionItemResponse `json:"movies,omitempty"`
+}
+
+type CollectionsResponse struct {
+	Collections []CollectionResponse `json:"collections"`
+}
+
+func NewCollectionItemResponse(item *collectiondomain.Item) CollectionItemResponse {
+	return CollectionItemResponse{
+		Position: item.Position,
+		MovieID:  item.MovieID.ID(),
+		Slug:     item.Slug,
+		Title:    item.Title,
+		Year:     item.ReleaseDate.Year(),
+		Month:    int(item.ReleaseDate.Month()),
+		Day:      item.ReleaseDate.Day(),
+		Rating:   item.Rating,
+	}
+}
+
+func NewCollectionResponse(collection *collectiondomain.Collection) CollectionResponse {
+	response := CollectionResponse{
+		ID:          collection.ID().ID(),
+		Slug:        collection.Slug,
+		Name:        collection.Name,
+		Description: collection.Description,
+		MovieCount:  collection.MovieCount,
+	}
+	for _, item := range collection.Items {
+		response.Movies = append(response.Movies, NewCollectionItemResponse(item))
+	}
+	return response
+}
+
+func NewCollectionsResponse(collections []*collectiondomain.Collection) CollectionsResponse {
+	response := CollectionsResponse{Collections: make([]CollectionResponse, 0, len(collections))}
+	for _, collection := range collections {
+		response.Collections = append(response.Collections, NewCollectionResponse(collection))
+	}
+	return response
+}
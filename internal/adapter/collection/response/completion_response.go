@@ -0,0 +1,48 @@
+package collectionresponse
+
+import collectiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection"
+
+type ItemProgressResponse struct {
+	CollectionItemResponse
+	UserRating int    `json:"user_rating"`
+	ListType   string `json:"list_type"`
+	Watched    bool   `json:"watched"`
+}
+
+type CompletionResponse struct {
+	ID      string                  `json:"id"`
+	Slug    string                  `json:"slug"`
+	Name    string                  `json:"name"`
+	Total   int                     `json:"total"`
+	Rated   int                     `json:"rated"`
+	Watched int                     `json:"watched"`
+	Percent float64                 `json:"percent"`
+	Next    *CollectionItemResponse `json:"next,omitempty"`
+	Movies  []ItemProgressResponse  `json:"movies"`
+}
+
+func NewCompletionResponse(completion *collectiondomain.Completion) CompletionResponse {
+	response := CompletionResponse{
+		ID:      completion.Collection.ID().ID(),
+		Slug:    completion.Collection.Slug,
+		Name:    completion.Collection.Name,
+		Total:   completion.Total,
+		Rated:   completion.Rated,
+		Watched: completion.Watched,
+		Percent: completion.Percent(),
+		Movies:  make([]ItemProgressResponse, 0, len(completion.Items)),
+	}
+	if completion.Next != nil {
+		next := NewCollectionItemResponse(completion.Next)
+		response.Next = &next
+	}
+	for _, progress := range completion.Items {
+		response.Movies = append(response.Movies, ItemProgressResponse{
+			CollectionItemResponse: NewCollectionItemResponse(progress.Item),
+			UserRating:             progress.Status.UserRating,
+			ListType:               string(progress.Status.ListType),
+			Watched:                progress.Status.IsWatched(),
+		})
+	}
+	return response
+}
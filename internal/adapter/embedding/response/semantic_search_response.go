@@ -0,0 +1,29 @@
+package embeddingresponse
+
+import (
+	movieresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/response"
+	embeddingdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/review"
+)
+
+type SemanticHitResponse struct {
+	Type       string                       `json:"type"`
+	Similarity float64                      `json:"similarity"`
+	Movie      *movieresponse.MovieResponse `json:"movie"`
+	Review     *review.ReviewInfo           `json:"review,omitempty"`
+}
+
+type SemanticSearchResponse struct {
+	Query   string                `json:"query"`
+	Model   string                `json:"model"`
+	Results []SemanticHitResponse `json:"results"`
+}
+
+func NewSemanticSearchResponse(result *embeddingdomain.SearchResult) SemanticSearchResponse {
+	response := SemanticSearchResponse{Query: result.Query, Model: result.Model, Results: make([]SemanticHitResponse, 0, len(result.Hits))}
+	for _, hit := range result.Hits {
+		movie := movieresponse.NewMovieResponse(hit.Movie)
+		response.Results = append(response.Results, SemanticHitResponse{Type: hit.EntityType.String(), Similarity: hit.Similarity, Movie: &movie, Review: hit.Review})
+	}
+	return response
+}
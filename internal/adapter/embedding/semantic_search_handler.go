@@ -0,0 +1,61 @@
+package embedding
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	embeddingresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/embedding/response"
+	embeddingdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/object"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type SemanticSearchHandler struct {
+	embeddingService   embeddingdomain.Service
+	translationService moviedomain.TranslationService
+}
+
+func NewSemanticSearchHandler(embeddingService embeddingdomain.Service, translationService moviedomain.TranslationService) *SemanticSearchHandler {
+	return &SemanticSearchHandler{embeddingService: embeddingService, translationService: translationService}
+}
+
+func (s *SemanticSearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SemanticSearchHandler.Search called")
+
+	query, err := object.GetSemanticQueryFromReq(r)
+	if err != nil {
+		slog.Error("SemanticSearchHandler.Search error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	result, err := s.embeddingService.Search(r.Context(), query)
+	if err != nil {
+		slog.Error("SemanticSearchHandler.Search error searching", "error", err)
+		if errors.Is(err, error2.ErrEmbedderIsNotConfigured) || errors.Is(err, error2.ErrEmbedderRequestFailed) {
+			http.Error(w, "Semantic search is unavailable", http.StatusServiceUnavailable)
+		} else {
+			http.Error(w, "Failed to search", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	err = s.translationService.Localize(r.Context(), movieobject.GetLocaleFromReq(r), moviedomain.LocalizableMovies(result.Movies())...)
+	if err != nil {
+		slog.Error("SemanticSearchHandler.Search error localizing movies", "error", err)
+		http.Error(w, "Failed to search", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Vary", "Accept-Language")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(embeddingresponse.NewSemanticSearchResponse(result)); err != nil {
+		slog.Error("SemanticSearchHandler.Search error encoding response", "error", err)
+		return
+	}
+}
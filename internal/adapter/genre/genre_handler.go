@@ -0,0 +1,211 @@
+package genre
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	genrerequest "github.com/Vlad-Ali/Movies-service-back/internal/adapter/genre/request"
+	genreresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/genre/response"
+	movieresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	genredomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre/error"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+)
+
+type GenreHandler struct {
+	genreService       genredomain.Service
+	movieService       moviedomain.Service
+	translationService moviedomain.TranslationService
+}
+
+func NewGenreHandler(genreService genredomain.Service, movieService moviedomain.Service, translationService moviedomain.TranslationService) *GenreHandler {
+	return &GenreHandler{genreService: genreService, movieService: movieService, translationService: translationService}
+}
+
+func (g *GenreHandler) GetGenres(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("GenreHandler.GetGenres called")
+
+	stats, err := g.genreService.GetAll(r.Context())
+	if err != nil {
+		slog.Error("GenreHandler.GetGenres error getting genres", "error", err)
+		http.Error(w, "Failed to get genres", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(genreresponse.NewGenresResponse(stats)); err != nil {
+		slog.Error("GenreHandler.GetGenres error encoding response", "error", err)
+		return
+	}
+}
+
+func (g *GenreHandler) GetGenreMovies(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("GenreHandler.GetGenreMovies called")
+
+	listQuery, err := object.GetMovieListQueryFromReq(r)
+	if err != nil {
+		slog.Error("GenreHandler.GetGenreMovies error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	genre, err := g.genreService.FindBySlug(r.Context(), r.PathValue("slug"))
+	if err != nil {
+		slog.Error("GenreHandler.GetGenreMovies error finding genre", "error", err)
+		writeGenreError(w, err, "Failed to get movies")
+		return
+	}
+	listQuery.Filter.Genre = genre.Slug
+
+	page, err := g.movieService.List(r.Context(), listQuery)
+	if err != nil {
+		slog.Error("GenreHandler.GetGenreMovies error finding movies", "error", err)
+		http.Error(w, "Failed to get movies", http.StatusInternalServerError)
+		return
+	}
+
+	err = g.translationService.Localize(r.Context(), object.GetLocaleFromReq(r), moviedomain.LocalizableMovies(page.Movies)...)
+	if err != nil {
+		slog.Error("GenreHandler.GetGenreMovies error localizing movies", "error", err)
+		http.Error(w, "Failed to get movies", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Vary", "Accept-Language")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewMoviesResponse(page, r.URL)); err != nil {
+		slog.Error("GenreHandler.GetGenreMovies error encoding response", "error", err)
+		return
+	}
+}
+
+func (g *GenreHandler) CreateGenre(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("GenreHandler.CreateGenre called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("GenreHandler.CreateGenre error extracting user id", "error", err)
+		http.Error(w, "Failed to create genre", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("GenreHandler.CreateGenre error reading body", "error", err)
+		http.Error(w, "Failed to create genre", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var saveRequest genrerequest.SaveGenreRequest
+	err = json.Unmarshal(body, &saveRequest)
+	if err != nil {
+		slog.Error("GenreHandler.CreateGenre error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	genre, err := g.genreService.CreateGenre(r.Context(), actorID, genredomain.NewGenre(saveRequest.Name, saveRequest.Slug))
+	if err != nil {
+		slog.Error("GenreHandler.CreateGenre error creating genre", "error", err)
+		writeGenreError(w, err, "Failed to create genre")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(genreresponse.NewGenreResponse(genre)); err != nil {
+		slog.Error("GenreHandler.CreateGenre error encoding response", "error", err)
+		return
+	}
+}
+
+func (g *GenreHandler) PatchGenre(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("GenreHandler.PatchGenre called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("GenreHandler.PatchGenre error extracting user id", "error", err)
+		http.Error(w, "Failed to update genre", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("GenreHandler.PatchGenre error reading body", "error", err)
+		http.Error(w, "Failed to update genre", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var patchRequest genrerequest.PatchGenreRequest
+	err = json.Unmarshal(body, &patchRequest)
+	if err != nil {
+		slog.Error("GenreHandler.PatchGenre error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	patch := genredomain.GenrePatch{Name: patchRequest.Name, Slug: patchRequest.Slug}
+	genre, err := g.genreService.UpdateGenre(r.Context(), actorID, r.PathValue("slug"), patch)
+	if err != nil {
+		slog.Error("GenreHandler.PatchGenre error updating genre", "error", err)
+		writeGenreError(w, err, "Failed to update genre")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(genreresponse.NewGenreResponse(genre)); err != nil {
+		slog.Error("GenreHandler.PatchGenre error encoding response", "error", err)
+		return
+	}
+}
+
+func (g *GenreHandler) DeleteGenre(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("GenreHandler.DeleteGenre called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("GenreHandler.DeleteGenre error extracting user id", "error", err)
+		http.Error(w, "Failed to delete genre", http.StatusUnauthorized)
+		return
+	}
+
+	err = g.genreService.DeleteGenre(r.Context(), actorID, r.PathValue("slug"))
+	if err != nil {
+		slog.Error("GenreHandler.DeleteGenre error deleting genre", "error", err)
+		writeGenreError(w, err, "Failed to delete genre")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write([]byte("Successfully deleted genre"))
+	if err != nil {
+		slog.Error("GenreHandler.DeleteGenre error writing body", "error", err)
+		return
+	}
+}
+
+func writeGenreError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, error2.ErrGenreIsNotFound) {
+		http.Error(w, "Genre is not found", http.StatusNotFound)
+	} else if errors.Is(err, error2.ErrGenreAlreadyExists) {
+		http.Error(w, "Genre with this name or slug already exists", http.StatusConflict)
+	} else if errors.Is(err, error2.ErrGenreDataValidationFailed) {
+		http.Error(w, "Invalid genre data", http.StatusBadRequest)
+	} else if errors.Is(err, usererror.ErrPermissionDenied) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	} else {
+		http.Error(w, message, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,6 @@
+package genrerequest
+
+type PatchGenreRequest struct {
+	Name *string `json:"name"`
+	Slug *string `json:"slug"`
+}
@@ -0,0 +1,6 @@
+package genrerequest
+
+type SaveGenreRequest struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
@@ -0,0 +1,35 @@
+package genreresponse
+
+import genredomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre"
+
+type GenreResponse struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+type GenreStatsResponse struct {
+	GenreResponse
+	MovieCount    int     `json:"movie_count"`
+	AverageRating float64 `json:"average_rating"`
+}
+
+type GenresResponse struct {
+	Genres []GenreStatsResponse `json:"genres"`
+}
+
+func NewGenreResponse(genre *genredomain.Genre) GenreResponse {
+	return GenreResponse{ID: genre.ID().ID(), Slug: genre.Slug, Name: genre.Name}
+}
+
+func NewGenresResponse(stats []*genredomain.GenreStats) GenresResponse {
+	response := GenresResponse{Genres: make([]GenreStatsResponse, 0, len(stats))}
+	for _, genreStats := range stats {
+		response.Genres = append(response.Genres, GenreStatsResponse{
+			GenreResponse: NewGenreResponse(genreStats.Genre),
+			MovieCount:    genreStats.MovieCount,
+			AverageRating: genreStats.AverageRating,
+		})
+	}
+	return response
+}
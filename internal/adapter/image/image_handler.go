@@ -0,0 +1,194 @@
+package image
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	imageresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/image/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	imagedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/image"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/image/object"
+	movieerror "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+)
+
+const imageCacheControl = "public, max-age=31536000, immutable"
+
+type ImageHandler struct {
+	imageService   imagedomain.Service
+	maxUploadBytes int64
+}
+
+func NewImageHandler(imageService imagedomain.Service, maxUploadBytes int64) *ImageHandler {
+	if maxUploadBytes <= 0 {
+		maxUploadBytes = imagedomain.DefaultMaxUploadBytes
+	}
+	return &ImageHandler{imageService: imageService, maxUploadBytes: maxUploadBytes}
+}
+
+func (i *ImageHandler) UploadMovieImage(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("ImageHandler.UploadMovieImage called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("ImageHandler.UploadMovieImage error extracting user id", "error", err)
+		http.Error(w, "Failed to upload image", http.StatusUnauthorized)
+		return
+	}
+
+	movieID, kind, err := getMovieImageParamsFromReq(r)
+	if err != nil {
+		slog.Error("ImageHandler.UploadMovieImage error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || imagedomain.ValidateContentType(contentType) != nil {
+		slog.Error("ImageHandler.UploadMovieImage unsupported content type", "contentType", r.Header.Get("Content-Type"))
+		http.Error(w, "Only image/jpeg and image/png are supported", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, i.maxUploadBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			slog.Error("ImageHandler.UploadMovieImage image is too large", "limit", maxBytesErr.Limit)
+			http.Error(w, "Image is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		slog.Error("ImageHandler.UploadMovieImage error reading body", "error", err)
+		http.Error(w, "Failed to upload image", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	image, err := i.imageService.UploadMovieImage(r.Context(), actorID, movieobject.NewMovieRefByID(movieID), kind, contentType, body)
+	if err != nil {
+		slog.Error("ImageHandler.UploadMovieImage error uploading image", "error", err)
+		writeImageError(w, err, "Failed to upload image")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(imageresponse.NewImageResponse(image)); err != nil {
+		slog.Error("ImageHandler.UploadMovieImage error encoding response", "error", err)
+		return
+	}
+}
+
+func (i *ImageHandler) DeleteMovieImage(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("ImageHandler.DeleteMovieImage called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("ImageHandler.DeleteMovieImage error extracting user id", "error", err)
+		http.Error(w, "Failed to delete image", http.StatusUnauthorized)
+		return
+	}
+
+	movieID, kind, err := getMovieImageParamsFromReq(r)
+	if err != nil {
+		slog.Error("ImageHandler.DeleteMovieImage error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	err = i.imageService.DeleteMovieImage(r.Context(), actorID, movieobject.NewMovieRefByID(movieID), kind)
+	if err != nil {
+		slog.Error("ImageHandler.DeleteMovieImage error deleting image", "error", err)
+		writeImageError(w, err, "Failed to delete image")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write([]byte("Successfully deleted image"))
+	if err != nil {
+		slog.Error("ImageHandler.DeleteMovieImage error writing body", "error", err)
+		return
+	}
+}
+
+func (i *ImageHandler) GetImage(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("ImageHandler.GetImage called")
+
+	imageID, err := object.NewImageID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("ImageHandler.GetImage error getting image id", "error", err)
+		http.Error(w, "Invalid image id", http.StatusBadRequest)
+		return
+	}
+	variant, err := object.NewVariant(r.PathValue("variant"))
+	if err != nil {
+		slog.Error("ImageHandler.GetImage error getting variant", "error", err)
+		http.Error(w, "Invalid image variant", http.StatusBadRequest)
+		return
+	}
+
+	etag := `"` + imageID.ID() + "-" + variant.String() + `"`
+	if r.Header.Get("If-None-Match") == etag {
+		w.Header().Set("Cache-Control", imageCacheControl)
+		w.Header().Set("ETag", etag)
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	image, blob, err := i.imageService.OpenVariant(r.Context(), imageID, variant)
+	if err != nil {
+		slog.Error("ImageHandler.GetImage error opening image", "error", err)
+		writeImageError(w, err, "Failed to get image")
+		return
+	}
+	defer blob.Body.Close()
+
+	w.Header().Set("Content-Type", image.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(blob.Size, 10))
+	w.Header().Set("Cache-Control", imageCacheControl)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Last-Modified", image.CreatedAt.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(w, blob.Body); err != nil {
+		slog.Error("ImageHandler.GetImage error writing body", "error", err)
+		return
+	}
+}
+
+func getMovieImageParamsFromReq(r *http.Request) (movieobject.MovieID, object.Kind, error) {
+	movieID, err := movieobject.NewMovieID(r.PathValue("id"))
+	if err != nil {
+		return movieobject.MovieID{}, "", err
+	}
+	kind, err := object.NewKind(r.PathValue("kind"))
+	if err != nil {
+		return movieobject.MovieID{}, "", err
+	}
+	return movieID, kind, nil
+}
+
+func writeImageError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, error2.ErrImageIsNotFound) {
+		http.Error(w, "Image is not found", http.StatusNotFound)
+	} else if errors.Is(err, movieerror.ErrMovieIsNotFound) {
+		http.Error(w, "Movie is not found", http.StatusNotFound)
+	} else if errors.Is(err, error2.ErrImageIsTooLarge) {
+		http.Error(w, "Image is too large", http.StatusRequestEntityTooLarge)
+	} else if errors.Is(err, error2.ErrImageContentTypeIsNotAllowed) {
+		http.Error(w, "Only image/jpeg and image/png are supported", http.StatusUnsupportedMediaType)
+	} else if errors.Is(err, error2.ErrImageIsNotValid) {
+		http.Error(w, "Invalid image", http.StatusBadRequest)
+	} else if errors.Is(err, usererror.ErrPermissionDenied) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	} else {
+		http.Error(w, message, http.StatusInternalServerError)
+	}
+}
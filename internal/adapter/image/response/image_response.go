@@ -0,0 +1,30 @@
+package imageresponse
+
+import (
+	movieresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/response"
+	imagedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/image"
+)
+
+type ImageResponse struct {
+	ID          string                       `json:"id"`
+	MovieID     string                       `json:"movie_id"`
+	Kind        string                       `json:"kind"`
+	ContentType string                       `json:"content_type"`
+	Width       int                          `json:"width"`
+	Height      int                          `json:"height"`
+	SizeBytes   int64                        `json:"size_bytes"`
+	URLs        *movieresponse.ImageResponse `json:"urls"`
+}
+
+func NewImageResponse(image *imagedomain.Image) ImageResponse {
+	return ImageResponse{
+		ID:          image.ID().ID(),
+		MovieID:     image.MovieID.ID(),
+		Kind:        image.Kind.String(),
+		ContentType: image.ContentType,
+		Width:       image.Width,
+		Height:      image.Height,
+		SizeBytes:   image.SizeBytes,
+		URLs:        movieresponse.NewImageResponse(image.ID()),
+	}
+}
@@ -0,0 +1,31 @@
+package jwks
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	jwksresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/jwks/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+)
+
+type JWKSHandler struct {
+	tokenService user.TokenService
+}
+
+func NewJWKSHandler(tokenService user.TokenService) *JWKSHandler {
+	return &JWKSHandler{tokenService: tokenService}
+}
+
+func (j *JWKSHandler) GetKeys(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("JWKSHandler.GetKeys called")
+	response := jwksresponse.NewJWKSResponse(j.tokenService.PublicKeys(r.Context()))
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		slog.Error("Error encoding response", "error", err)
+		return
+	}
+}
@@ -0,0 +1,46 @@
+package jwksresponse
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type JWKResponse struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKSResponse struct {
+	Keys []JWKResponse `json:"keys"`
+}
+
+func NewJWKSResponse(keys []object.SigningKey) JWKSResponse {
+	response := JWKSResponse{Keys: make([]JWKResponse, 0, len(keys))}
+	for _, key := range keys {
+		jwk := JWKResponse{Kid: key.ID, Use: "sig", Alg: key.Algorithm}
+		switch publicKey := key.Public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		default:
+			continue
+		}
+		response.Keys = append(response.Keys, jwk)
+	}
+	return response
+}
@@ -2,11 +2,14 @@ package middleware
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type AuthMiddleware struct {
@@ -32,15 +35,35 @@ func (am *AuthMiddleware) Authorize(next http.Handler) http.Handler {
 		token := parts[1]
 
 		if token != "" {
-			userID, err := am.tokenService.ValidateToken(r.Context(), token)
+			claims, err := am.tokenService.ValidateToken(r.Context(), token)
 			if err != nil {
 				http.Error(w, "Invalid token", http.StatusUnauthorized)
 				return
 			}
-			ctx := context.WithValue(r.Context(), useridkey.UserIDKey{}, userID.ID())
+			ctx := context.WithValue(r.Context(), useridkey.UserIDKey{}, claims.UserID().ID())
+			ctx = context.WithValue(ctx, useridkey.UserRoleKey{}, claims.Role())
+			ctx = context.WithValue(ctx, useridkey.TokenClaimsKey{}, claims)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		} else {
 			next.ServeHTTP(w, r)
 		}
 	})
 }
+
+func (am *AuthMiddleware) RequireRole(roles ...object.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role, ok := useridkey.ExtractUserRoleFromReq(r)
+			if !ok {
+				http.Error(w, "Authorization required", http.StatusUnauthorized)
+				return
+			}
+			if !slices.Contains(roles, role) {
+				slog.Error("AuthMiddleware.RequireRole access denied", "role", role, "path", r.URL.Path)
+				http.Error(w, "Forbidden", http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
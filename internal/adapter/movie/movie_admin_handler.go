@@ -0,0 +1,338 @@
+package movie
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	movierequest "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/request"
+	movieresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	genreerror "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre/error"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	personerror "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/error"
+	personobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+)
+
+func (m *MovieHandler) CreateMovie(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieHandler.CreateMovie called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("MovieHandler.CreateMovie error extracting user id", "error", err)
+		http.Error(w, "Failed to create movie", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("MovieHandler.CreateMovie error reading body", "error", err)
+		http.Error(w, "Failed to create movie", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var saveRequest movierequest.SaveMovieRequest
+	err = json.Unmarshal(body, &saveRequest)
+	if err != nil {
+		slog.Error("MovieHandler.CreateMovie error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	movie, err := movieFromSaveRequest(saveRequest)
+	if err != nil {
+		slog.Error("MovieHandler.CreateMovie invalid movie data", "error", err)
+		http.Error(w, "Invalid movie data", http.StatusBadRequest)
+		return
+	}
+
+	movie, err = m.movieService.CreateMovie(r.Context(), actorID, movie)
+	if err != nil {
+		slog.Error("MovieHandler.CreateMovie error creating movie", "error", err)
+		writeMovieAdminError(w, err, "Failed to create movie")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewMovieResponse(movie)); err != nil {
+		slog.Error("MovieHandler.CreateMovie error encoding response", "error", err)
+		return
+	}
+}
+
+func (m *MovieHandler) UpdateMovie(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieHandler.UpdateMovie called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("MovieHandler.UpdateMovie error extracting user id", "error", err)
+		http.Error(w, "Failed to update movie", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("MovieHandler.UpdateMovie error reading body", "error", err)
+		http.Error(w, "Failed to update movie", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var updateRequest movierequest.UpdateMovieRequest
+	err = json.Unmarshal(body, &updateRequest)
+	if err != nil {
+		slog.Error("MovieHandler.UpdateMovie error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	movie, err := movieFromSaveRequest(updateRequest.SaveMovieRequest)
+	if err != nil {
+		slog.Error("MovieHandler.UpdateMovie invalid movie data", "error", err)
+		http.Error(w, "Invalid movie data", http.StatusBadRequest)
+		return
+	}
+
+	movieRef, err := object.NewMovieRef(updateRequest.MovieID, updateRequest.MovieSlug, updateRequest.MovieInfo)
+	if err != nil {
+		slog.Error("MovieHandler.UpdateMovie invalid movie reference", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	movie, err = m.movieService.UpdateMovie(r.Context(), actorID, movieRef, movie)
+	if err != nil {
+		slog.Error("MovieHandler.UpdateMovie error updating movie", "error", err)
+		writeMovieAdminError(w, err, "Failed to update movie")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewMovieResponse(movie)); err != nil {
+		slog.Error("MovieHandler.UpdateMovie error encoding response", "error", err)
+		return
+	}
+}
+
+func (m *MovieHandler) PatchMovie(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieHandler.PatchMovie called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("MovieHandler.PatchMovie error extracting user id", "error", err)
+		http.Error(w, "Failed to patch movie", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("MovieHandler.PatchMovie error reading body", "error", err)
+		http.Error(w, "Failed to patch movie", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var patchRequest movierequest.PatchMovieRequest
+	err = json.Unmarshal(body, &patchRequest)
+	if err != nil {
+		slog.Error("MovieHandler.PatchMovie error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	patch, err := patchFromRequest(patchRequest)
+	if err != nil {
+		slog.Error("MovieHandler.PatchMovie invalid movie data", "error", err)
+		http.Error(w, "Invalid movie data", http.StatusBadRequest)
+		return
+	}
+
+	movieRef, err := object.NewMovieRef(patchRequest.MovieID, patchRequest.MovieSlug, patchRequest.MovieInfo)
+	if err != nil {
+		slog.Error("MovieHandler.PatchMovie invalid movie reference", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	movie, err := m.movieService.PatchMovie(r.Context(), actorID, movieRef, patch)
+	if err != nil {
+		slog.Error("MovieHandler.PatchMovie error patching movie", "error", err)
+		writeMovieAdminError(w, err, "Failed to patch movie")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewMovieResponse(movie)); err != nil {
+		slog.Error("MovieHandler.PatchMovie error encoding response", "error", err)
+		return
+	}
+}
+
+func (m *MovieHandler) DeleteMovie(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieHandler.DeleteMovie called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("MovieHandler.DeleteMovie error extracting user id", "error", err)
+		http.Error(w, "Failed to delete movie", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("MovieHandler.DeleteMovie error reading body", "error", err)
+		http.Error(w, "Failed to delete movie", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var deleteRequest movierequest.DeleteMovieRequest
+	err = json.Unmarshal(body, &deleteRequest)
+	if err != nil {
+		slog.Error("MovieHandler.DeleteMovie error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	movieRef, err := object.NewMovieRef(deleteRequest.MovieID, deleteRequest.MovieSlug, deleteRequest.MovieInfo)
+	if err != nil {
+		slog.Error("MovieHandler.DeleteMovie invalid movie reference", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	err = m.movieService.DeleteMovie(r.Context(), actorID, movieRef)
+	if err != nil {
+		slog.Error("MovieHandler.DeleteMovie error deleting movie", "error", err)
+		writeMovieAdminError(w, err, "Failed to delete movie")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write([]byte("Successfully deleted movie"))
+	if err != nil {
+		slog.Error("MovieHandler.DeleteMovie error writing body", "error", err)
+		return
+	}
+}
+
+func movieFromSaveRequest(request movierequest.SaveMovieRequest) (*moviedomain.Movie, error) {
+	releaseDate, err := moviedomain.NewReleaseDate(request.Year, request.Month, request.Day)
+	if err != nil {
+		return nil, err
+	}
+	movie := moviedomain.NewMovie(request.Title, request.Description, releaseDate, request.Director, request.Actors, request.Genres, 0)
+	movie.ApplyPatch(moviedomain.MoviePatch{
+		RuntimeMinutes: &request.RuntimeMinutes,
+		Countries:      &request.Countries,
+		Languages:      &request.Languages,
+		PosterURL:      &request.PosterURL,
+		ExternalIDs:    &request.ExternalIDs,
+
+		OriginalLanguage: &request.OriginalLanguage,
+		Certifications:   &request.Certifications,
+		Budget:           &request.Budget,
+		BoxOffice:        &request.BoxOffice,
+		Tagline:          &request.Tagline,
+	})
+	if len(request.Credits) > 0 {
+		credits, err := creditsFromRequest(request.Credits)
+		if err != nil {
+			return nil, err
+		}
+		movie.SetCredits(credits)
+	}
+	return movie, nil
+}
+
+func creditsFromRequest(requests []movierequest.CreditRequest) ([]*persondomain.Credit, error) {
+	credits := make([]*persondomain.Credit, 0, len(requests))
+	for _, request := range requests {
+		var personID personobject.PersonID
+		if request.PersonID != "" {
+			var err error
+			personID, err = personobject.NewPersonID(request.PersonID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		role, err := personobject.NewCreditRole(request.Role)
+		if err != nil {
+			return nil, err
+		}
+		credits = append(credits, persondomain.NewCredit(personID, request.Name, role, request.Character))
+	}
+	return credits, nil
+}
+
+func patchFromRequest(request movierequest.PatchMovieRequest) (moviedomain.MoviePatch, error) {
+	patch := moviedomain.MoviePatch{
+		Title:       request.Title,
+		Description: request.Description,
+		Director:    request.Director,
+		Actors:      request.Actors,
+		Genres:      request.Genres,
+
+		RuntimeMinutes: request.RuntimeMinutes,
+		Countries:      request.Countries,
+		Languages:      request.Languages,
+		PosterURL:      request.PosterURL,
+		ExternalIDs:    request.ExternalIDs,
+
+		OriginalLanguage: request.OriginalLanguage,
+		Certifications:   request.Certifications,
+		Budget:           request.Budget,
+		BoxOffice:        request.BoxOffice,
+		Tagline:          request.Tagline,
+	}
+	if request.Credits != nil {
+		credits, err := creditsFromRequest(*request.Credits)
+		if err != nil {
+			return patch, err
+		}
+		patch.Credits = &credits
+	}
+	if request.Year == nil && request.Month == nil && request.Day == nil {
+		return patch, nil
+	}
+	if request.Year == nil || request.Month == nil || request.Day == nil {
+		return patch, error2.ErrMovieDataValidationFailed
+	}
+	releaseDate, err := moviedomain.NewReleaseDate(*request.Year, *request.Month, *request.Day)
+	if err != nil {
+		return patch, err
+	}
+	patch.ReleaseDate = &releaseDate
+	return patch, nil
+}
+
+func writeMovieAdminError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, error2.ErrMovieIsNotFound) {
+		http.Error(w, "Movie is not found", http.StatusNotFound)
+	} else if errors.Is(err, error2.ErrMovieAlreadyExists) {
+		http.Error(w, "Movie with this title and release date already exists", http.StatusConflict)
+	} else if errors.Is(err, error2.ErrMovieDataValidationFailed) {
+		http.Error(w, "Invalid movie data", http.StatusBadRequest)
+	} else if errors.Is(err, genreerror.ErrGenreIsNotFound) {
+		http.Error(w, "Unknown genre", http.StatusBadRequest)
+	} else if errors.Is(err, personerror.ErrPersonIsNotFound) {
+		http.Error(w, "Person is not found", http.StatusNotFound)
+	} else if errors.Is(err, personerror.ErrPersonNameIsAmbiguous) {
+		http.Error(w, "Several people have this name, use person_id", http.StatusBadRequest)
+	} else if errors.Is(err, usererror.ErrPermissionDenied) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	} else {
+		http.Error(w, message, http.StatusInternalServerError)
+	}
+}
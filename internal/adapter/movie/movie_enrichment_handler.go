@@ -0,0 +1,62 @@
+package movie
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	movieresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type MovieEnrichmentHandler struct {
+	enrichmentService moviedomain.EnrichmentService
+}
+
+func NewMovieEnrichmentHandler(enrichmentService moviedomain.EnrichmentService) *MovieEnrichmentHandler {
+	return &MovieEnrichmentHandler{enrichmentService: enrichmentService}
+}
+
+func (m *MovieEnrichmentHandler) EnrichMovie(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieEnrichmentHandler.EnrichMovie called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("MovieEnrichmentHandler.EnrichMovie error extracting user id", "error", err)
+		http.Error(w, "Failed to enrich movie", http.StatusUnauthorized)
+		return
+	}
+
+	movieID, err := object.NewMovieID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("MovieEnrichmentHandler.EnrichMovie error getting movie id", "error", err)
+		http.Error(w, "Invalid movie id", http.StatusBadRequest)
+		return
+	}
+
+	movie, err := m.enrichmentService.EnrichMovie(r.Context(), actorID, object.NewMovieRefByID(movieID))
+	if err != nil {
+		slog.Error("MovieEnrichmentHandler.EnrichMovie error enriching movie", "error", err)
+		if errors.Is(err, error2.ErrMetadataIsNotFound) {
+			http.Error(w, "Metadata for this movie is not found", http.StatusNotFound)
+		} else if errors.Is(err, error2.ErrMetadataProviderIsNotConfigured) {
+			http.Error(w, "Metadata provider is not configured", http.StatusServiceUnavailable)
+		} else if errors.Is(err, error2.ErrMetadataProviderRequestFailed) {
+			http.Error(w, "Metadata provider request failed", http.StatusBadGateway)
+		} else {
+			writeMovieAdminError(w, err, "Failed to enrich movie")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewMovieResponse(movie)); err != nil {
+		slog.Error("MovieEnrichmentHandler.EnrichMovie error encoding response", "error", err)
+		return
+	}
+}
@@ -13,24 +13,55 @@ import (
 )
 
 type MovieHandler struct {
-	movieService moviedomain.Service
+	movieService       moviedomain.Service
+	translationService moviedomain.TranslationService
 }
 
-func NewMovieHandler(movieService moviedomain.Service) *MovieHandler {
-	return &MovieHandler{movieService}
+func NewMovieHandler(movieService moviedomain.Service, translationService moviedomain.TranslationService) *MovieHandler {
+	return &MovieHandler{movieService: movieService, translationService: translationService}
 }
 
 func (m *MovieHandler) GetMovie(w http.ResponseWriter, r *http.Request) {
 	slog.Debug("MovieHandler.GetMovie called")
 
-	movieInfo, err := object.GetMovieInfoFromReq(r)
+	movieRef, err := object.GetMovieRefFromReq(r)
 	if err != nil {
 		slog.Error("MovieHandler.GetMovie error getting parameters", slog.String("err", err.Error()))
 		http.Error(w, "Invalid parameters", http.StatusBadRequest)
 		return
 	}
 
-	movie, err := m.movieService.FindByReleaseDateAndTitle(r.Context(), movieInfo)
+	m.writeMovie(w, r, movieRef)
+}
+
+func (m *MovieHandler) GetMovieByID(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieHandler.GetMovieByID called")
+
+	movieID, err := object.NewMovieID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("MovieHandler.GetMovieByID error getting movie id", slog.String("err", err.Error()))
+		http.Error(w, "Invalid movie id", http.StatusBadRequest)
+		return
+	}
+
+	m.writeMovie(w, r, object.NewMovieRefByID(movieID))
+}
+
+func (m *MovieHandler) GetMovieBySlug(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieHandler.GetMovieBySlug called")
+
+	slug := r.PathValue("slug")
+	if slug == "" {
+		slog.Error("MovieHandler.GetMovieBySlug empty slug")
+		http.Error(w, "Invalid movie slug", http.StatusBadRequest)
+		return
+	}
+
+	m.writeMovie(w, r, object.NewMovieRefBySlug(slug))
+}
+
+func (m *MovieHandler) writeMovie(w http.ResponseWriter, r *http.Request, movieRef object.MovieRef) {
+	movie, err := m.movieService.FindByRef(r.Context(), movieRef)
 	if err != nil {
 		slog.Error("MovieHandler.GetMovie Error finding movie", slog.String("err", err.Error()))
 		if errors.Is(err, error2.ErrMovieIsNotFound) {
@@ -40,7 +71,19 @@ func (m *MovieHandler) GetMovie(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+
+	err = m.translationService.Localize(r.Context(), object.GetLocaleFromReq(r), movie)
+	if err != nil {
+		slog.Error("MovieHandler.GetMovie Error localizing movie", slog.String("err", err.Error()))
+		http.Error(w, "Failed to get movie", http.StatusInternalServerError)
+		return
+	}
+	if movie.Language != "" {
+		w.Header().Set("Content-Language", movie.Language.String())
+	}
+
 	movieResponse := movieresponse.NewMovieResponse(movie)
+	w.Header().Set("Vary", "Accept-Language")
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err = json.NewEncoder(w).Encode(movieResponse); err != nil {
@@ -52,19 +95,30 @@ func (m *MovieHandler) GetMovie(w http.ResponseWriter, r *http.Request) {
 func (m *MovieHandler) GetMovies(w http.ResponseWriter, r *http.Request) {
 	slog.Debug("MovieHandler.GetMovies called")
 
-	movies, err := m.movieService.GetAll(r.Context())
+	listQuery, err := object.GetMovieListQueryFromReq(r)
+	if err != nil {
+		slog.Error("MovieHandler.GetMovies error getting parameters", slog.String("err", err.Error()))
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	page, err := m.movieService.List(r.Context(), listQuery)
 	if err != nil {
 		slog.Error("MovieHandler.GetMovies Error finding movies", slog.String("err", err.Error()))
 		http.Error(w, "Failed to get movies", http.StatusInternalServerError)
 		return
 	}
 
-	moviesResponse := movieresponse.MoviesResponse{Movies: make([]movieresponse.MovieResponse, 0)}
-	for _, movie := range movies {
-		movieResponse := movieresponse.NewMovieResponse(movie)
-		moviesResponse.Movies = append(moviesResponse.Movies, movieResponse)
+	err = m.translationService.Localize(r.Context(), object.GetLocaleFromReq(r), moviedomain.LocalizableMovies(page.Movies)...)
+	if err != nil {
+		slog.Error("MovieHandler.GetMovies Error localizing movies", slog.String("err", err.Error()))
+		http.Error(w, "Failed to get movies", http.StatusInternalServerError)
+		return
 	}
 
+	moviesResponse := movieresponse.NewMoviesResponse(page, r.URL)
+
+	w.Header().Set("Vary", "Accept-Language")
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err = json.NewEncoder(w).Encode(moviesResponse); err != nil {
@@ -72,3 +126,36 @@ func (m *MovieHandler) GetMovies(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+func (m *MovieHandler) SearchMovies(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieHandler.SearchMovies called")
+
+	searchQuery, err := object.GetMovieSearchQueryFromReq(r)
+	if err != nil {
+		slog.Error("MovieHandler.SearchMovies error getting parameters", slog.String("err", err.Error()))
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	result, err := m.movieService.Search(r.Context(), searchQuery)
+	if err != nil {
+		slog.Error("MovieHandler.SearchMovies Error searching movies", slog.String("err", err.Error()))
+		http.Error(w, "Failed to search movies", http.StatusInternalServerError)
+		return
+	}
+
+	err = m.translationService.Localize(r.Context(), object.GetLocaleFromReq(r), result.Localizable()...)
+	if err != nil {
+		slog.Error("MovieHandler.SearchMovies Error localizing movies", slog.String("err", err.Error()))
+		http.Error(w, "Failed to search movies", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Vary", "Accept-Language")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewMovieSearchResponse(result)); err != nil {
+		slog.Error("MovieHandler.SearchMovies Error encoding response", slog.String("err", err.Error()))
+		return
+	}
+}
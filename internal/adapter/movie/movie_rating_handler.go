@@ -0,0 +1,78 @@
+package movie
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	movieresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/response"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type MovieRatingHandler struct {
+	ratingService      moviedomain.RatingService
+	translationService moviedomain.TranslationService
+}
+
+func NewMovieRatingHandler(ratingService moviedomain.RatingService, translationService moviedomain.TranslationService) *MovieRatingHandler {
+	return &MovieRatingHandler{ratingService: ratingService, translationService: translationService}
+}
+
+func (m *MovieRatingHandler) GetRatings(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieRatingHandler.GetRatings called")
+
+	movieID, err := object.NewMovieID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("MovieRatingHandler.GetRatings error getting movie id", "error", err)
+		http.Error(w, "Invalid movie id", http.StatusBadRequest)
+		return
+	}
+
+	summary, err := m.ratingService.GetRatings(r.Context(), object.NewMovieRefByID(movieID))
+	if err != nil {
+		slog.Error("MovieRatingHandler.GetRatings error getting ratings", "error", err)
+		writeMovieAdminError(w, err, "Failed to get ratings")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewRatingsResponse(summary)); err != nil {
+		slog.Error("MovieRatingHandler.GetRatings error encoding response", "error", err)
+		return
+	}
+}
+
+func (m *MovieRatingHandler) GetTopRated(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieRatingHandler.GetTopRated called")
+
+	query, err := object.GetTopRatedQueryFromReq(r)
+	if err != nil {
+		slog.Error("MovieRatingHandler.GetTopRated error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	movies, err := m.ratingService.TopRated(r.Context(), query)
+	if err != nil {
+		slog.Error("MovieRatingHandler.GetTopRated error getting movies", "error", err)
+		http.Error(w, "Failed to get top rated movies", http.StatusInternalServerError)
+		return
+	}
+
+	err = m.translationService.Localize(r.Context(), object.GetLocaleFromReq(r), moviedomain.LocalizableMovies(movies)...)
+	if err != nil {
+		slog.Error("MovieRatingHandler.GetTopRated error localizing movies", "error", err)
+		http.Error(w, "Failed to get top rated movies", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Vary", "Accept-Language")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewTopRatedResponse(movies)); err != nil {
+		slog.Error("MovieRatingHandler.GetTopRated error encoding response", "error", err)
+		return
+	}
+}
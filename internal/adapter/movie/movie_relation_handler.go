@@ -0,0 +1,130 @@
+package movie
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	movierequest "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/request"
+	movieresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+func (m *MovieHandler) SaveRelatedMovie(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieHandler.SaveRelatedMovie called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("MovieHandler.SaveRelatedMovie error extracting user id", "error", err)
+		http.Error(w, "Failed to save related movie", http.StatusUnauthorized)
+		return
+	}
+
+	movieID, err := object.NewMovieID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("MovieHandler.SaveRelatedMovie error getting movie id", "error", err)
+		http.Error(w, "Invalid movie id", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("MovieHandler.SaveRelatedMovie error reading body", "error", err)
+		http.Error(w, "Failed to save related movie", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var saveRequest movierequest.SaveRelatedMovieRequest
+	err = json.Unmarshal(body, &saveRequest)
+	if err != nil {
+		slog.Error("MovieHandler.SaveRelatedMovie error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	relationType, err := object.NewRelationType(saveRequest.Type)
+	if err != nil {
+		slog.Error("MovieHandler.SaveRelatedMovie invalid relation type", "error", err)
+		http.Error(w, "Invalid relation type", http.StatusBadRequest)
+		return
+	}
+
+	if saveRequest.RelatedMovieID == "" && saveRequest.RelatedMovieSlug == "" {
+		slog.Error("MovieHandler.SaveRelatedMovie related movie is not set")
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+	relatedRef, err := object.NewMovieRef(saveRequest.RelatedMovieID, saveRequest.RelatedMovieSlug, object.MovieInfo{})
+	if err != nil {
+		slog.Error("MovieHandler.SaveRelatedMovie invalid related movie reference", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	movie, err := m.movieService.SaveRelatedMovie(r.Context(), actorID, object.NewMovieRefByID(movieID), relatedRef, relationType)
+	if err != nil {
+		slog.Error("MovieHandler.SaveRelatedMovie error saving related movie", "error", err)
+		writeMovieRelationError(w, err, "Failed to save related movie")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewMovieResponse(movie)); err != nil {
+		slog.Error("MovieHandler.SaveRelatedMovie error encoding response", "error", err)
+		return
+	}
+}
+
+func (m *MovieHandler) DeleteRelatedMovie(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieHandler.DeleteRelatedMovie called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("MovieHandler.DeleteRelatedMovie error extracting user id", "error", err)
+		http.Error(w, "Failed to delete related movie", http.StatusUnauthorized)
+		return
+	}
+
+	movieID, err := object.NewMovieID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("MovieHandler.DeleteRelatedMovie error getting movie id", "error", err)
+		http.Error(w, "Invalid movie id", http.StatusBadRequest)
+		return
+	}
+	relatedID, err := object.NewMovieID(r.PathValue("related"))
+	if err != nil {
+		slog.Error("MovieHandler.DeleteRelatedMovie error getting related movie id", "error", err)
+		http.Error(w, "Invalid movie id", http.StatusBadRequest)
+		return
+	}
+
+	movie, err := m.movieService.DeleteRelatedMovie(r.Context(), actorID, object.NewMovieRefByID(movieID), object.NewMovieRefByID(relatedID))
+	if err != nil {
+		slog.Error("MovieHandler.DeleteRelatedMovie error deleting related movie", "error", err)
+		writeMovieRelationError(w, err, "Failed to delete related movie")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewMovieResponse(movie)); err != nil {
+		slog.Error("MovieHandler.DeleteRelatedMovie error encoding response", "error", err)
+		return
+	}
+}
+
+func writeMovieRelationError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, error2.ErrMovieRelationIsNotValid) {
+		http.Error(w, "Movie can not be related to itself", http.StatusBadRequest)
+	} else if errors.Is(err, error2.ErrMovieRelationIsNotFound) {
+		http.Error(w, "Movie relation is not found", http.StatusNotFound)
+	} else {
+		writeMovieAdminError(w, err, message)
+	}
+}
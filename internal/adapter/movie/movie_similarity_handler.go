@@ -0,0 +1,64 @@
+package movie
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	movieresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/response"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type MovieSimilarityHandler struct {
+	similarityService  moviedomain.SimilarityService
+	translationService moviedomain.TranslationService
+}
+
+func NewMovieSimilarityHandler(similarityService moviedomain.SimilarityService, translationService moviedomain.TranslationService) *MovieSimilarityHandler {
+	return &MovieSimilarityHandler{similarityService: similarityService, translationService: translationService}
+}
+
+func (m *MovieSimilarityHandler) GetSimilar(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieSimilarityHandler.GetSimilar called")
+
+	movieID, err := object.NewMovieID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("MovieSimilarityHandler.GetSimilar error getting movie id", "error", err)
+		http.Error(w, "Invalid movie id", http.StatusBadRequest)
+		return
+	}
+
+	query, err := object.GetSimilarQueryFromReq(r)
+	if err != nil {
+		slog.Error("MovieSimilarityHandler.GetSimilar error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	similar, err := m.similarityService.Similar(r.Context(), object.NewMovieRefByID(movieID), query)
+	if err != nil {
+		slog.Error("MovieSimilarityHandler.GetSimilar error getting similar movies", "error", err)
+		writeMovieAdminError(w, err, "Failed to get similar movies")
+		return
+	}
+
+	movies := make([]*moviedomain.Movie, 0, len(similar))
+	for _, item := range similar {
+		movies = append(movies, item.Movie)
+	}
+	err = m.translationService.Localize(r.Context(), object.GetLocaleFromReq(r), moviedomain.LocalizableMovies(movies)...)
+	if err != nil {
+		slog.Error("MovieSimilarityHandler.GetSimilar error localizing movies", "error", err)
+		http.Error(w, "Failed to get similar movies", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Vary", "Accept-Language")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewSimilarResponse(movieID, similar)); err != nil {
+		slog.Error("MovieSimilarityHandler.GetSimilar error encoding response", "error", err)
+		return
+	}
+}
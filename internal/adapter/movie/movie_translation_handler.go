@@ -0,0 +1,219 @@
+package movie
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	movierequest "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/request"
+	movieresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type MovieTranslationHandler struct {
+	translationService moviedomain.TranslationService
+}
+
+func NewMovieTranslationHandler(translationService moviedomain.TranslationService) *MovieTranslationHandler {
+	return &MovieTranslationHandler{translationService: translationService}
+}
+
+func (m *MovieTranslationHandler) GetTranslations(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieTranslationHandler.GetTranslations called")
+
+	movieID, err := object.NewMovieID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("MovieTranslationHandler.GetTranslations error getting movie id", "error", err)
+		http.Error(w, "Invalid movie id", http.StatusBadRequest)
+		return
+	}
+
+	translations, err := m.translationService.GetTranslations(r.Context(), object.NewMovieRefByID(movieID))
+	if err != nil {
+		slog.Error("MovieTranslationHandler.GetTranslations error getting translations", "error", err)
+		writeTranslationError(w, err, "Failed to get translations")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewTranslationsResponse(translations)); err != nil {
+		slog.Error("MovieTranslationHandler.GetTranslations error encoding response", "error", err)
+		return
+	}
+}
+
+func (m *MovieTranslationHandler) SaveTranslation(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieTranslationHandler.SaveTranslation called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("MovieTranslationHandler.SaveTranslation error extracting user id", "error", err)
+		http.Error(w, "Failed to save translation", http.StatusUnauthorized)
+		return
+	}
+
+	movieID, err := object.NewMovieID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("MovieTranslationHandler.SaveTranslation error getting movie id", "error", err)
+		http.Error(w, "Invalid movie id", http.StatusBadRequest)
+		return
+	}
+	language, err := object.NewLanguageTag(r.PathValue("language"))
+	if err != nil {
+		slog.Error("MovieTranslationHandler.SaveTranslation error getting language", "error", err)
+		http.Error(w, "Invalid language tag", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("MovieTranslationHandler.SaveTranslation error reading body", "error", err)
+		http.Error(w, "Failed to save translation", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var saveRequest movierequest.SaveTranslationRequest
+	err = json.Unmarshal(body, &saveRequest)
+	if err != nil {
+		slog.Error("MovieTranslationHandler.SaveTranslation error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	translation := moviedomain.NewTranslation(language, saveRequest.Title, saveRequest.Description, saveRequest.Tagline)
+	translation, err = m.translationService.SaveTranslation(r.Context(), actorID, object.NewMovieRefByID(movieID), translation)
+	if err != nil {
+		slog.Error("MovieTranslationHandler.SaveTranslation error saving translation", "error", err)
+		writeTranslationError(w, err, "Failed to save translation")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewTranslationResponse(translation)); err != nil {
+		slog.Error("MovieTranslationHandler.SaveTranslation error encoding response", "error", err)
+		return
+	}
+}
+
+func (m *MovieTranslationHandler) DeleteTranslation(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieTranslationHandler.DeleteTranslation called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("MovieTranslationHandler.DeleteTranslation error extracting user id", "error", err)
+		http.Error(w, "Failed to delete translation", http.StatusUnauthorized)
+		return
+	}
+
+	movieID, err := object.NewMovieID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("MovieTranslationHandler.DeleteTranslation error getting movie id", "error", err)
+		http.Error(w, "Invalid movie id", http.StatusBadRequest)
+		return
+	}
+	language, err := object.NewLanguageTag(r.PathValue("language"))
+	if err != nil {
+		slog.Error("MovieTranslationHandler.DeleteTranslation error getting language", "error", err)
+		http.Error(w, "Invalid language tag", http.StatusBadRequest)
+		return
+	}
+
+	err = m.translationService.DeleteTranslation(r.Context(), actorID, object.NewMovieRefByID(movieID), language)
+	if err != nil {
+		slog.Error("MovieTranslationHandler.DeleteTranslation error deleting translation", "error", err)
+		writeTranslationError(w, err, "Failed to delete translation")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write([]byte("Successfully deleted translation"))
+	if err != nil {
+		slog.Error("MovieTranslationHandler.DeleteTranslation error writing body", "error", err)
+		return
+	}
+}
+
+func (m *MovieTranslationHandler) SaveAlternateTitles(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieTranslationHandler.SaveAlternateTitles called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("MovieTranslationHandler.SaveAlternateTitles error extracting user id", "error", err)
+		http.Error(w, "Failed to save alternate titles", http.StatusUnauthorized)
+		return
+	}
+
+	movieID, err := object.NewMovieID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("MovieTranslationHandler.SaveAlternateTitles error getting movie id", "error", err)
+		http.Error(w, "Invalid movie id", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("MovieTranslationHandler.SaveAlternateTitles error reading body", "error", err)
+		http.Error(w, "Failed to save alternate titles", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var saveRequest movierequest.SaveAlternateTitlesRequest
+	err = json.Unmarshal(body, &saveRequest)
+	if err != nil {
+		slog.Error("MovieTranslationHandler.SaveAlternateTitles error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	titles := make([]*moviedomain.AlternateTitle, 0, len(saveRequest.Titles))
+	for _, titleRequest := range saveRequest.Titles {
+		var language object.LanguageTag
+		if titleRequest.Language != "" {
+			language, err = object.NewLanguageTag(titleRequest.Language)
+			if err != nil {
+				slog.Error("MovieTranslationHandler.SaveAlternateTitles error getting language", "error", err)
+				http.Error(w, "Invalid language tag", http.StatusBadRequest)
+				return
+			}
+		}
+		titles = append(titles, moviedomain.NewAlternateTitle(titleRequest.Title, language))
+	}
+
+	titles, err = m.translationService.SaveAlternateTitles(r.Context(), actorID, object.NewMovieRefByID(movieID), titles)
+	if err != nil {
+		slog.Error("MovieTranslationHandler.SaveAlternateTitles error saving titles", "error", err)
+		writeTranslationError(w, err, "Failed to save alternate titles")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	response := movieresponse.AlternateTitlesResponse{AlternateTitles: movieresponse.NewAlternateTitleResponses(titles)}
+	if response.AlternateTitles == nil {
+		response.AlternateTitles = make([]movieresponse.AlternateTitleResponse, 0)
+	}
+	if err = json.NewEncoder(w).Encode(response); err != nil {
+		slog.Error("MovieTranslationHandler.SaveAlternateTitles error encoding response", "error", err)
+		return
+	}
+}
+
+func writeTranslationError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, error2.ErrTranslationIsNotFound) {
+		http.Error(w, "Translation is not found", http.StatusNotFound)
+	} else if errors.Is(err, error2.ErrTranslationDataValidationFailed) {
+		http.Error(w, "Invalid translation data", http.StatusBadRequest)
+	} else {
+		writeMovieAdminError(w, err, message)
+	}
+}
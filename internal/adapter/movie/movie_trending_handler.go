@@ -0,0 +1,57 @@
+package movie
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	movieresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/response"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type MovieTrendingHandler struct {
+	trendingService    moviedomain.TrendingService
+	translationService moviedomain.TranslationService
+}
+
+func NewMovieTrendingHandler(trendingService moviedomain.TrendingService, translationService moviedomain.TranslationService) *MovieTrendingHandler {
+	return &MovieTrendingHandler{trendingService: trendingService, translationService: translationService}
+}
+
+func (m *MovieTrendingHandler) GetTrending(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieTrendingHandler.GetTrending called")
+
+	query, err := object.GetTrendingQueryFromReq(r)
+	if err != nil {
+		slog.Error("MovieTrendingHandler.GetTrending error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	trending, err := m.trendingService.Trending(r.Context(), query)
+	if err != nil {
+		slog.Error("MovieTrendingHandler.GetTrending error getting movies", "error", err)
+		http.Error(w, "Failed to get trending movies", http.StatusInternalServerError)
+		return
+	}
+
+	movies := make([]*moviedomain.Movie, 0, len(trending))
+	for _, item := range trending {
+		movies = append(movies, item.Movie)
+	}
+	err = m.translationService.Localize(r.Context(), object.GetLocaleFromReq(r), moviedomain.LocalizableMovies(movies)...)
+	if err != nil {
+		slog.Error("MovieTrendingHandler.GetTrending error localizing movies", "error", err)
+		http.Error(w, "Failed to get trending movies", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Vary", "Accept-Language")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(movieresponse.NewTrendingResponse(query.Window, trending)); err != nil {
+		slog.Error("MovieTrendingHandler.GetTrending error encoding response", "error", err)
+		return
+	}
+}
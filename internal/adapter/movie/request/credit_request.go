@@ -0,0 +1,8 @@
+package movierequest
+
+type CreditRequest struct {
+	PersonID  string `json:"person_id"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Character string `json:"character"`
+}
@@ -0,0 +1,9 @@
+package movierequest
+
+import "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+
+type DeleteMovieRequest struct {
+	MovieID   string `json:"movie_id"`
+	MovieSlug string `json:"movie_slug"`
+	object.MovieInfo
+}
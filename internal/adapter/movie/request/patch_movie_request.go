@@ -0,0 +1,30 @@
+package movierequest
+
+import "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+
+type PatchMovieRequest struct {
+	MovieID     string           `json:"movie_id"`
+	MovieSlug   string           `json:"movie_slug"`
+	MovieInfo   object.MovieInfo `json:"movie_info"`
+	Title       *string          `json:"title"`
+	Description *string          `json:"description"`
+	Year        *int             `json:"year"`
+	Month       *int             `json:"month"`
+	Day         *int             `json:"day"`
+	Director    *string          `json:"director"`
+	Actors      *[]string        `json:"actors"`
+	Genres      *[]string        `json:"genres"`
+	Credits     *[]CreditRequest `json:"credits"`
+
+	RuntimeMinutes *int               `json:"runtime_minutes"`
+	Countries      *[]string          `json:"countries"`
+	Languages      *[]string          `json:"languages"`
+	PosterURL      *string            `json:"poster_url"`
+	ExternalIDs    *map[string]string `json:"external_ids"`
+
+	OriginalLanguage *string            `json:"original_language"`
+	Certifications   *map[string]string `json:"certifications"`
+	Budget           *int64             `json:"budget"`
+	BoxOffice        *int64             `json:"box_office"`
+	Tagline          *string            `json:"tagline"`
+}
@@ -0,0 +1,7 @@
+package movierequest
+
+type SaveRelatedMovieRequest struct {
+	RelatedMovieID   string `json:"related_movie_id"`
+	RelatedMovieSlug string `json:"related_movie_slug"`
+	Type             string `json:"type"`
+}
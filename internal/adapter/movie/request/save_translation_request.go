@@ -0,0 +1,16 @@
+package movierequest
+
+type SaveTranslationRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Tagline     string `json:"tagline"`
+}
+
+type SaveAlternateTitlesRequest struct {
+	Titles []AlternateTitleRequest `json:"titles"`
+}
+
+type AlternateTitleRequest struct {
+	Title    string `json:"title"`
+	Language string `json:"language"`
+}
@@ -0,0 +1,10 @@
+package movierequest
+
+import "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+
+type UpdateMovieRequest struct {
+	MovieID   string           `json:"movie_id"`
+	MovieSlug string           `json:"movie_slug"`
+	MovieInfo object.MovieInfo `json:"movie_info"`
+	SaveMovieRequest
+}
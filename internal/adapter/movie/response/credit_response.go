@@ -0,0 +1,26 @@
+package movieresponse
+
+import persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+
+type CreditResponse struct {
+	PersonID  string `json:"person_id"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Character string `json:"character,omitempty"`
+}
+
+func NewCreditResponses(credits []*persondomain.Credit) []CreditResponse {
+	if len(credits) == 0 {
+		return nil
+	}
+	responses := make([]CreditResponse, 0, len(credits))
+	for _, credit := range credits {
+		responses = append(responses, CreditResponse{
+			PersonID:  credit.PersonID.ID(),
+			Name:      credit.Name,
+			Role:      credit.Role.String(),
+			Character: credit.Character,
+		})
+	}
+	return responses
+}
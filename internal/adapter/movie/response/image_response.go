@@ -0,0 +1,29 @@
+package movieresponse
+
+import "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/object"
+
+type ImageResponse struct {
+	Original  string `json:"original"`
+	Medium    string `json:"medium"`
+	Thumbnail string `json:"thumbnail"`
+}
+
+type ImagesResponse struct {
+	Poster   *ImageResponse `json:"poster"`
+	Backdrop *ImageResponse `json:"backdrop"`
+}
+
+func NewImageResponse(imageID object.ImageID) *ImageResponse {
+	if imageID.IsEmpty() {
+		return nil
+	}
+	return &ImageResponse{
+		Original:  ImageURL(imageID, object.VariantOriginal),
+		Medium:    ImageURL(imageID, object.VariantMedium),
+		Thumbnail: ImageURL(imageID, object.VariantThumbnail),
+	}
+}
+
+func ImageURL(imageID object.ImageID, variant object.Variant) string {
+	return "/api/images/" + imageID.ID() + "/" + variant.String()
+}
@@ -1,29 +1,99 @@
 package movieresponse
 
-import "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+import (
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
 
 type MovieResponse struct {
-	Title       string   `json:"title"`
-	Description string   `json:"description"`
-	Year        int      `json:"year"`
-	Month       int      `json:"month"`
-	Day         int      `json:"day"`
-	Director    string   `json:"director"`
-	Actors      []string `json:"actors"`
-	Genres      []string `json:"genres"`
-	Rating      float64  `json:"rating"`
+	ID             string           `json:"id"`
+	Slug           string           `json:"slug"`
+	Title          string           `json:"title"`
+	Description    string           `json:"description"`
+	Year           int              `json:"year"`
+	Month          int              `json:"month"`
+	Day            int              `json:"day"`
+	Director       string           `json:"director"`
+	Actors         []string         `json:"actors"`
+	Genres         []string         `json:"genres"`
+	Rating         float64          `json:"rating"`
+	RatingCount    int              `json:"rating_count"`
+	WeightedRating float64          `json:"weighted_rating"`
+	ReviewCount    int              `json:"review_count"`
+	FavoriteCount  int              `json:"favorite_count"`
+	WatchlistCount int              `json:"watchlist_count"`
+	Credits        []CreditResponse `json:"credits,omitempty"`
+
+	RuntimeMinutes int               `json:"runtime_minutes"`
+	Countries      []string          `json:"countries"`
+	Languages      []string          `json:"languages"`
+	PosterURL      string            `json:"poster_url"`
+	ExternalIDs    map[string]string `json:"external_ids"`
+	Sources        map[string]string `json:"field_sources,omitempty"`
+	Images         ImagesResponse    `json:"images"`
+
+	OriginalLanguage string            `json:"original_language"`
+	Certifications   map[string]string `json:"certifications"`
+	Budget           int64             `json:"budget"`
+	BoxOffice        int64             `json:"box_office"`
+	Tagline          string            `json:"tagline"`
+
+	Related []RelatedMovieResponse `json:"related,omitempty"`
+
+	Language        string                   `json:"language,omitempty"`
+	OriginalTitle   string                   `json:"original_title,omitempty"`
+	AlternateTitles []AlternateTitleResponse `json:"alternate_titles,omitempty"`
 }
 
 func NewMovieResponse(movie *movie.Movie) MovieResponse {
 	return MovieResponse{
-		Title:       movie.Title,
-		Description: movie.Description,
-		Year:        movie.ReleaseDate.Year(),
-		Month:       int(movie.ReleaseDate.Month()),
-		Day:         movie.ReleaseDate.Day(),
-		Director:    movie.Director,
-		Actors:      movie.Actors,
-		Genres:      movie.Genres,
-		Rating:      movie.Rating,
+		ID:             movie.ID().ID(),
+		Slug:           movie.Slug(),
+		Title:          movie.Title,
+		Description:    movie.Description,
+		Year:           movie.ReleaseDate.Year(),
+		Month:          int(movie.ReleaseDate.Month()),
+		Day:            movie.ReleaseDate.Day(),
+		Director:       movie.Director,
+		Actors:         movie.Actors,
+		Genres:         movie.Genres,
+		Rating:         movie.Rating,
+		RatingCount:    movie.RatingCount,
+		WeightedRating: movie.WeightedRating,
+		ReviewCount:    movie.ReviewCount,
+		FavoriteCount:  movie.FavoriteCount,
+		WatchlistCount: movie.WatchlistCount,
+		Credits:        NewCreditResponses(movie.Credits),
+
+		RuntimeMinutes: movie.RuntimeMinutes,
+		Countries:      movie.Countries,
+		Languages:      movie.Languages,
+		PosterURL:      movie.PosterURL,
+		ExternalIDs:    movie.ExternalIDs,
+		Sources:        newSourcesResponse(movie.Sources),
+		Images: ImagesResponse{
+			Poster:   NewImageResponse(movie.PosterImage),
+			Backdrop: NewImageResponse(movie.BackdropImage),
+		},
+
+		OriginalLanguage: movie.OriginalLanguage,
+		Certifications:   movie.Certifications,
+		Budget:           movie.Budget,
+		BoxOffice:        movie.BoxOffice,
+		Tagline:          movie.Tagline,
+
+		Related: NewRelatedMovieResponses(movie.Related),
+
+		Language:        movie.Language.String(),
+		OriginalTitle:   movie.OriginalTitle,
+		AlternateTitles: NewAlternateTitleResponses(movie.AlternateTitles),
+	}
+}
+
+func newSourcesResponse(sources map[object.MetadataField]string) map[string]string {
+	response := make(map[string]string, len(sources))
+	for field, source := range sources {
+		response[string(field)] = source
 	}
+	return response
 }
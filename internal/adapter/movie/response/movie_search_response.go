@@ -0,0 +1,26 @@
+package movieresponse
+
+import "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+
+type MovieSearchHitResponse struct {
+	Movie   MovieResponse `json:"movie"`
+	Rank    float64       `json:"rank"`
+	Snippet string        `json:"snippet"`
+}
+
+type MovieSearchResponse struct {
+	Results []MovieSearchHitResponse `json:"results"`
+	Fuzzy   bool                     `json:"fuzzy"`
+}
+
+func NewMovieSearchResponse(result *movie.SearchResult) MovieSearchResponse {
+	response := MovieSearchResponse{Results: make([]MovieSearchHitResponse, 0, len(result.Hits)), Fuzzy: result.Fuzzy}
+	for _, hit := range result.Hits {
+		response.Results = append(response.Results, MovieSearchHitResponse{
+			Movie:   NewMovieResponse(hit.Movie),
+			Rank:    hit.Rank,
+			Snippet: hit.Snippet,
+		})
+	}
+	return response
+}
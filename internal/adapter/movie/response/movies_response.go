@@ -1,5 +1,28 @@
 package movieresponse
 
+import (
+	"net/url"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+)
+
 type MoviesResponse struct {
-	Movies []MovieResponse
+	Movies     []MovieResponse
+	Total      int    `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Next       string `json:"next,omitempty"`
+}
+
+func NewMoviesResponse(page *movie.MoviePage, requestURL *url.URL) MoviesResponse {
+	response := MoviesResponse{Movies: make([]MovieResponse, 0, len(page.Movies)), Total: page.Total}
+	for _, m := range page.Movies {
+		response.Movies = append(response.Movies, NewMovieResponse(m))
+	}
+	if page.NextCursor != "" {
+		query := requestURL.Query()
+		query.Set("cursor", page.NextCursor)
+		response.NextCursor = page.NextCursor
+		response.Next = requestURL.Path + "?" + query.Encode()
+	}
+	return response
 }
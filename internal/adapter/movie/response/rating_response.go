@@ -0,0 +1,53 @@
+package movieresponse
+
+import (
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+)
+
+type RatingBucketResponse struct {
+	Rating int `json:"rating"`
+	Count  int `json:"count"`
+}
+
+type RatingsResponse struct {
+	MovieID        string                 `json:"movie_id"`
+	Rating         float64                `json:"rating"`
+	RatingCount    int                    `json:"rating_count"`
+	WeightedRating float64                `json:"weighted_rating"`
+	MeanRating     float64                `json:"mean_rating"`
+	MinVotes       int                    `json:"min_votes"`
+	Histogram      []RatingBucketResponse `json:"histogram"`
+}
+
+func NewRatingsResponse(summary *movie.RatingSummary) RatingsResponse {
+	response := RatingsResponse{
+		MovieID:        summary.MovieID.ID(),
+		Rating:         summary.Rating,
+		RatingCount:    summary.RatingCount,
+		WeightedRating: summary.WeightedRating,
+		MeanRating:     summary.Stats.Mean,
+		MinVotes:       summary.Stats.MinVotes,
+		Histogram:      make([]RatingBucketResponse, 0, len(summary.Histogram)),
+	}
+	for _, bucket := range summary.Histogram {
+		response.Histogram = append(response.Histogram, RatingBucketResponse{Rating: bucket.Rating, Count: bucket.Count})
+	}
+	return response
+}
+
+type TopRatedMovieResponse struct {
+	Rank int `json:"rank"`
+	MovieResponse
+}
+
+type TopRatedResponse struct {
+	Movies []TopRatedMovieResponse `json:"movies"`
+}
+
+func NewTopRatedResponse(movies []*movie.Movie) TopRatedResponse {
+	response := TopRatedResponse{Movies: make([]TopRatedMovieResponse, 0, len(movies))}
+	for i, m := range movies {
+		response.Movies = append(response.Movies, TopRatedMovieResponse{Rank: i + 1, MovieResponse: NewMovieResponse(m)})
+	}
+	return response
+}
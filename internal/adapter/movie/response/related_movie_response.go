@@ -0,0 +1,32 @@
+package movieresponse
+
+import moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+
+type RelatedMovieResponse struct {
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+	Year  int    `json:"year"`
+	Month int    `json:"month"`
+	Day   int    `json:"day"`
+}
+
+func NewRelatedMovieResponses(related []*moviedomain.RelatedMovie) []RelatedMovieResponse {
+	if len(related) == 0 {
+		return nil
+	}
+	responses := make([]RelatedMovieResponse, 0, len(related))
+	for _, relatedMovie := range related {
+		responses = append(responses, RelatedMovieResponse{
+			Type:  relatedMovie.Type.String(),
+			ID:    relatedMovie.MovieID.ID(),
+			Slug:  relatedMovie.Slug,
+			Title: relatedMovie.Title,
+			Year:  relatedMovie.ReleaseDate.Year(),
+			Month: int(relatedMovie.ReleaseDate.Month()),
+			Day:   relatedMovie.ReleaseDate.Day(),
+		})
+	}
+	return responses
+}
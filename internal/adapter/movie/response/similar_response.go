@@ -0,0 +1,35 @@
+package movieresponse
+
+import (
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type SimilarityReasonResponse struct {
+	Type     string   `json:"type"`
+	Names    []string `json:"names,omitempty"`
+	CoRaters int      `json:"co_raters,omitempty"`
+}
+
+type SimilarMovieResponse struct {
+	Score   float64                    `json:"score"`
+	Reasons []SimilarityReasonResponse `json:"reasons"`
+	MovieResponse
+}
+
+type SimilarResponse struct {
+	MovieID string                 `json:"movie_id"`
+	Movies  []SimilarMovieResponse `json:"movies"`
+}
+
+func NewSimilarResponse(movieID object.MovieID, similar []*movie.SimilarMovie) SimilarResponse {
+	response := SimilarResponse{MovieID: movieID.ID(), Movies: make([]SimilarMovieResponse, 0, len(similar))}
+	for _, item := range similar {
+		reasons := make([]SimilarityReasonResponse, 0, len(item.Reasons))
+		for _, reason := range item.Reasons {
+			reasons = append(reasons, SimilarityReasonResponse{Type: reason.Kind.String(), Names: reason.Names, CoRaters: reason.CoRaters})
+		}
+		response.Movies = append(response.Movies, SimilarMovieResponse{Score: item.Score, Reasons: reasons, MovieResponse: NewMovieResponse(item.Movie)})
+	}
+	return response
+}
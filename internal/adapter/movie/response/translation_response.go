@@ -0,0 +1,51 @@
+package movieresponse
+
+import moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+
+type TranslationResponse struct {
+	Language    string `json:"language"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Tagline     string `json:"tagline"`
+}
+
+type TranslationsResponse struct {
+	Translations []TranslationResponse `json:"translations"`
+}
+
+type AlternateTitleResponse struct {
+	Title    string `json:"title"`
+	Language string `json:"language,omitempty"`
+}
+
+type AlternateTitlesResponse struct {
+	AlternateTitles []AlternateTitleResponse `json:"alternate_titles"`
+}
+
+func NewTranslationResponse(translation *moviedomain.Translation) TranslationResponse {
+	return TranslationResponse{
+		Language:    translation.Language.String(),
+		Title:       translation.Title,
+		Description: translation.Description,
+		Tagline:     translation.Tagline,
+	}
+}
+
+func NewTranslationsResponse(translations []*moviedomain.Translation) TranslationsResponse {
+	response := TranslationsResponse{Translations: make([]TranslationResponse, 0, len(translations))}
+	for _, translation := range translations {
+		response.Translations = append(response.Translations, NewTranslationResponse(translation))
+	}
+	return response
+}
+
+func NewAlternateTitleResponses(titles []*moviedomain.AlternateTitle) []AlternateTitleResponse {
+	if len(titles) == 0 {
+		return nil
+	}
+	responses := make([]AlternateTitleResponse, 0, len(titles))
+	for _, title := range titles {
+		responses = append(responses, AlternateTitleResponse{Title: title.Title, Language: title.Language.String()})
+	}
+	return responses
+}
@@ -0,0 +1,25 @@
+package movieresponse
+
+import (
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type TrendingMovieResponse struct {
+	Rank  int     `json:"rank"`
+	Score float64 `json:"score"`
+	MovieResponse
+}
+
+type TrendingResponse struct {
+	Window string                  `json:"window"`
+	Movies []TrendingMovieResponse `json:"movies"`
+}
+
+func NewTrendingResponse(window object.TrendingWindow, trending []*movie.TrendingMovie) TrendingResponse {
+	response := TrendingResponse{Window: window.String(), Movies: make([]TrendingMovieResponse, 0, len(trending))}
+	for _, item := range trending {
+		response.Movies = append(response.Movies, TrendingMovieResponse{Rank: item.Rank, Score: item.Score, MovieResponse: NewMovieResponse(item.Movie)})
+	}
+	return response
+}
@@ -0,0 +1,144 @@
+package movieimport
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	importresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movieimport/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	importdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/object"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+)
+
+const maxMultipartMemory = 32 << 20
+
+type MovieImportHandler struct {
+	importService  importdomain.Service
+	maxUploadBytes int64
+}
+
+func NewMovieImportHandler(importService importdomain.Service, maxUploadBytes int64) *MovieImportHandler {
+	if maxUploadBytes <= 0 {
+		maxUploadBytes = importdomain.DefaultMaxUploadBytes
+	}
+	return &MovieImportHandler{importService: importService, maxUploadBytes: maxUploadBytes}
+}
+
+func (m *MovieImportHandler) ImportMovies(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("MovieImportHandler.ImportMovies called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("MovieImportHandler.ImportMovies error extracting user id", "error", err)
+		http.Error(w, "Failed to import movies", http.StatusUnauthorized)
+		return
+	}
+
+	format, options, err := getImportParamsFromReq(r)
+	if err != nil {
+		slog.Error("MovieImportHandler.ImportMovies error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, m.maxUploadBytes)
+	err = r.ParseMultipartForm(maxMultipartMemory)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			slog.Error("MovieImportHandler.ImportMovies import file is too large", "limit", maxBytesErr.Limit)
+			http.Error(w, "Import file is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		slog.Error("MovieImportHandler.ImportMovies error parsing multipart form", "error", err)
+		http.Error(w, "Invalid multipart form", http.StatusBadRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	var sources importdomain.Sources
+	files := make([]multipart.File, 0, 3)
+	defer func() {
+		for _, file := range files {
+			file.Close()
+		}
+	}()
+	for _, part := range []struct {
+		names  []string
+		target *io.Reader
+	}{
+		{names: []string{"file", "basics"}, target: &sources.Main},
+		{names: []string{"principals"}, target: &sources.Principals},
+		{names: []string{"names"}, target: &sources.Names},
+	} {
+		for _, name := range part.names {
+			file, _, err := r.FormFile(name)
+			if errors.Is(err, http.ErrMissingFile) {
+				continue
+			}
+			if err != nil {
+				slog.Error("MovieImportHandler.ImportMovies error opening file", "error", err, "name", name)
+				http.Error(w, "Invalid multipart form", http.StatusBadRequest)
+				return
+			}
+			files = append(files, file)
+			*part.target = file
+			break
+		}
+	}
+
+	report, err := m.importService.ImportAs(r.Context(), actorID, format, sources, options)
+	if err != nil {
+		slog.Error("MovieImportHandler.ImportMovies error importing movies", "error", err)
+		if errors.Is(err, error2.ErrImportSourceIsMissing) {
+			http.Error(w, "Import file is missing", http.StatusBadRequest)
+		} else if errors.Is(err, error2.ErrImportHeaderIsNotValid) {
+			http.Error(w, "Import file header is not valid", http.StatusBadRequest)
+		} else if errors.Is(err, error2.ErrImportFormatIsNotValid) || errors.Is(err, error2.ErrImportOptionsAreInvalid) {
+			http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		} else if errors.Is(err, usererror.ErrPermissionDenied) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+		} else {
+			http.Error(w, "Failed to import movies", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(importresponse.NewImportResponse(report)); err != nil {
+		slog.Error("MovieImportHandler.ImportMovies error encoding response", "error", err)
+		return
+	}
+}
+
+func getImportParamsFromReq(r *http.Request) (object.Format, importdomain.Options, error) {
+	query := r.URL.Query()
+	var options importdomain.Options
+
+	format, err := object.NewFormat(query.Get("format"))
+	if err != nil {
+		return "", options, err
+	}
+
+	for name, target := range map[string]*bool{"dry_run": &options.DryRun, "create_genres": &options.CreateGenres} {
+		if value := query.Get(name); value != "" {
+			if *target, err = strconv.ParseBool(value); err != nil {
+				return "", options, error2.ErrImportOptionsAreInvalid
+			}
+		}
+	}
+	if value := query.Get("batch_size"); value != "" {
+		if options.BatchSize, err = strconv.Atoi(value); err != nil || options.BatchSize <= 0 {
+			return "", options, error2.ErrImportOptionsAreInvalid
+		}
+	}
+	return format, options, nil
+}
@@ -0,0 +1,34 @@
+package importresponse
+
+import importdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport"
+
+type LineErrorResponse struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+type ImportResponse struct {
+	Created         int                 `json:"created"`
+	Updated         int                 `json:"updated"`
+	Skipped         int                 `json:"skipped"`
+	Failed          int                 `json:"failed"`
+	Errors          []LineErrorResponse `json:"errors"`
+	ErrorsTruncated bool                `json:"errors_truncated"`
+	DryRun          bool                `json:"dry_run"`
+}
+
+func NewImportResponse(report *importdomain.Report) ImportResponse {
+	response := ImportResponse{
+		Created:         report.Created,
+		Updated:         report.Updated,
+		Skipped:         report.Skipped,
+		Failed:          report.Failed,
+		Errors:          make([]LineErrorResponse, 0, len(report.Errors)),
+		ErrorsTruncated: report.ErrorsTruncated,
+		DryRun:          report.DryRun,
+	}
+	for _, lineError := range report.Errors {
+		response.Errors = append(response.Errors, LineErrorResponse{Line: lineError.Line, Message: lineError.Message})
+	}
+	return response
+}
@@ -0,0 +1,72 @@
+package person
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	personresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/person/response"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+)
+
+type PersonHandler struct {
+	personService persondomain.Service
+}
+
+func NewPersonHandler(personService persondomain.Service) *PersonHandler {
+	return &PersonHandler{personService}
+}
+
+func (p *PersonHandler) GetPerson(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("PersonHandler.GetPerson called")
+
+	personID, err := object.NewPersonID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("PersonHandler.GetPerson error getting person id", "error", err)
+		http.Error(w, "Invalid person id", http.StatusBadRequest)
+		return
+	}
+
+	profile, err := p.personService.FindByID(r.Context(), personID)
+	if err != nil {
+		slog.Error("PersonHandler.GetPerson error finding person", "error", err)
+		if errors.Is(err, error2.ErrPersonIsNotFound) {
+			http.Error(w, "Person is not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to get person", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(personresponse.NewPersonProfileResponse(profile)); err != nil {
+		slog.Error("PersonHandler.GetPerson error encoding response", "error", err)
+		return
+	}
+}
+
+func (p *PersonHandler) SearchPeople(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("PersonHandler.SearchPeople called")
+
+	people, err := p.personService.SearchByName(r.Context(), r.URL.Query().Get("name"))
+	if err != nil {
+		slog.Error("PersonHandler.SearchPeople error searching people", "error", err)
+		if errors.Is(err, error2.ErrPersonDataValidationFailed) {
+			http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		} else {
+			http.Error(w, "Failed to get people", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(personresponse.NewPeopleResponse(people)); err != nil {
+		slog.Error("PersonHandler.SearchPeople error encoding response", "error", err)
+		return
+	}
+}
@@ -0,0 +1,39 @@
+package personresponse
+
+import persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+
+type FilmographyEntryResponse struct {
+	MovieID   string `json:"movie_id"`
+	Slug      string `json:"slug"`
+	Title     string `json:"title"`
+	Year      int    `json:"year"`
+	Month     int    `json:"month"`
+	Day       int    `json:"day"`
+	Role      string `json:"role"`
+	Character string `json:"character,omitempty"`
+}
+
+type PersonProfileResponse struct {
+	PersonResponse
+	Filmography []FilmographyEntryResponse `json:"filmography"`
+}
+
+func NewPersonProfileResponse(profile *persondomain.Profile) PersonProfileResponse {
+	response := PersonProfileResponse{
+		PersonResponse: NewPersonResponse(profile.Person),
+		Filmography:    make([]FilmographyEntryResponse, 0, len(profile.Filmography)),
+	}
+	for _, entry := range profile.Filmography {
+		response.Filmography = append(response.Filmography, FilmographyEntryResponse{
+			MovieID:   entry.MovieID.ID(),
+			Slug:      entry.Slug,
+			Title:     entry.Title,
+			Year:      entry.ReleaseDate.Year(),
+			Month:     int(entry.ReleaseDate.Month()),
+			Day:       entry.ReleaseDate.Day(),
+			Role:      entry.Role.String(),
+			Character: entry.Character,
+		})
+	}
+	return response
+}
@@ -0,0 +1,24 @@
+package personresponse
+
+import persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+
+type PersonResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type PeopleResponse struct {
+	People []PersonResponse `json:"people"`
+}
+
+func NewPersonResponse(person *persondomain.Person) PersonResponse {
+	return PersonResponse{ID: person.ID().ID(), Name: person.Name}
+}
+
+func NewPeopleResponse(people []*persondomain.Person) PeopleResponse {
+	response := PeopleResponse{People: make([]PersonResponse, 0, len(people))}
+	for _, person := range people {
+		response.People = append(response.People, NewPersonResponse(person))
+	}
+	return response
+}
@@ -0,0 +1,67 @@
+package recommendation
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	recommendationresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/recommendation/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	recommendationdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation/object"
+)
+
+type RecommendationHandler struct {
+	recommendationService recommendationdomain.Service
+	translationService    moviedomain.TranslationService
+}
+
+func NewRecommendationHandler(recommendationService recommendationdomain.Service, translationService moviedomain.TranslationService) *RecommendationHandler {
+	return &RecommendationHandler{recommendationService: recommendationService, translationService: translationService}
+}
+
+func (rh *RecommendationHandler) GetRecommendations(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("RecommendationHandler.GetRecommendations called")
+
+	userID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("RecommendationHandler.GetRecommendations error extracting user id", "error", err)
+		http.Error(w, "Failed to get recommendations", http.StatusUnauthorized)
+		return
+	}
+
+	query, err := object.GetRecommendationQueryFromReq(r)
+	if err != nil {
+		slog.Error("RecommendationHandler.GetRecommendations error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	recommendations, err := rh.recommendationService.Recommend(r.Context(), userID, query)
+	if err != nil {
+		slog.Error("RecommendationHandler.GetRecommendations error getting recommendations", "error", err)
+		http.Error(w, "Failed to get recommendations", http.StatusInternalServerError)
+		return
+	}
+
+	movies := make([]*moviedomain.Movie, 0, len(recommendations))
+	for _, item := range recommendations {
+		movies = append(movies, item.Movie)
+	}
+	err = rh.translationService.Localize(r.Context(), movieobject.GetLocaleFromReq(r), moviedomain.LocalizableMovies(movies)...)
+	if err != nil {
+		slog.Error("RecommendationHandler.GetRecommendations error localizing movies", "error", err)
+		http.Error(w, "Failed to get recommendations", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Vary", "Accept-Language")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(recommendationresponse.NewRecommendationsResponse(recommendations)); err != nil {
+		slog.Error("RecommendationHandler.GetRecommendations error encoding response", "error", err)
+		return
+	}
+}
@@ -0,0 +1,49 @@
+package recommendationresponse
+
+import (
+	movieresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie/response"
+	recommendationdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation"
+)
+
+type BecauseResponse struct {
+	MovieID    string `json:"movie_id"`
+	MovieTitle string `json:"movie_title"`
+	Rating     int    `json:"rating,omitempty"`
+}
+
+type RecommendationResponse struct {
+	Rank        int              `json:"rank"`
+	Score       float64          `json:"score"`
+	Source      string           `json:"source"`
+	Reason      string           `json:"reason"`
+	Explanation string           `json:"explanation"`
+	Because     *BecauseResponse `json:"because,omitempty"`
+	movieresponse.MovieResponse
+}
+
+type RecommendationsResponse struct {
+	Movies []RecommendationResponse `json:"movies"`
+}
+
+func NewRecommendationsResponse(recommendations []*recommendationdomain.Recommendation) RecommendationsResponse {
+	response := RecommendationsResponse{Movies: make([]RecommendationResponse, 0, len(recommendations))}
+	for _, item := range recommendations {
+		recommendation := RecommendationResponse{
+			Rank:          item.Rank,
+			Score:         item.Score,
+			Source:        item.Source.String(),
+			Reason:        item.Reason.Kind.String(),
+			Explanation:   item.Reason.Explanation(),
+			MovieResponse: movieresponse.NewMovieResponse(item.Movie),
+		}
+		if !item.Reason.MovieID.IsEmpty() {
+			recommendation.Because = &BecauseResponse{
+				MovieID:    item.Reason.MovieID.ID(),
+				MovieTitle: item.Reason.MovieTitle,
+				Rating:     item.Reason.Rating,
+			}
+		}
+		response.Movies = append(response.Movies, recommendation)
+	}
+	return response
+}
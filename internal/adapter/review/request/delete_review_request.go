@@ -0,0 +1,5 @@
+package reviewrequest
+
+type DeleteReviewRequest struct {
+	ReviewID string `json:"review_id"`
+}
@@ -0,0 +1,13 @@
+package reviewrequest
+
+import (
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
+)
+
+type DeleteUserReviewRequest struct {
+	MovieID   string `json:"movie_id"`
+	MovieSlug string `json:"movie_slug"`
+	object.MovieInfo
+	titleobject.SeriesTargetInfo
+}
@@ -2,6 +2,7 @@ package reviewrequest
 
 import (
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 )
 
 type SaveReviewRequest struct {
@@ -9,5 +10,8 @@ type SaveReviewRequest struct {
 	ReviewYear  int              `json:"review_year"`
 	ReviewMonth int              `json:"review_month"`
 	ReviewDay   int              `json:"review_day"`
+	MovieID     string           `json:"movie_id"`
+	MovieSlug   string           `json:"movie_slug"`
 	MovieInfo   object.MovieInfo `json:"movie_info"`
+	titleobject.SeriesTargetInfo
 }
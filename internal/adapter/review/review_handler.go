@@ -15,6 +15,10 @@ import (
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
 	reviewdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/review"
 	error3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/error"
+	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
+	serieserror "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/error"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
 )
 
 type ReviewHandler struct {
@@ -52,14 +56,25 @@ func (rh *ReviewHandler) SaveReview(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	targetRef, err := titleobject.NewTargetRef(saveRequest.MovieID, saveRequest.MovieSlug, saveRequest.MovieInfo, saveRequest.SeriesTargetInfo)
+	if err != nil {
+		slog.Error("Error with target reference", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
 	date := time.Date(saveRequest.ReviewYear, time.Month(saveRequest.ReviewMonth), saveRequest.ReviewDay, 0, 0, 0, 0, time.UTC)
-	err = rh.reviewService.SaveReview(r.Context(), userID, saveRequest.MovieInfo, saveRequest.Text, date)
+	err = rh.reviewService.SaveReview(r.Context(), userID, targetRef, saveRequest.Text, date)
 	if err != nil {
 		slog.Error("Error while saving review", "error", err)
 		if errors.Is(err, error2.ErrMovieIsNotFound) {
 			http.Error(w, "Movie is not found", http.StatusNotFound)
+		} else if errors.Is(err, serieserror.ErrSeriesIsNotFound) || errors.Is(err, serieserror.ErrSeasonIsNotFound) || errors.Is(err, serieserror.ErrEpisodeIsNotFound) {
+			http.Error(w, "Series, season or episode is not found", http.StatusNotFound)
 		} else if errors.Is(err, error3.ErrReviewTextValidationError) {
 			http.Error(w, "Text validation error", http.StatusBadRequest)
+		} else if errors.Is(err, usererror.ErrEmailIsNotVerified) {
+			http.Error(w, "Email is not verified", http.StatusForbidden)
 		} else {
 			http.Error(w, "Failed to save review", http.StatusInternalServerError)
 		}
@@ -93,21 +108,30 @@ func (rh *ReviewHandler) DeleteReview(w http.ResponseWriter, r *http.Request) {
 
 	defer r.Body.Close()
 
-	var movieInfo object.MovieInfo
-	err = json.Unmarshal(body, &movieInfo)
+	var deleteRequest reviewrequest.DeleteUserReviewRequest
+	err = json.Unmarshal(body, &deleteRequest)
 	if err != nil {
 		slog.Error("Error while unmarshalling body", "error", err)
 		http.Error(w, "Invalid JSON", http.StatusBadRequest)
 		return
 	}
 
-	err = rh.reviewService.DeleteReview(r.Context(), userID, movieInfo)
+	targetRef, err := titleobject.NewTargetRef(deleteRequest.MovieID, deleteRequest.MovieSlug, deleteRequest.MovieInfo, deleteRequest.SeriesTargetInfo)
+	if err != nil {
+		slog.Error("Error with target reference", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	err = rh.reviewService.DeleteReview(r.Context(), userID, targetRef)
 	if err != nil {
 		slog.Error("Error while deleting review", "error", err)
 		if errors.Is(err, error3.ErrReviewNotFound) {
 			http.Error(w, "Review is not found", http.StatusNotFound)
 		} else if errors.Is(err, error2.ErrMovieIsNotFound) {
 			http.Error(w, "Movie is not found", http.StatusNotFound)
+		} else if errors.Is(err, serieserror.ErrSeriesIsNotFound) || errors.Is(err, serieserror.ErrSeasonIsNotFound) || errors.Is(err, serieserror.ErrEpisodeIsNotFound) {
+			http.Error(w, "Series, season or episode is not found", http.StatusNotFound)
 		} else {
 			http.Error(w, "Failed to delete review", http.StatusInternalServerError)
 		}
@@ -132,18 +156,20 @@ func (rh *ReviewHandler) GetReview(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	movieInfo, err := object.GetMovieInfoFromReq(r)
+	targetRef, err := titleobject.GetTargetRefFromReq(r)
 	if err != nil {
 		slog.Error("Error while getting parameters", "error", err)
 		http.Error(w, "Invalid parameters", http.StatusBadRequest)
 		return
 	}
 
-	review, err := rh.reviewService.GetUserReview(r.Context(), userID, movieInfo)
+	review, err := rh.reviewService.GetUserReview(r.Context(), userID, targetRef)
 	if err != nil {
 		slog.Error("Error while getting review", "error", err)
 		if errors.Is(err, error2.ErrMovieIsNotFound) {
 			http.Error(w, "Movie is not found", http.StatusNotFound)
+		} else if errors.Is(err, serieserror.ErrSeriesIsNotFound) || errors.Is(err, serieserror.ErrSeasonIsNotFound) || errors.Is(err, serieserror.ErrEpisodeIsNotFound) {
+			http.Error(w, "Series, season or episode is not found", http.StatusNotFound)
 		} else if errors.Is(err, error3.ErrReviewNotFound) {
 			http.Error(w, "Review is not found", http.StatusNotFound)
 		} else {
@@ -165,18 +191,20 @@ func (rh *ReviewHandler) GetReview(w http.ResponseWriter, r *http.Request) {
 func (rh *ReviewHandler) GetReviews(w http.ResponseWriter, r *http.Request) {
 	slog.Debug("ReviewHandler.GetReviews called")
 
-	movieInfo, err := object.GetMovieInfoFromReq(r)
+	targetRef, err := titleobject.GetTargetRefFromReq(r)
 	if err != nil {
 		slog.Error("Error while getting parameters", "error", err)
 		http.Error(w, "Invalid parameters", http.StatusBadRequest)
 		return
 	}
 
-	reviews, err := rh.reviewService.GetReviewsByMovie(r.Context(), movieInfo)
+	reviews, err := rh.reviewService.GetReviews(r.Context(), targetRef)
 	if err != nil {
 		slog.Error("Error while getting reviews", "error", err)
 		if errors.Is(err, error2.ErrMovieIsNotFound) {
 			http.Error(w, "Movie is not found", http.StatusNotFound)
+		} else if errors.Is(err, serieserror.ErrSeriesIsNotFound) || errors.Is(err, serieserror.ErrSeasonIsNotFound) || errors.Is(err, serieserror.ErrEpisodeIsNotFound) {
+			http.Error(w, "Series, season or episode is not found", http.StatusNotFound)
 		} else {
 			http.Error(w, "Failed to get reviews", http.StatusInternalServerError)
 		}
@@ -202,17 +230,19 @@ func (rh *ReviewHandler) GetReviewsForUser(w http.ResponseWriter, r *http.Reques
 		http.Error(w, "Failed to delete review", http.StatusUnauthorized)
 	}
 
-	movieInfo, err := object.GetMovieInfoFromReq(r)
+	targetRef, err := titleobject.GetTargetRefFromReq(r)
 	if err != nil {
 		slog.Error("Error while getting parameters", "error", err)
 		http.Error(w, "Invalid parameters", http.StatusBadRequest)
 	}
 
-	reviews, err := rh.reviewService.GetReviewsByMovieForUser(r.Context(), movieInfo, userID)
+	reviews, err := rh.reviewService.GetReviewsForUser(r.Context(), targetRef, userID)
 	if err != nil {
 		slog.Error("Error while getting reviews", "error", err)
 		if errors.Is(err, error2.ErrMovieIsNotFound) {
 			http.Error(w, "Movie is not found", http.StatusNotFound)
+		} else if errors.Is(err, serieserror.ErrSeriesIsNotFound) || errors.Is(err, serieserror.ErrSeasonIsNotFound) || errors.Is(err, serieserror.ErrEpisodeIsNotFound) {
+			http.Error(w, "Series, season or episode is not found", http.StatusNotFound)
 		} else {
 			http.Error(w, "Failed to get reviews", http.StatusInternalServerError)
 		}
@@ -232,14 +262,14 @@ func (rh *ReviewHandler) GetReviewsForUser(w http.ResponseWriter, r *http.Reques
 func (rh *ReviewHandler) GetSummaryReviews(w http.ResponseWriter, r *http.Request) {
 	slog.Debug("ReviewHandler.GetSummaryReviews called")
 
-	movieInfo, err := object.GetMovieInfoFromReq(r)
+	movieRef, err := object.GetMovieRefFromReq(r)
 	if err != nil {
 		slog.Error("Error while getting parameters", "error", err)
 		http.Error(w, "Invalid parameters", http.StatusBadRequest)
 		return
 	}
 
-	summary, err := rh.reviewProvider.ProvideMovieReviews(r.Context(), movieInfo)
+	summary, err := rh.reviewProvider.ProvideMovieReviews(r.Context(), movieRef)
 	if err != nil {
 		slog.Error("Error while getting summary", "error", err)
 		if errors.Is(err, error2.ErrMovieIsNotFound) {
@@ -260,3 +290,58 @@ func (rh *ReviewHandler) GetSummaryReviews(w http.ResponseWriter, r *http.Reques
 	}
 	slog.Info("Successfully got summary")
 }
+
+func (rh *ReviewHandler) ModerateDeleteReview(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("ReviewHandler.ModerateDeleteReview called")
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("Error while extracting user id from request", "error", err)
+		http.Error(w, "Failed to delete review", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("Error while reading body", "error", err)
+		http.Error(w, "Failed to delete review", http.StatusInternalServerError)
+		return
+	}
+
+	defer r.Body.Close()
+
+	var deleteRequest reviewrequest.DeleteReviewRequest
+	err = json.Unmarshal(body, &deleteRequest)
+	if err != nil {
+		slog.Error("Error while unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	reviewID, err := object2.NewReviewID(deleteRequest.ReviewID)
+	if err != nil {
+		slog.Error("Error with review id", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	err = rh.reviewService.DeleteReviewByID(r.Context(), actorID, reviewID)
+	if err != nil {
+		slog.Error("Error while deleting review", "error", err)
+		if errors.Is(err, error3.ErrReviewNotFound) {
+			http.Error(w, "Review is not found", http.StatusNotFound)
+		} else if errors.Is(err, usererror.ErrPermissionDenied) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+		} else {
+			http.Error(w, "Failed to delete review", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write([]byte("Successfully deleted review"))
+	if err != nil {
+		slog.Error("Error while writing body", "error", err)
+		return
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
 	reviewlikedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/reviewlike"
 	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/reviewlike/error"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
 )
 
 type ReviewLikeHandler struct {
@@ -65,6 +66,8 @@ func (rl *ReviewLikeHandler) Like(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, "Review not found", http.StatusNotFound)
 		} else if errors.Is(err, error2.ErrReviewLikeAlreadyExists) {
 			http.Error(w, "Review like already exists", http.StatusConflict)
+		} else if errors.Is(err, usererror.ErrEmailIsNotVerified) {
+			http.Error(w, "Email is not verified", http.StatusForbidden)
 		} else {
 			http.Error(w, "Review like error", http.StatusInternalServerError)
 		}
@@ -0,0 +1,10 @@
+package seriesrequest
+
+type SaveEpisodeRequest struct {
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	AirYear        int    `json:"air_year"`
+	AirMonth       int    `json:"air_month"`
+	AirDay         int    `json:"air_day"`
+	RuntimeMinutes int    `json:"runtime_minutes"`
+}
@@ -0,0 +1,8 @@
+package seriesrequest
+
+type SaveSeasonRequest struct {
+	Title    string `json:"title"`
+	AirYear  int    `json:"air_year"`
+	AirMonth int    `json:"air_month"`
+	AirDay   int    `json:"air_day"`
+}
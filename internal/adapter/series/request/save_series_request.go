@@ -0,0 +1,8 @@
+package seriesrequest
+
+type SaveSeriesRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	StartYear   int    `json:"start_year"`
+	EndYear     int    `json:"end_year"`
+}
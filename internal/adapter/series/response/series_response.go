@@ -0,0 +1,122 @@
+package seriesresponse
+
+import (
+	"time"
+
+	seriesdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/series"
+)
+
+type SeriesResponse struct {
+	ID           string  `json:"id"`
+	Slug         string  `json:"slug"`
+	Title        string  `json:"title"`
+	Description  string  `json:"description"`
+	StartYear    int     `json:"start_year"`
+	EndYear      int     `json:"end_year,omitempty"`
+	Rating       float64 `json:"rating"`
+	RatingCount  int     `json:"rating_count"`
+	SeasonCount  int     `json:"season_count"`
+	EpisodeCount int     `json:"episode_count"`
+}
+
+type SeriesDetailsResponse struct {
+	SeriesResponse
+	Seasons []SeasonResponse `json:"seasons"`
+}
+
+type SeriesListResponse struct {
+	Series []SeriesResponse `json:"series"`
+	Total  int              `json:"total"`
+}
+
+type SeasonResponse struct {
+	ID          string            `json:"id"`
+	Number      int               `json:"number"`
+	Title       string            `json:"title"`
+	AirDate     string            `json:"air_date,omitempty"`
+	Rating      float64           `json:"rating"`
+	RatingCount int               `json:"rating_count"`
+	Episodes    []EpisodeResponse `json:"episodes,omitempty"`
+}
+
+type EpisodeResponse struct {
+	ID             string  `json:"id"`
+	Code           string  `json:"code"`
+	Season         int     `json:"season"`
+	Number         int     `json:"number"`
+	Title          string  `json:"title"`
+	Description    string  `json:"description"`
+	AirDate        string  `json:"air_date,omitempty"`
+	RuntimeMinutes int     `json:"runtime_minutes"`
+	Rating         float64 `json:"rating"`
+	RatingCount    int     `json:"rating_count"`
+}
+
+func NewSeriesResponse(series *seriesdomain.Series) SeriesResponse {
+	return SeriesResponse{
+		ID:           series.ID().ID(),
+		Slug:         series.Slug(),
+		Title:        series.Title,
+		Description:  series.Description,
+		StartYear:    series.StartYear,
+		EndYear:      series.EndYear,
+		Rating:       series.Rating,
+		RatingCount:  series.RatingCount,
+		SeasonCount:  series.SeasonCount,
+		EpisodeCount: series.EpisodeCount,
+	}
+}
+
+func NewSeriesDetailsResponse(series *seriesdomain.Series, seasons []*seriesdomain.Season) SeriesDetailsResponse {
+	response := SeriesDetailsResponse{SeriesResponse: NewSeriesResponse(series), Seasons: make([]SeasonResponse, 0, len(seasons))}
+	for _, season := range seasons {
+		response.Seasons = append(response.Seasons, NewSeasonResponse(season))
+	}
+	return response
+}
+
+func NewSeriesListResponse(page *seriesdomain.SeriesPage) SeriesListResponse {
+	response := SeriesListResponse{Series: make([]SeriesResponse, 0, len(page.Series)), Total: page.Total}
+	for _, series := range page.Series {
+		response.Series = append(response.Series, NewSeriesResponse(series))
+	}
+	return response
+}
+
+func NewSeasonResponse(season *seriesdomain.Season) SeasonResponse {
+	response := SeasonResponse{
+		ID:          season.ID().ID(),
+		Number:      season.Number,
+		Title:       season.Title,
+		AirDate:     formatDate(season.AirDate),
+		Rating:      season.Rating,
+		RatingCount: season.RatingCount,
+		Episodes:    make([]EpisodeResponse, 0, len(season.Episodes)),
+	}
+	for _, episode := range season.Episodes {
+		response.Episodes = append(response.Episodes, NewEpisodeResponse(episode))
+	}
+	return response
+}
+
+func NewEpisodeResponse(episode *seriesdomain.Episode) EpisodeResponse {
+	return EpisodeResponse{
+		ID:             episode.ID().ID(),
+		Code:           episode.Code().String(),
+		Season:         episode.SeasonNumber,
+		Number:         episode.Number,
+		Title:          episode.Title,
+		Description:    episode.Description,
+		AirDate:        formatDate(episode.AirDate),
+		RuntimeMinutes: episode.RuntimeMinutes,
+		Rating:         episode.Rating,
+		RatingCount:    episode.RatingCount,
+	}
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
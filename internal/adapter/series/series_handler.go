@@ -0,0 +1,479 @@
+package series
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	seriesrequest "github.com/Vlad-Ali/Movies-service-back/internal/adapter/series/request"
+	seriesresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/series/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	seriesdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/series"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+)
+
+type SeriesHandler struct {
+	seriesService seriesdomain.Service
+}
+
+func NewSeriesHandler(seriesService seriesdomain.Service) *SeriesHandler {
+	return &SeriesHandler{seriesService: seriesService}
+}
+
+func (s *SeriesHandler) GetSeriesList(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SeriesHandler.GetSeriesList called")
+
+	limit, offset, err := parsePage(r)
+	if err != nil {
+		slog.Error("SeriesHandler.GetSeriesList error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	page, err := s.seriesService.List(r.Context(), limit, offset)
+	if err != nil {
+		slog.Error("SeriesHandler.GetSeriesList error getting series", "error", err)
+		http.Error(w, "Failed to get series", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(seriesresponse.NewSeriesListResponse(page)); err != nil {
+		slog.Error("SeriesHandler.GetSeriesList error encoding response", "error", err)
+		return
+	}
+}
+
+func (s *SeriesHandler) GetSeries(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SeriesHandler.GetSeries called")
+
+	ref, err := object.GetSeriesRefFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.GetSeries error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	series, err := s.seriesService.FindByRef(r.Context(), ref)
+	if err != nil {
+		slog.Error("SeriesHandler.GetSeries error finding series", "error", err)
+		writeSeriesError(w, err, "Failed to get series")
+		return
+	}
+
+	seasons, err := s.seriesService.FindSeasons(r.Context(), object.NewSeriesRefByID(series.ID()))
+	if err != nil {
+		slog.Error("SeriesHandler.GetSeries error finding seasons", "error", err)
+		writeSeriesError(w, err, "Failed to get series")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(seriesresponse.NewSeriesDetailsResponse(series, seasons)); err != nil {
+		slog.Error("SeriesHandler.GetSeries error encoding response", "error", err)
+		return
+	}
+}
+
+func (s *SeriesHandler) GetSeason(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SeriesHandler.GetSeason called")
+
+	ref, number, err := getSeasonFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.GetSeason error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	season, err := s.seriesService.FindSeason(r.Context(), ref, number)
+	if err != nil {
+		slog.Error("SeriesHandler.GetSeason error finding season", "error", err)
+		writeSeriesError(w, err, "Failed to get season")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(seriesresponse.NewSeasonResponse(season)); err != nil {
+		slog.Error("SeriesHandler.GetSeason error encoding response", "error", err)
+		return
+	}
+}
+
+func (s *SeriesHandler) GetEpisode(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SeriesHandler.GetEpisode called")
+
+	ref, code, err := getEpisodeFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.GetEpisode error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	episode, err := s.seriesService.FindEpisode(r.Context(), ref, code)
+	if err != nil {
+		slog.Error("SeriesHandler.GetEpisode error finding episode", "error", err)
+		writeSeriesError(w, err, "Failed to get episode")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(seriesresponse.NewEpisodeResponse(episode)); err != nil {
+		slog.Error("SeriesHandler.GetEpisode error encoding response", "error", err)
+		return
+	}
+}
+
+func (s *SeriesHandler) CreateSeries(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SeriesHandler.CreateSeries called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.CreateSeries error extracting user id", "error", err)
+		http.Error(w, "Failed to create series", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("SeriesHandler.CreateSeries error reading body", "error", err)
+		http.Error(w, "Failed to create series", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var saveRequest seriesrequest.SaveSeriesRequest
+	err = json.Unmarshal(body, &saveRequest)
+	if err != nil {
+		slog.Error("SeriesHandler.CreateSeries error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	series := seriesdomain.NewSeries(saveRequest.Title, saveRequest.Description, saveRequest.StartYear, saveRequest.EndYear)
+	series, err = s.seriesService.CreateSeries(r.Context(), actorID, series)
+	if err != nil {
+		slog.Error("SeriesHandler.CreateSeries error creating series", "error", err)
+		writeSeriesError(w, err, "Failed to create series")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(seriesresponse.NewSeriesResponse(series)); err != nil {
+		slog.Error("SeriesHandler.CreateSeries error encoding response", "error", err)
+		return
+	}
+}
+
+func (s *SeriesHandler) UpdateSeries(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SeriesHandler.UpdateSeries called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.UpdateSeries error extracting user id", "error", err)
+		http.Error(w, "Failed to update series", http.StatusUnauthorized)
+		return
+	}
+
+	ref, err := object.GetSeriesRefFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.UpdateSeries error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("SeriesHandler.UpdateSeries error reading body", "error", err)
+		http.Error(w, "Failed to update series", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var saveRequest seriesrequest.SaveSeriesRequest
+	err = json.Unmarshal(body, &saveRequest)
+	if err != nil {
+		slog.Error("SeriesHandler.UpdateSeries error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	series := seriesdomain.NewSeries(saveRequest.Title, saveRequest.Description, saveRequest.StartYear, saveRequest.EndYear)
+	series, err = s.seriesService.UpdateSeries(r.Context(), actorID, ref, series)
+	if err != nil {
+		slog.Error("SeriesHandler.UpdateSeries error updating series", "error", err)
+		writeSeriesError(w, err, "Failed to update series")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(seriesresponse.NewSeriesResponse(series)); err != nil {
+		slog.Error("SeriesHandler.UpdateSeries error encoding response", "error", err)
+		return
+	}
+}
+
+func (s *SeriesHandler) DeleteSeries(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SeriesHandler.DeleteSeries called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.DeleteSeries error extracting user id", "error", err)
+		http.Error(w, "Failed to delete series", http.StatusUnauthorized)
+		return
+	}
+
+	ref, err := object.GetSeriesRefFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.DeleteSeries error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	err = s.seriesService.DeleteSeries(r.Context(), actorID, ref)
+	if err != nil {
+		slog.Error("SeriesHandler.DeleteSeries error deleting series", "error", err)
+		writeSeriesError(w, err, "Failed to delete series")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *SeriesHandler) SaveSeason(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SeriesHandler.SaveSeason called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.SaveSeason error extracting user id", "error", err)
+		http.Error(w, "Failed to save season", http.StatusUnauthorized)
+		return
+	}
+
+	ref, number, err := getSeasonFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.SaveSeason error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("SeriesHandler.SaveSeason error reading body", "error", err)
+		http.Error(w, "Failed to save season", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var saveRequest seriesrequest.SaveSeasonRequest
+	err = json.Unmarshal(body, &saveRequest)
+	if err != nil {
+		slog.Error("SeriesHandler.SaveSeason error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	season := seriesdomain.NewSeason(number, saveRequest.Title, airDate(saveRequest.AirYear, saveRequest.AirMonth, saveRequest.AirDay))
+	season, err = s.seriesService.SaveSeason(r.Context(), actorID, ref, season)
+	if err != nil {
+		slog.Error("SeriesHandler.SaveSeason error saving season", "error", err)
+		writeSeriesError(w, err, "Failed to save season")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(seriesresponse.NewSeasonResponse(season)); err != nil {
+		slog.Error("SeriesHandler.SaveSeason error encoding response", "error", err)
+		return
+	}
+}
+
+func (s *SeriesHandler) DeleteSeason(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SeriesHandler.DeleteSeason called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.DeleteSeason error extracting user id", "error", err)
+		http.Error(w, "Failed to delete season", http.StatusUnauthorized)
+		return
+	}
+
+	ref, number, err := getSeasonFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.DeleteSeason error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	err = s.seriesService.DeleteSeason(r.Context(), actorID, ref, number)
+	if err != nil {
+		slog.Error("SeriesHandler.DeleteSeason error deleting season", "error", err)
+		writeSeriesError(w, err, "Failed to delete season")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *SeriesHandler) SaveEpisode(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SeriesHandler.SaveEpisode called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.SaveEpisode error extracting user id", "error", err)
+		http.Error(w, "Failed to save episode", http.StatusUnauthorized)
+		return
+	}
+
+	ref, code, err := getEpisodeFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.SaveEpisode error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("SeriesHandler.SaveEpisode error reading body", "error", err)
+		http.Error(w, "Failed to save episode", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var saveRequest seriesrequest.SaveEpisodeRequest
+	err = json.Unmarshal(body, &saveRequest)
+	if err != nil {
+		slog.Error("SeriesHandler.SaveEpisode error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	episode := seriesdomain.NewEpisode(code.Episode, saveRequest.Title, saveRequest.Description,
+		airDate(saveRequest.AirYear, saveRequest.AirMonth, saveRequest.AirDay), saveRequest.RuntimeMinutes)
+	episode, err = s.seriesService.SaveEpisode(r.Context(), actorID, ref, code.Season, episode)
+	if err != nil {
+		slog.Error("SeriesHandler.SaveEpisode error saving episode", "error", err)
+		writeSeriesError(w, err, "Failed to save episode")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(w).Encode(seriesresponse.NewEpisodeResponse(episode)); err != nil {
+		slog.Error("SeriesHandler.SaveEpisode error encoding response", "error", err)
+		return
+	}
+}
+
+func (s *SeriesHandler) DeleteEpisode(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SeriesHandler.DeleteEpisode called")
+
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.DeleteEpisode error extracting user id", "error", err)
+		http.Error(w, "Failed to delete episode", http.StatusUnauthorized)
+		return
+	}
+
+	ref, code, err := getEpisodeFromReq(r)
+	if err != nil {
+		slog.Error("SeriesHandler.DeleteEpisode error getting parameters", "error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	err = s.seriesService.DeleteEpisode(r.Context(), actorID, ref, code)
+	if err != nil {
+		slog.Error("SeriesHandler.DeleteEpisode error deleting episode", "error", err)
+		writeSeriesError(w, err, "Failed to delete episode")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parsePage(r *http.Request) (int, int, error) {
+	query := r.URL.Query()
+	limit := movieobject.DefaultMovieListLimit
+	offset := 0
+	var err error
+	if s := query.Get("limit"); s != "" {
+		limit, err = strconv.Atoi(s)
+		if err != nil || limit <= 0 || limit > movieobject.MaxMovieListLimit {
+			return 0, 0, error2.ErrSeriesDataValidationFailed
+		}
+	}
+	if s := query.Get("offset"); s != "" {
+		offset, err = strconv.Atoi(s)
+		if err != nil || offset < 0 {
+			return 0, 0, error2.ErrSeriesDataValidationFailed
+		}
+	}
+	return limit, offset, nil
+}
+
+func getSeasonFromReq(r *http.Request) (object.SeriesRef, int, error) {
+	ref, err := object.GetSeriesRefFromReq(r)
+	if err != nil {
+		return object.SeriesRef{}, 0, err
+	}
+	number, err := strconv.Atoi(r.PathValue("season"))
+	if err != nil || number < 0 {
+		return object.SeriesRef{}, 0, error2.ErrEpisodeCodeIsNotValid
+	}
+	return ref, number, nil
+}
+
+func getEpisodeFromReq(r *http.Request) (object.SeriesRef, object.EpisodeCode, error) {
+	ref, season, err := getSeasonFromReq(r)
+	if err != nil {
+		return object.SeriesRef{}, object.EpisodeCode{}, err
+	}
+	episode, err := strconv.Atoi(r.PathValue("episode"))
+	if err != nil {
+		return object.SeriesRef{}, object.EpisodeCode{}, error2.ErrEpisodeCodeIsNotValid
+	}
+	code, err := object.NewEpisodeCode(season, episode)
+	if err != nil {
+		return object.SeriesRef{}, object.EpisodeCode{}, err
+	}
+	return ref, code, nil
+}
+
+func airDate(year, month, day int) time.Time {
+	if year == 0 {
+		return time.Time{}
+	}
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+func writeSeriesError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, error2.ErrSeriesIsNotFound) {
+		http.Error(w, "Series is not found", http.StatusNotFound)
+	} else if errors.Is(err, error2.ErrSeasonIsNotFound) {
+		http.Error(w, "Season is not found", http.StatusNotFound)
+	} else if errors.Is(err, error2.ErrEpisodeIsNotFound) {
+		http.Error(w, "Episode is not found", http.StatusNotFound)
+	} else if errors.Is(err, error2.ErrSeriesAlreadyExists) || errors.Is(err, error2.ErrSeriesSlugAlreadyExists) {
+		http.Error(w, "Series with this title and start year already exists", http.StatusConflict)
+	} else if errors.Is(err, error2.ErrSeriesDataValidationFailed) {
+		http.Error(w, "Invalid series data", http.StatusBadRequest)
+	} else if errors.Is(err, usererror.ErrPermissionDenied) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	} else {
+		http.Error(w, message, http.StatusInternalServerError)
+	}
+}
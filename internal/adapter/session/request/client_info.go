@@ -0,0 +1,80 @@
+package request
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/session/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/session/object"
+)
+
+type ClientInfoResolver struct {
+	trustedProxies []netip.Prefix
+}
+
+func NewClientInfoResolver(trustedProxies []string) (*ClientInfoResolver, error) {
+	prefixes := make([]netip.Prefix, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		proxy = strings.TrimSpace(proxy)
+		if strings.Contains(proxy, "/") {
+			prefix, err := netip.ParsePrefix(proxy)
+			if err != nil {
+				return nil, error2.ErrTrustedProxyIsNotValid
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(proxy)
+		if err != nil {
+			return nil, error2.ErrTrustedProxyIsNotValid
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return &ClientInfoResolver{trustedProxies: prefixes}, nil
+}
+
+func (c *ClientInfoResolver) FromReq(r *http.Request) object.ClientInfo {
+	return object.NewClientInfo(r.UserAgent(), c.clientIP(r))
+}
+
+func (c *ClientInfoResolver) clientIP(r *http.Request) string {
+	remote := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		remote = host
+	}
+	remoteAddr, err := netip.ParseAddr(remote)
+	if err != nil || !c.isTrusted(remoteAddr) {
+		return remote
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	client := remote
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		addr, err := netip.ParseAddr(hop)
+		if err != nil {
+			break
+		}
+		client = addr.Unmap().String()
+		if !c.isTrusted(addr) {
+			break
+		}
+	}
+	return client
+}
+
+func (c *ClientInfoResolver) isTrusted(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range c.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
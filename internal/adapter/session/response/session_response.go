@@ -0,0 +1,41 @@
+package sessionresponse
+
+import (
+	"time"
+
+	sessiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/session"
+)
+
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+type SessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+func NewSessionsResponse(sessions []*sessiondomain.Session, currentID string) SessionsResponse {
+	response := SessionsResponse{Sessions: make([]SessionResponse, 0, len(sessions))}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, SessionResponse{
+			ID:         session.FamilyID.ID(),
+			UserAgent:  session.UserAgent,
+			IP:         session.IP,
+			CreatedAt:  session.StartedAt,
+			LastSeenAt: session.LastSeenAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.FamilyID.ID() == currentID,
+		})
+	}
+	return response
+}
+
+type RevokedSessionsResponse struct {
+	Revoked int `json:"revoked"`
+}
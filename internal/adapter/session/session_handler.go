@@ -0,0 +1,178 @@
+package session
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/session/request"
+	sessionresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/session/response"
+	userresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/user/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	sessiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/session"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/session/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/session/object"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+)
+
+type SessionHandler struct {
+	sessionService sessiondomain.Service
+	clientInfo     *request.ClientInfoResolver
+}
+
+func NewSessionHandler(sessionService sessiondomain.Service, clientInfo *request.ClientInfoResolver) *SessionHandler {
+	return &SessionHandler{sessionService: sessionService, clientInfo: clientInfo}
+}
+
+func (s *SessionHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SessionHandler.Refresh called")
+	var refreshRequest request.RefreshRequest
+	body, err := io.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		slog.Error("Error reading body", "error", err)
+		http.Error(w, "Failed to refresh token", http.StatusInternalServerError)
+		return
+	}
+
+	err = json.Unmarshal(body, &refreshRequest)
+	if err != nil {
+		slog.Error("Error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	token, err := object.NewRefreshToken(refreshRequest.RefreshToken)
+	if err != nil {
+		slog.Error("Error parsing refresh token", "error", err)
+		http.Error(w, "Invalid refresh token", http.StatusUnauthorized)
+		return
+	}
+
+	authResp, err := s.sessionService.Refresh(r.Context(), token, s.clientInfo.FromReq(r))
+	if err != nil {
+		slog.Error("Error refreshing token", "error", err)
+		if errors.Is(err, error2.ErrRefreshTokenReused) {
+			http.Error(w, "Refresh token reuse detected, session revoked", http.StatusUnauthorized)
+		} else if errors.Is(err, error2.ErrRefreshTokenIsNotValid) || errors.Is(err, usererror.ErrUserIsNotFound) {
+			http.Error(w, "Invalid refresh token", http.StatusUnauthorized)
+		} else {
+			http.Error(w, "Failed to refresh token", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := userresponse.NewUserAuthResponse(authResp)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		slog.Error("Error encoding response", "error", err)
+		return
+	}
+}
+
+func (s *SessionHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SessionHandler.Logout called")
+	claims, ok := useridkey.ExtractTokenClaimsFromReq(r)
+	if !ok {
+		slog.Error("Token claims are missing in request context")
+		http.Error(w, "Authorization required", http.StatusUnauthorized)
+		return
+	}
+
+	err := s.sessionService.Logout(r.Context(), claims)
+	if err != nil {
+		slog.Error("Error logging out", "error", err)
+		http.Error(w, "Failed to logout", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *SessionHandler) GetSessions(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SessionHandler.GetSessions called")
+	claims, ok := useridkey.ExtractTokenClaimsFromReq(r)
+	if !ok {
+		slog.Error("Token claims are missing in request context")
+		http.Error(w, "Authorization required", http.StatusUnauthorized)
+		return
+	}
+
+	sessions, err := s.sessionService.ListSessions(r.Context(), claims.UserID())
+	if err != nil {
+		slog.Error("Error getting sessions", "error", err)
+		http.Error(w, "Failed to get sessions", http.StatusInternalServerError)
+		return
+	}
+
+	response := sessionresponse.NewSessionsResponse(sessions, claims.SessionID())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		slog.Error("Error encoding response", "error", err)
+		return
+	}
+}
+
+func (s *SessionHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SessionHandler.RevokeSession called")
+	userID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("Error extracting user id", "error", err)
+		http.Error(w, "Authorization required", http.StatusUnauthorized)
+		return
+	}
+
+	sessionID, err := object.NewSessionID(r.PathValue("id"))
+	if err != nil {
+		slog.Error("Error parsing session id", "error", err)
+		http.Error(w, "Invalid session id", http.StatusBadRequest)
+		return
+	}
+
+	err = s.sessionService.RevokeSession(r.Context(), userID, sessionID)
+	if err != nil {
+		slog.Error("Error revoking session", "error", err)
+		if errors.Is(err, error2.ErrSessionIsNotFound) {
+			http.Error(w, "Session not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to revoke session", http.StatusInternalServerError)
+		}
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *SessionHandler) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("SessionHandler.RevokeOtherSessions called")
+	claims, ok := useridkey.ExtractTokenClaimsFromReq(r)
+	if !ok {
+		slog.Error("Token claims are missing in request context")
+		http.Error(w, "Authorization required", http.StatusUnauthorized)
+		return
+	}
+
+	revoked, err := s.sessionService.RevokeOtherSessions(r.Context(), claims)
+	if err != nil {
+		slog.Error("Error revoking other sessions", "error", err)
+		if errors.Is(err, error2.ErrSessionIDIsNotValid) {
+			http.Error(w, "Token is not bound to a session", http.StatusBadRequest)
+		} else {
+			http.Error(w, "Failed to revoke sessions", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := sessionresponse.RevokedSessionsResponse{Revoked: revoked}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		slog.Error("Error encoding response", "error", err)
+		return
+	}
+}
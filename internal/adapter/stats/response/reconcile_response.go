@@ -0,0 +1,12 @@
+package statsresponse
+
+import statsdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/stats"
+
+type ReconcileResponse struct {
+	Movies  int `json:"movies"`
+	Reviews int `json:"reviews"`
+}
+
+func NewReconcileResponse(report *statsdomain.ReconcileReport) ReconcileResponse {
+	return ReconcileResponse{Movies: report.Movies, Reviews: report.Reviews}
+}
@@ -0,0 +1,5 @@
+package request
+
+type PasswordResetRequest struct {
+	Email string `json:"email"`
+}
@@ -0,0 +1,6 @@
+package request
+
+type UserChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
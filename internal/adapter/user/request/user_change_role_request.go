@@ -0,0 +1,6 @@
+package request
+
+type UserChangeRoleRequest struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+}
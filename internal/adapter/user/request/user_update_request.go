@@ -0,0 +1,7 @@
+package request
+
+type UserUpdateRequest struct {
+	Username        string `json:"username"`
+	Email           string `json:"email"`
+	CurrentPassword string `json:"current_password"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
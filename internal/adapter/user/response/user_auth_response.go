@@ -1,7 +1,21 @@
 package response
 
+import (
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
 type UserAuthResponse struct {
-	Token    string `json:"token"`
-	Username string `json:"username"`
-	Email    string `json:"email"`
+	Token        string    `json:"token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	RefreshToken string    `json:"refresh_token"`
+	Username     string    `json:"username"`
+	Email        string    `json:"email"`
+	Role         string    `json:"role"`
+}
+
+func NewUserAuthResponse(authResp *object.AuthResponse) UserAuthResponse {
+	return UserAuthResponse{Token: authResp.Token, ExpiresAt: authResp.ExpiresAt, RefreshToken: authResp.RefreshToken, Username: authResp.Username,
+		Email: authResp.Email, Role: authResp.Role.String()}
 }
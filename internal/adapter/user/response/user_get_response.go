@@ -3,4 +3,6 @@ package response
 type UserGetResponse struct {
 	Email    string `json:"email"`
 	Username string `json:"username"`
+	Role     string `json:"role"`
+	Verified bool   `json:"email_verified"`
 }
@@ -0,0 +1,189 @@
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/user/request"
+	userresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/user/response"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+func (u *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserHandler.UpdateUser called")
+	userID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("Error extracting user id", "error", err)
+		http.Error(w, "Failed to update user", http.StatusUnauthorized)
+		return
+	}
+
+	var updateRequest request.UserUpdateRequest
+	body, err := io.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		slog.Error("Error reading body", "error", err)
+		http.Error(w, "Failed to update user", http.StatusInternalServerError)
+		return
+	}
+
+	err = json.Unmarshal(body, &updateRequest)
+	if err != nil {
+		slog.Error("Error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	updateData := object.NewProfileUpdateData(updateRequest.Username, updateRequest.Email, updateRequest.CurrentPassword)
+	user, err := u.userService.UpdateProfile(r.Context(), userID, updateData)
+	if err != nil {
+		slog.Error("Error updating user", "error", err)
+		if errors.Is(err, usererror.ErrUserIsNotFound) {
+			http.Error(w, "User not found", http.StatusNotFound)
+		} else if errors.Is(err, usererror.ErrProfileUpdateIsEmpty) {
+			http.Error(w, "Nothing to update", http.StatusBadRequest)
+		} else if errors.Is(err, usererror.ErrUserNameValidationFailed) {
+			http.Error(w, "Username is invalid", http.StatusBadRequest)
+		} else if errors.Is(err, usererror.ErrUserEmailValidationFailed) {
+			http.Error(w, "Email is invalid", http.StatusBadRequest)
+		} else if errors.Is(err, usererror.ErrInvalidPassword) {
+			http.Error(w, "Current password is invalid", http.StatusForbidden)
+		} else if errors.Is(err, usererror.ErrUserEmailAlreadyExists) {
+			http.Error(w, "Email already exists", http.StatusConflict)
+		} else {
+			http.Error(w, "Failed to update user", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := userresponse.UserGetResponse{Username: user.Username(), Email: user.Email(), Role: user.Role().String(), Verified: user.EmailVerified()}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		slog.Error("Error encoding response", "error", err)
+		return
+	}
+}
+
+func (u *UserHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserHandler.ChangePassword called")
+	claims, ok := useridkey.ExtractTokenClaimsFromReq(r)
+	if !ok {
+		slog.Error("Error extracting token claims")
+		http.Error(w, "Failed to change password", http.StatusUnauthorized)
+		return
+	}
+
+	var changeRequest request.UserChangePasswordRequest
+	body, err := io.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		slog.Error("Error reading body", "error", err)
+		http.Error(w, "Failed to change password", http.StatusInternalServerError)
+		return
+	}
+
+	err = json.Unmarshal(body, &changeRequest)
+	if err != nil {
+		slog.Error("Error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	changeData := object.NewPasswordChangeData(changeRequest.CurrentPassword, changeRequest.NewPassword)
+	err = u.userService.ChangePassword(r.Context(), claims, changeData)
+	if err != nil {
+		slog.Error("Error changing password", "error", err)
+		if errors.Is(err, usererror.ErrUserIsNotFound) {
+			http.Error(w, "User not found", http.StatusNotFound)
+		} else if errors.Is(err, usererror.ErrUserPasswordValidationFailed) {
+			http.Error(w, "New password is invalid", http.StatusBadRequest)
+		} else if errors.Is(err, usererror.ErrInvalidPassword) {
+			http.Error(w, "Current password is invalid", http.StatusForbidden)
+		} else {
+			http.Error(w, "Failed to change password", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (u *UserHandler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserHandler.RequestPasswordReset called")
+	var resetRequest request.PasswordResetRequest
+	body, err := io.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		slog.Error("Error reading body", "error", err)
+		http.Error(w, "Failed to request password reset", http.StatusInternalServerError)
+		return
+	}
+
+	err = json.Unmarshal(body, &resetRequest)
+	if err != nil {
+		slog.Error("Error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	err = u.userService.RequestPasswordReset(r.Context(), resetRequest.Email)
+	if err != nil {
+		slog.Error("Error requesting password reset", "error", err)
+		if errors.Is(err, usererror.ErrUserEmailValidationFailed) {
+			http.Error(w, "Email is invalid", http.StatusBadRequest)
+		} else {
+			http.Error(w, "Failed to request password reset", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (u *UserHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserHandler.ResetPassword called")
+	var confirmRequest request.PasswordResetConfirmRequest
+	body, err := io.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		slog.Error("Error reading body", "error", err)
+		http.Error(w, "Failed to reset password", http.StatusInternalServerError)
+		return
+	}
+
+	err = json.Unmarshal(body, &confirmRequest)
+	if err != nil {
+		slog.Error("Error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	token, err := object.NewPasswordResetToken(confirmRequest.Token)
+	if err != nil {
+		slog.Error("Error parsing reset token", "error", err)
+		http.Error(w, "Reset token is invalid or expired", http.StatusBadRequest)
+		return
+	}
+
+	err = u.userService.ResetPassword(r.Context(), object.NewPasswordResetData(token, confirmRequest.NewPassword))
+	if err != nil {
+		slog.Error("Error resetting password", "error", err)
+		if errors.Is(err, usererror.ErrPasswordResetTokenIsNotValid) {
+			http.Error(w, "Reset token is invalid or expired", http.StatusBadRequest)
+		} else if errors.Is(err, usererror.ErrUserPasswordValidationFailed) {
+			http.Error(w, "New password is invalid", http.StatusBadRequest)
+		} else {
+			http.Error(w, "Failed to reset password", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	sessionrequest "github.com/Vlad-Ali/Movies-service-back/internal/adapter/session/request"
 	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/user/request"
 	userresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/user/response"
 	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
@@ -17,10 +18,11 @@ import (
 
 type UserHandler struct {
 	userService userdomain.Service
+	clientInfo  *sessionrequest.ClientInfoResolver
 }
 
-func NewUserHandler(userService userdomain.Service) *UserHandler {
-	return &UserHandler{userService}
+func NewUserHandler(userService userdomain.Service, clientInfo *sessionrequest.ClientInfoResolver) *UserHandler {
+	return &UserHandler{userService: userService, clientInfo: clientInfo}
 }
 
 func (u *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
@@ -88,7 +90,7 @@ func (u *UserHandler) Authenticate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	authData := object.NewAuthenticationData(authRequest.Password, authRequest.Email)
-	authResp, err := u.userService.Authenticate(r.Context(), authData)
+	authResp, err := u.userService.Authenticate(r.Context(), authData, u.clientInfo.FromReq(r))
 	if err != nil {
 		slog.Error("Error authenticating user", "error", err)
 		if errors.Is(err, usererror.ErrUserPasswordValidationFailed) || errors.Is(err, usererror.ErrUserEmailValidationFailed) {
@@ -101,7 +103,7 @@ func (u *UserHandler) Authenticate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response := userresponse.UserAuthResponse{Token: authResp.Token, Username: authResp.Username, Email: authResp.Email}
+	response := userresponse.NewUserAuthResponse(authResp)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	err = json.NewEncoder(w).Encode(response)
@@ -131,7 +133,7 @@ func (u *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response := userresponse.UserGetResponse{Username: user.Username(), Email: user.Email()}
+	response := userresponse.UserGetResponse{Username: user.Username(), Email: user.Email(), Role: user.Role().String(), Verified: user.EmailVerified()}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	err = json.NewEncoder(w).Encode(response)
@@ -140,3 +142,66 @@ func (u *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+func (u *UserHandler) ChangeRole(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserHandler.ChangeRole called")
+	actorID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("Error extracting user id", "error", err)
+		http.Error(w, "Failed to change role", http.StatusUnauthorized)
+		return
+	}
+
+	var changeRoleRequest request.UserChangeRoleRequest
+	body, err := io.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		slog.Error("Error reading body", "error", err)
+		http.Error(w, "Failed to change role", http.StatusInternalServerError)
+		return
+	}
+
+	err = json.Unmarshal(body, &changeRoleRequest)
+	if err != nil {
+		slog.Error("Error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	targetID, err := object.NewUserID(changeRoleRequest.UserID)
+	if err != nil {
+		slog.Error("Error parsing target user id", "error", err)
+		http.Error(w, "Invalid user id", http.StatusBadRequest)
+		return
+	}
+
+	role, err := object.NewRole(changeRoleRequest.Role)
+	if err != nil {
+		slog.Error("Error parsing role", "error", err)
+		http.Error(w, "Invalid role", http.StatusBadRequest)
+		return
+	}
+
+	err = u.userService.ChangeRole(r.Context(), actorID, targetID, role)
+	if err != nil {
+		slog.Error("Error changing role", "error", err)
+		if errors.Is(err, usererror.ErrUserIsNotFound) {
+			http.Error(w, "User not found", http.StatusNotFound)
+		} else if errors.Is(err, usererror.ErrPermissionDenied) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+		} else if errors.Is(err, usererror.ErrCannotChangeOwnRole) {
+			http.Error(w, "Cannot change own role", http.StatusBadRequest)
+		} else {
+			http.Error(w, "Failed to change role", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write([]byte("Role changed successfully"))
+	if err != nil {
+		slog.Error("Error writing body", "error", err)
+		return
+	}
+}
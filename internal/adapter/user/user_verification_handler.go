@@ -0,0 +1,72 @@
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/user/request"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+)
+
+func (u *UserHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserHandler.VerifyEmail called")
+	var verifyRequest request.VerifyEmailRequest
+	body, err := io.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		slog.Error("Error reading body", "error", err)
+		http.Error(w, "Failed to verify email", http.StatusInternalServerError)
+		return
+	}
+
+	err = json.Unmarshal(body, &verifyRequest)
+	if err != nil {
+		slog.Error("Error unmarshalling body", "error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	err = u.userService.VerifyEmail(r.Context(), verifyRequest.Token)
+	if err != nil {
+		slog.Error("Error verifying email", "error", err)
+		if errors.Is(err, usererror.ErrVerificationTokenIsNotValid) {
+			http.Error(w, "Verification token is invalid or expired", http.StatusBadRequest)
+		} else {
+			http.Error(w, "Failed to verify email", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (u *UserHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserHandler.ResendVerification called")
+	userID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("Error extracting user id", "error", err)
+		http.Error(w, "Failed to resend verification", http.StatusUnauthorized)
+		return
+	}
+
+	err = u.userService.ResendVerification(r.Context(), userID)
+	if err != nil {
+		slog.Error("Error resending verification", "error", err)
+		if errors.Is(err, usererror.ErrUserIsNotFound) {
+			http.Error(w, "User not found", http.StatusNotFound)
+		} else if errors.Is(err, usererror.ErrEmailAlreadyVerified) {
+			http.Error(w, "Email is already verified", http.StatusConflict)
+		} else if errors.Is(err, usererror.ErrVerificationResendThrottled) {
+			http.Error(w, "Verification email was sent recently, try again later", http.StatusTooManyRequests)
+		} else {
+			http.Error(w, "Failed to resend verification", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -4,13 +4,22 @@ import (
 	"log/slog"
 	"net/http"
 
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type UserIDKey struct{}
 
+type UserRoleKey struct{}
+
+type TokenClaimsKey struct{}
+
 func ExtractUserIdFromReq(r *http.Request) (object.UserID, error) {
-	id := r.Context().Value(UserIDKey{}).(string)
+	id, ok := r.Context().Value(UserIDKey{}).(string)
+	if !ok {
+		slog.Error("User id is missing in request context")
+		return object.UserID{}, usererror.ErrUserIDCreatingIsNotValid
+	}
 	userID, err := object.NewUserID(id)
 	if err != nil {
 		slog.Error("Error while extracting user id from request", "error", err)
@@ -18,3 +27,13 @@ func ExtractUserIdFromReq(r *http.Request) (object.UserID, error) {
 	}
 	return userID, nil
 }
+
+func ExtractUserRoleFromReq(r *http.Request) (object.Role, bool) {
+	role, ok := r.Context().Value(UserRoleKey{}).(object.Role)
+	return role, ok
+}
+
+func ExtractTokenClaimsFromReq(r *http.Request) (object.TokenClaims, bool) {
+	claims, ok := r.Context().Value(TokenClaimsKey{}).(object.TokenClaims)
+	return claims, ok
+}
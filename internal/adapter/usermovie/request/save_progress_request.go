@@ -0,0 +1,7 @@
+package request
+
+type SaveProgressRequest struct {
+	EpisodeCode string `json:"episode_code"`
+	Season      *int   `json:"season"`
+	Episode     *int   `json:"episode"`
+}
@@ -1,8 +1,14 @@
 package request
 
-import object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+import (
+	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
+)
 
 type UserMovieSaveRatingRequest struct {
+	MovieID   string            `json:"movie_id"`
+	MovieSlug string            `json:"movie_slug"`
 	MovieInfo object2.MovieInfo `json:"movie_info"`
 	Rating    int               `json:"rating"`
+	titleobject.SeriesTargetInfo
 }
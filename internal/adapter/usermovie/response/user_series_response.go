@@ -0,0 +1,7 @@
+package response
+
+import "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
+
+type UserSeriesResponse struct {
+	UserSeries []*usermovie.SeriesUserInfo `json:"userSeries"`
+}
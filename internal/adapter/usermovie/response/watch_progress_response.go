@@ -0,0 +1,36 @@
+package response
+
+import (
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
+)
+
+type WatchProgressResponse struct {
+	SeriesID        string    `json:"series_id"`
+	EpisodeID       string    `json:"episode_id"`
+	EpisodeCode     string    `json:"episode_code"`
+	EpisodeTitle    string    `json:"episode_title"`
+	NextEpisodeCode string    `json:"next_episode_code,omitempty"`
+	WatchedEpisodes int       `json:"watched_episodes"`
+	TotalEpisodes   int       `json:"total_episodes"`
+	Finished        bool      `json:"finished"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func NewWatchProgressResponse(progress *usermovie.WatchProgress) WatchProgressResponse {
+	response := WatchProgressResponse{
+		SeriesID:        progress.SeriesID.ID(),
+		EpisodeID:       progress.EpisodeID.ID(),
+		EpisodeCode:     progress.Code.String(),
+		EpisodeTitle:    progress.EpisodeTitle,
+		WatchedEpisodes: progress.WatchedEpisodes,
+		TotalEpisodes:   progress.TotalEpisodes,
+		Finished:        progress.IsFinished(),
+		UpdatedAt:       progress.UpdatedAt,
+	}
+	if progress.Next != nil {
+		response.NextEpisodeCode = progress.Next.String()
+	}
+	return response
+}
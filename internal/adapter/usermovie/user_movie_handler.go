@@ -10,18 +10,24 @@ import (
 	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/useridkey"
 	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/usermovie/request"
 	response2 "github.com/Vlad-Ali/Movies-service-back/internal/adapter/usermovie/response"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
 	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	serieserror "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/error"
+	seriesobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	titleerror "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/error"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
 	error3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie/error"
 )
 
 type UserMovieHandler struct {
-	userMovieService usermovie.Service
+	userMovieService   usermovie.Service
+	translationService moviedomain.TranslationService
 }
 
-func NewUserMovieHandler(userMovieService usermovie.Service) *UserMovieHandler {
-	return &UserMovieHandler{userMovieService}
+func NewUserMovieHandler(userMovieService usermovie.Service, translationService moviedomain.TranslationService) *UserMovieHandler {
+	return &UserMovieHandler{userMovieService: userMovieService, translationService: translationService}
 }
 
 func (u *UserMovieHandler) SaveRating(w http.ResponseWriter, r *http.Request) {
@@ -48,12 +54,22 @@ func (u *UserMovieHandler) SaveRating(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = u.userMovieService.SaveRating(r.Context(), userID, saveRatingRequest.MovieInfo, saveRatingRequest.Rating)
+	targetRef, err := titleobject.NewTargetRef(saveRatingRequest.MovieID, saveRatingRequest.MovieSlug, saveRatingRequest.MovieInfo, saveRatingRequest.SeriesTargetInfo)
+	if err != nil {
+		slog.Error("UserMovieHandler.SaveRating  Error with target reference: ", "Error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	err = u.userMovieService.SaveRating(r.Context(), userID, targetRef, saveRatingRequest.Rating)
 	if err != nil {
 		slog.Error("UserMovieHandler.SaveRating  Error saving rating: ", "Error", err)
 		if errors.Is(err, error2.ErrMovieIsNotFound) {
 			http.Error(w, "Movie is not found", http.StatusNotFound)
 			return
+		} else if errors.Is(err, serieserror.ErrSeriesIsNotFound) || errors.Is(err, serieserror.ErrSeasonIsNotFound) || errors.Is(err, serieserror.ErrEpisodeIsNotFound) {
+			http.Error(w, "Series, season or episode is not found", http.StatusNotFound)
+			return
 		} else if errors.Is(err, error3.ErrInvalidRating) {
 			http.Error(w, "Invalid rating", http.StatusBadRequest)
 			return
@@ -98,12 +114,22 @@ func (u *UserMovieHandler) SaveListType(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	err = u.userMovieService.SaveListType(r.Context(), userID, saveListTypeRequest.MovieInfo, saveListTypeRequest.ListType)
+	targetRef, err := titleobject.NewTargetRef(saveListTypeRequest.MovieID, saveListTypeRequest.MovieSlug, saveListTypeRequest.MovieInfo, saveListTypeRequest.SeriesTargetInfo)
+	if err != nil {
+		slog.Error("UserMovieHandler.SaveListType Error with target reference: ", "Error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	err = u.userMovieService.SaveListType(r.Context(), userID, targetRef, saveListTypeRequest.ListType)
 	if err != nil {
 		slog.Error("UserMovieHandler.SaveListType Error saving list type: ", "Error", err)
 		if errors.Is(err, error2.ErrMovieIsNotFound) {
 			http.Error(w, "Movie is not found", http.StatusNotFound)
 			return
+		} else if errors.Is(err, serieserror.ErrSeriesIsNotFound) || errors.Is(err, serieserror.ErrSeasonIsNotFound) || errors.Is(err, serieserror.ErrEpisodeIsNotFound) {
+			http.Error(w, "Series, season or episode is not found", http.StatusNotFound)
+			return
 		} else if errors.Is(err, error3.ErrListTypeIsIncorrect) {
 			http.Error(w, "Invalid list-type", http.StatusBadRequest)
 			return
@@ -134,7 +160,7 @@ func (u *UserMovieHandler) GetUserMovie(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	movieInfo, err := object.GetMovieInfoFromReq(r)
+	movieRef, err := object.GetMovieRefFromReq(r)
 
 	if err != nil {
 		slog.Error("UserMovieHandler.GetUserMovie Error getting parameters: ", "Error", err)
@@ -143,7 +169,7 @@ func (u *UserMovieHandler) GetUserMovie(w http.ResponseWriter, r *http.Request)
 	}
 
 	listType := r.URL.Query().Get("listType")
-	movie, err := u.userMovieService.FindMovieByUser(r.Context(), userID, movieInfo, listType)
+	movie, err := u.userMovieService.FindMovieByUser(r.Context(), userID, movieRef, listType)
 	if err != nil {
 		slog.Error("UserMovieHandler.GetUserMovie  Error finding movie: ", "Error", err)
 		if errors.Is(err, error2.ErrMovieIsNotFound) {
@@ -158,6 +184,14 @@ func (u *UserMovieHandler) GetUserMovie(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	err = u.translationService.Localize(r.Context(), object.GetLocaleFromReq(r), movie)
+	if err != nil {
+		slog.Error("UserMovieHandler.GetUserMovie Error localizing movie: ", "Error", err)
+		http.Error(w, "Failed to get user movie", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Vary", "Accept-Language")
 	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(movie)
@@ -190,7 +224,19 @@ func (u *UserMovieHandler) GetUserMovies(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	items := make([]moviedomain.Localizable, 0, len(movies))
+	for _, movie := range movies {
+		items = append(items, movie)
+	}
+	err = u.translationService.Localize(r.Context(), object.GetLocaleFromReq(r), items...)
+	if err != nil {
+		slog.Error("UserMovieHandler.GetUserMovies Error localizing movies: ", "Error", err)
+		http.Error(w, "Failed to get user movies", http.StatusInternalServerError)
+		return
+	}
+
 	response := response2.UserMoviesResponse{UserMovies: movies}
+	w.Header().Set("Vary", "Accept-Language")
 	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(response)
@@ -199,3 +245,221 @@ func (u *UserMovieHandler) GetUserMovies(w http.ResponseWriter, r *http.Request)
 		return
 	}
 }
+
+func (u *UserMovieHandler) GetUserSeries(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserMovieHandler.GetUserSeries called")
+
+	userID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("Error while extracting user id from request: ", "Error", err)
+		http.Error(w, "Failed to get user series", http.StatusUnauthorized)
+		return
+	}
+
+	targetRef, err := titleobject.GetTargetRefFromReq(r)
+	if err != nil {
+		slog.Error("UserMovieHandler.GetUserSeries Error getting parameters: ", "Error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	listType := r.URL.Query().Get("listType")
+	series, err := u.userMovieService.FindSeriesTargetByUser(r.Context(), userID, targetRef, listType)
+	if err != nil {
+		slog.Error("UserMovieHandler.GetUserSeries Error finding series: ", "Error", err)
+		if errors.Is(err, serieserror.ErrSeriesIsNotFound) || errors.Is(err, serieserror.ErrSeasonIsNotFound) || errors.Is(err, serieserror.ErrEpisodeIsNotFound) {
+			http.Error(w, "Series, season or episode is not found", http.StatusNotFound)
+		} else if errors.Is(err, error3.ErrUserMovieIsNotFound) {
+			http.Error(w, "This series is not found in this list", http.StatusBadRequest)
+		} else if errors.Is(err, error3.ErrListTypeIsIncorrect) || errors.Is(err, titleerror.ErrTargetIsNotValid) {
+			http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		} else {
+			http.Error(w, "Failed to get user series", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(series)
+	if err != nil {
+		slog.Error("UserMovieHandler.GetUserSeries Error writing body: ", "Error", err)
+		return
+	}
+}
+
+func (u *UserMovieHandler) GetUserSeriesList(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserMovieHandler.GetUserSeriesList called")
+
+	userID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("Error while extracting user id from request: ", "Error", err)
+		http.Error(w, "Failed to get user series", http.StatusUnauthorized)
+		return
+	}
+
+	listType := r.URL.Query().Get("listType")
+	series, err := u.userMovieService.FindSeriesByUserAndListType(r.Context(), userID, listType)
+	if err != nil {
+		slog.Error("UserMovieHandler.GetUserSeriesList Error finding series: ", "Error", err)
+		if errors.Is(err, error3.ErrListTypeIsIncorrect) {
+			http.Error(w, "Invalid list-type", http.StatusBadRequest)
+		} else {
+			http.Error(w, "Failed to get user series", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := response2.UserSeriesResponse{UserSeries: series}
+	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		slog.Error("UserMovieHandler.GetUserSeriesList Error writing body: ", "Error", err)
+		return
+	}
+}
+
+func (u *UserMovieHandler) SaveProgress(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserMovieHandler.SaveProgress called")
+
+	userID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("Error while extracting user id from request: ", "Error", err)
+		http.Error(w, "Failed to save progress", http.StatusUnauthorized)
+		return
+	}
+
+	seriesRef, err := seriesobject.GetSeriesRefFromReq(r)
+	if err != nil {
+		slog.Error("UserMovieHandler.SaveProgress Error getting parameters: ", "Error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		slog.Error("UserMovieHandler.SaveProgress Error reading body: ", "Error", err)
+		http.Error(w, "Failed to save progress", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var saveProgressRequest request.SaveProgressRequest
+	err = json.Unmarshal(body, &saveProgressRequest)
+	if err != nil {
+		slog.Error("UserMovieHandler.SaveProgress Error unmarshalling body: ", "Error", err)
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	code, err := progressEpisodeCode(saveProgressRequest)
+	if err != nil {
+		slog.Error("UserMovieHandler.SaveProgress Error with episode code: ", "Error", err)
+		http.Error(w, "Invalid episode code", http.StatusBadRequest)
+		return
+	}
+
+	progress, err := u.userMovieService.SaveProgress(r.Context(), userID, seriesRef, code)
+	if err != nil {
+		slog.Error("UserMovieHandler.SaveProgress Error saving progress: ", "Error", err)
+		if errors.Is(err, serieserror.ErrSeriesIsNotFound) {
+			http.Error(w, "Series is not found", http.StatusNotFound)
+		} else if errors.Is(err, serieserror.ErrEpisodeIsNotFound) {
+			http.Error(w, "Episode is not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to save progress", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response2.NewWatchProgressResponse(progress))
+	if err != nil {
+		slog.Error("UserMovieHandler.SaveProgress Error writing body: ", "Error", err)
+		return
+	}
+}
+
+func (u *UserMovieHandler) GetProgress(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserMovieHandler.GetProgress called")
+
+	userID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("Error while extracting user id from request: ", "Error", err)
+		http.Error(w, "Failed to get progress", http.StatusUnauthorized)
+		return
+	}
+
+	seriesRef, err := seriesobject.GetSeriesRefFromReq(r)
+	if err != nil {
+		slog.Error("UserMovieHandler.GetProgress Error getting parameters: ", "Error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	progress, err := u.userMovieService.FindProgress(r.Context(), userID, seriesRef)
+	if err != nil {
+		slog.Error("UserMovieHandler.GetProgress Error finding progress: ", "Error", err)
+		if errors.Is(err, serieserror.ErrSeriesIsNotFound) {
+			http.Error(w, "Series is not found", http.StatusNotFound)
+		} else if errors.Is(err, error3.ErrWatchProgressIsNotFound) {
+			http.Error(w, "Progress is not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to get progress", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response2.NewWatchProgressResponse(progress))
+	if err != nil {
+		slog.Error("UserMovieHandler.GetProgress Error writing body: ", "Error", err)
+		return
+	}
+}
+
+func (u *UserMovieHandler) DeleteProgress(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("UserMovieHandler.DeleteProgress called")
+
+	userID, err := useridkey.ExtractUserIdFromReq(r)
+	if err != nil {
+		slog.Error("Error while extracting user id from request: ", "Error", err)
+		http.Error(w, "Failed to delete progress", http.StatusUnauthorized)
+		return
+	}
+
+	seriesRef, err := seriesobject.GetSeriesRefFromReq(r)
+	if err != nil {
+		slog.Error("UserMovieHandler.DeleteProgress Error getting parameters: ", "Error", err)
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	err = u.userMovieService.DeleteProgress(r.Context(), userID, seriesRef)
+	if err != nil {
+		slog.Error("UserMovieHandler.DeleteProgress Error deleting progress: ", "Error", err)
+		if errors.Is(err, serieserror.ErrSeriesIsNotFound) {
+			http.Error(w, "Series is not found", http.StatusNotFound)
+		} else if errors.Is(err, error3.ErrWatchProgressIsNotFound) {
+			http.Error(w, "Progress is not found", http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to delete progress", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func progressEpisodeCode(saveProgressRequest request.SaveProgressRequest) (seriesobject.EpisodeCode, error) {
+	if saveProgressRequest.EpisodeCode != "" {
+		return seriesobject.ParseEpisodeCode(saveProgressRequest.EpisodeCode)
+	}
+	if saveProgressRequest.Season == nil || saveProgressRequest.Episode == nil {
+		return seriesobject.EpisodeCode{}, serieserror.ErrEpisodeCodeIsNotValid
+	}
+	return seriesobject.NewEpisodeCode(*saveProgressRequest.Season, *saveProgressRequest.Episode)
+}
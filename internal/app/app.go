@@ -9,10 +9,19 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
+	sessionrequest "github.com/Vlad-Ali/Movies-service-back/internal/adapter/session/request"
+	movieservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/movie"
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/user/verification"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/blobstore"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/embedder"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/mailer"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/metadata"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/signingkey"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
@@ -30,6 +39,7 @@ type App struct {
 	server       *http.Server
 	db           *sql.DB
 	config       *Config
+	jobs         sync.WaitGroup
 }
 
 func NewApp() (*App, error) {
@@ -59,10 +69,67 @@ func NewApp() (*App, error) {
 		return nil, err
 	}
 
+	metadataProvider, err := metadata.NewMetadataProvider(cfg.MetadataConfig)
+	if err != nil {
+		db.Close()
+		slog.Error("Error creating metadata provider", "error", err)
+		return nil, err
+	}
+
+	blobStore, err := blobstore.NewBlobStore(cfg.ImagesConfig)
+	if err != nil {
+		db.Close()
+		slog.Error("Error creating blob store", "error", err)
+		return nil, err
+	}
+
+	textEmbedder, err := embedder.NewEmbedder(cfg.EmbedderConfig)
+	if err != nil {
+		db.Close()
+		slog.Error("Error creating embedder", "error", err)
+		return nil, err
+	}
+
+	keyRing, err := signingkey.NewKeyRing(cfg.SigningKeyConfig, cfg.SecretKey)
+	if err != nil {
+		db.Close()
+		slog.Error("Error loading token signing keys", "error", err)
+		return nil, err
+	}
+
+	mailSender, err := mailer.NewMailer(cfg.MailerConfig)
+	if err != nil {
+		db.Close()
+		slog.Error("Error creating mailer", "error", err)
+		return nil, err
+	}
+
+	verificationSigner, err := verification.NewHMACSigner(cfg.AccountConfig.VerificationSecret, cfg.SecretKey)
+	if err != nil {
+		db.Close()
+		slog.Error("Error creating email verification signer", "error", err)
+		return nil, err
+	}
+
 	txUser := transactionmanager.NewTransactionUser(db)
 	repos := NewRepositories(db)
-	services := NewServices(db, repos, cfg.SecretKey, txUser, cfg.ModelConfig)
-	handlers := NewHandlers(services)
+	similarityStrategy, err := movieservice.NewSimilarityStrategy(repos.MovieRepository, cfg.SimilarityConfig)
+	if err != nil {
+		db.Close()
+		slog.Error("Error creating similarity strategy", "error", err)
+		return nil, err
+	}
+	services := NewServices(db, repos, keyRing, txUser, cfg.ModelConfig, metadataProvider, blobStore, cfg.ImagesConfig.MaxUploadBytes, cfg.RatingConfig, cfg.TrendingConfig,
+		cfg.RecommendationConfig, similarityStrategy,
+		textEmbedder, cfg.EmbedderConfig.BatchSize, cfg.SessionConfig,
+		mailSender, cfg.MailerConfig.BatchSize, verificationSigner, cfg.AccountConfig)
+	clientInfo, err := sessionrequest.NewClientInfoResolver(cfg.TrustedProxies)
+	if err != nil {
+		db.Close()
+		slog.Error("Error parsing trusted proxies", "error", err)
+		return nil, err
+	}
+	handlers := NewHandlers(services, cfg.ImagesConfig.MaxUploadBytes, cfg.ImportConfig.MaxUploadBytes, clientInfo)
 	handler := handlers.registerRoutes(cfg)
 
 	slog.Info("Successfully connected to PostgreSQL")
@@ -83,6 +150,21 @@ func (a *App) Start() error {
 		return err
 	}
 
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	if a.config.MetadataConfig.Provider != "" && a.config.MetadataConfig.JobInterval > 0 {
+		a.runEnrichmentJob(jobsCtx)
+	}
+	a.runSessionJob(jobsCtx)
+	a.runTrendingJob(jobsCtx)
+	a.runRecommendationJob(jobsCtx)
+	if a.config.EmbedderConfig.Provider != "" {
+		a.runEmbeddingJob(jobsCtx)
+	}
+	if a.config.MailerConfig.Provider != "" {
+		a.runMailJob(jobsCtx)
+	}
+
 	go func() {
 		slog.Info(fmt.Sprintf("Server started at %s", a.server.Addr))
 		if err := a.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -93,6 +175,7 @@ func (a *App) Start() error {
 
 	<-signalChan
 	slog.Info("Shutting down...")
+	stopJobs()
 
 	slog.Info("Shutting down server...")
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
@@ -102,6 +185,14 @@ func (a *App) Start() error {
 		slog.Error("Error shutting down server", "error", servErr)
 	}
 
+	if err := a.waitJobs(ctx); err != nil {
+		slog.Error("Background jobs did not stop in time", "error", err)
+	}
+
+	if _, err := a.services.SessionService.FlushActivity(ctx); err != nil {
+		slog.Error("Error flushing session activity", "error", err)
+	}
+
 	dbErr := a.db.Close()
 	if dbErr != nil {
 		slog.Error("Error closing database", "error", dbErr)
@@ -115,6 +206,28 @@ func (a *App) Start() error {
 	return nil
 }
 
+func (a *App) startJob(ctx context.Context, job func(ctx context.Context)) {
+	a.jobs.Add(1)
+	go func() {
+		defer a.jobs.Done()
+		job(ctx)
+	}()
+}
+
+func (a *App) waitJobs(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		a.jobs.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (a *App) RunMigrations() error {
 	driver, err := postgres.WithInstance(a.db, &postgres.Config{})
 	if err != nil {
@@ -5,19 +5,46 @@ import (
 	"os"
 	"time"
 
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/movie/ratingconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/movie/similarityconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/movie/trendingconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/movieimport/importconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/recommendation/recommendationconfig"
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/review/modelconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/session/sessionconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/user/accountconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/blobstore/blobstoreconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/embedder/embedderconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/mailer/mailerconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/metadata/metadataconfig"
 	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/postgresconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/signingkey/signingkeyconfig"
 	"gopkg.in/yaml.v3"
 )
 
+const verificationSecretEnv = "VERIFICATION_SECRET"
+
 type Config struct {
-	SecretKey      string                        `yaml:"secret_key"`
-	Address        string                        `yaml:"address"`
-	ReadTimeout    time.Duration                 `yaml:"read_timeout"`
-	WriteTimeout   time.Duration                 `yaml:"write_timeout"`
-	AllowedOrigins []string                      `yaml:"allowed_origins"`
-	PostgresConfig postgresconfig.PostgresConfig `yaml:"postgres"`
-	ModelConfig    modelconfig.ModelConfig       `yaml:"model"`
+	SecretKey            string                                    `yaml:"secret_key"`
+	Address              string                                    `yaml:"address"`
+	ReadTimeout          time.Duration                             `yaml:"read_timeout"`
+	WriteTimeout         time.Duration                             `yaml:"write_timeout"`
+	AllowedOrigins       []string                                  `yaml:"allowed_origins"`
+	TrustedProxies       []string                                  `yaml:"trusted_proxies"`
+	PostgresConfig       postgresconfig.PostgresConfig             `yaml:"postgres"`
+	ModelConfig          modelconfig.ModelConfig                   `yaml:"model"`
+	MetadataConfig       metadataconfig.MetadataConfig             `yaml:"metadata"`
+	ImagesConfig         blobstoreconfig.BlobStoreConfig           `yaml:"images"`
+	ImportConfig         importconfig.ImportConfig                 `yaml:"import"`
+	RatingConfig         ratingconfig.RatingConfig                 `yaml:"ratings"`
+	TrendingConfig       trendingconfig.TrendingConfig             `yaml:"trending"`
+	RecommendationConfig recommendationconfig.RecommendationConfig `yaml:"recommendations"`
+	SimilarityConfig     similarityconfig.SimilarityConfig         `yaml:"similarity"`
+	EmbedderConfig       embedderconfig.EmbedderConfig             `yaml:"embeddings"`
+	SessionConfig        sessionconfig.SessionConfig               `yaml:"sessions"`
+	SigningKeyConfig     signingkeyconfig.SigningKeyConfig         `yaml:"jwt"`
+	MailerConfig         mailerconfig.MailerConfig                 `yaml:"mail"`
+	AccountConfig        accountconfig.AccountConfig               `yaml:"account"`
 }
 
 func LoadConfig(path string) (*Config, error) {
@@ -30,5 +57,8 @@ func LoadConfig(path string) (*Config, error) {
 	if err := yaml.Unmarshal(data, &config); err != nil {
 		return nil, fmt.Errorf("error parsing config file: %v", err)
 	}
+	if secret := os.Getenv(verificationSecretEnv); secret != "" {
+		config.AccountConfig.VerificationSecret = secret
+	}
 	return &config, nil
 }
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const defaultEmbeddingJobInterval = time.Minute
+
+func (a *App) runEmbeddingJob(ctx context.Context) {
+	interval := a.config.EmbedderConfig.JobInterval
+	if interval <= 0 {
+		interval = defaultEmbeddingJobInterval
+	}
+
+	a.runPeriodic(ctx, "Embedding", interval, func(ctx context.Context) error {
+		processed, err := a.services.EmbeddingService.ProcessPending(ctx)
+		if err != nil {
+			return err
+		}
+		if processed > 0 {
+			slog.Info("Embedding job finished", "processed", processed)
+		}
+		return nil
+	})
+}
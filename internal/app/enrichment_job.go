@@ -0,0 +1,35 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const (
+	defaultEnrichmentBatchSize    = 50
+	defaultEnrichmentRefreshAfter = 30 * 24 * time.Hour
+)
+
+func (a *App) runEnrichmentJob(ctx context.Context) {
+	cfg := a.config.MetadataConfig
+	batchSize := cfg.JobBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultEnrichmentBatchSize
+	}
+	refreshAfter := cfg.RefreshAfter
+	if refreshAfter <= 0 {
+		refreshAfter = defaultEnrichmentRefreshAfter
+	}
+
+	a.runPeriodic(ctx, "Metadata enrichment", cfg.JobInterval, func(ctx context.Context) error {
+		result, err := a.services.EnrichmentService.EnrichStale(ctx, time.Now().Add(-refreshAfter), batchSize)
+		if err != nil {
+			return err
+		}
+		if result.Enriched > 0 || result.NotFound > 0 || result.Failed > 0 {
+			slog.Info("Metadata enrichment job finished", "enriched", result.Enriched, "notFound", result.NotFound, "failed", result.Failed)
+		}
+		return nil
+	})
+}
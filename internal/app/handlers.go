@@ -3,49 +3,186 @@ package app
 import (
 	"net/http"
 
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/collection"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/embedding"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/genre"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/image"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/jwks"
 	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/middleware"
 	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/movieimport"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/person"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/recommendation"
 	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/review"
 	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/reviewlike"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/series"
+	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/session"
+	sessionrequest "github.com/Vlad-Ali/Movies-service-back/internal/adapter/session/request"
 	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/user"
 	"github.com/Vlad-Ali/Movies-service-back/internal/adapter/usermovie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 	"github.com/rs/cors"
 )
 
 type Handlers struct {
-	UserHandler       *user.UserHandler
-	MovieHandler      *movie.MovieHandler
-	UserMovieHandler  *usermovie.UserMovieHandler
-	AuthHandler       *middleware.AuthMiddleware
-	ReviewHandler     *review.ReviewHandler
-	ReviewLikeHandler *reviewlike.ReviewLikeHandler
+	UserHandler           *user.UserHandler
+	MovieHandler          *movie.MovieHandler
+	UserMovieHandler      *usermovie.UserMovieHandler
+	AuthHandler           *middleware.AuthMiddleware
+	ReviewHandler         *review.ReviewHandler
+	ReviewLikeHandler     *reviewlike.ReviewLikeHandler
+	PersonHandler         *person.PersonHandler
+	GenreHandler          *genre.GenreHandler
+	ImportHandler         *movieimport.MovieImportHandler
+	EnrichmentHandler     *movie.MovieEnrichmentHandler
+	ImageHandler          *image.ImageHandler
+	SeriesHandler         *series.SeriesHandler
+	CollectionHandler     *collection.CollectionHandler
+	TranslationHandler    *movie.MovieTranslationHandler
+	RatingHandler         *movie.MovieRatingHandler
+	TrendingHandler       *movie.MovieTrendingHandler
+	RecommendationHandler *recommendation.RecommendationHandler
+	SimilarityHandler     *movie.MovieSimilarityHandler
+	SemanticSearchHandler *embedding.SemanticSearchHandler
+	SessionHandler        *session.SessionHandler
+	JWKSHandler           *jwks.JWKSHandler
 }
 
-func NewHandlers(services *Services) *Handlers {
-	userHandler := user.NewUserHandler(services.UserService)
-	movieHandler := movie.NewMovieHandler(services.MovieService)
-	userMovieHandler := usermovie.NewUserMovieHandler(services.UserMovieService)
+func NewHandlers(services *Services, maxUploadBytes, maxImportBytes int64, clientInfo *sessionrequest.ClientInfoResolver) *Handlers {
+	userHandler := user.NewUserHandler(services.UserService, clientInfo)
+	movieHandler := movie.NewMovieHandler(services.MovieService, services.TranslationService)
+	userMovieHandler := usermovie.NewUserMovieHandler(services.UserMovieService, services.TranslationService)
 	tokenHandler := middleware.NewAuthMiddleware(services.TokenService)
 	reviewHandler := review.NewReviewHandler(services.ReviewService, services.ReviewProvider)
 	reviewLikeHandler := reviewlike.NewReviewLikeHandler(services.ReviewLikeService)
+	personHandler := person.NewPersonHandler(services.PersonService)
+	genreHandler := genre.NewGenreHandler(services.GenreService, services.MovieService, services.TranslationService)
+	importHandler := movieimport.NewMovieImportHandler(services.ImportService, maxImportBytes)
+	enrichmentHandler := movie.NewMovieEnrichmentHandler(services.EnrichmentService)
+	imageHandler := image.NewImageHandler(services.ImageService, maxUploadBytes)
+	seriesHandler := series.NewSeriesHandler(services.SeriesService)
+	collectionHandler := collection.NewCollectionHandler(services.CollectionService)
+	translationHandler := movie.NewMovieTranslationHandler(services.TranslationService)
+	ratingHandler := movie.NewMovieRatingHandler(services.RatingService, services.TranslationService)
+	trendingHandler := movie.NewMovieTrendingHandler(services.TrendingService, services.TranslationService)
+	recommendationHandler := recommendation.NewRecommendationHandler(services.RecommendationService, services.TranslationService)
+	similarityHandler := movie.NewMovieSimilarityHandler(services.SimilarityService, services.TranslationService)
+	semanticSearchHandler := embedding.NewSemanticSearchHandler(services.EmbeddingService, services.TranslationService)
+	sessionHandler := session.NewSessionHandler(services.SessionService, clientInfo)
+	jwksHandler := jwks.NewJWKSHandler(services.TokenService)
 	return &Handlers{UserHandler: userHandler, MovieHandler: movieHandler, UserMovieHandler: userMovieHandler, AuthHandler: tokenHandler,
-		ReviewHandler: reviewHandler, ReviewLikeHandler: reviewLikeHandler}
+		ReviewHandler: reviewHandler, ReviewLikeHandler: reviewLikeHandler, PersonHandler: personHandler, GenreHandler: genreHandler, ImportHandler: importHandler, EnrichmentHandler: enrichmentHandler, ImageHandler: imageHandler,
+		SeriesHandler: seriesHandler, CollectionHandler: collectionHandler, TranslationHandler: translationHandler, RatingHandler: ratingHandler, TrendingHandler: trendingHandler,
+		RecommendationHandler: recommendationHandler, SimilarityHandler: similarityHandler,
+		SemanticSearchHandler: semanticSearchHandler, SessionHandler: sessionHandler,
+		JWKSHandler: jwksHandler}
+}
+
+func (h *Handlers) getMovieResource(w http.ResponseWriter, r *http.Request) {
+	resource := r.PathValue("resource")
+	if r.PathValue("id") == "by-slug" {
+		r.SetPathValue("slug", resource)
+		h.MovieHandler.GetMovieBySlug(w, r)
+		return
+	}
+	switch resource {
+	case "translations":
+		h.TranslationHandler.GetTranslations(w, r)
+	case "ratings":
+		h.RatingHandler.GetRatings(w, r)
+	case "similar":
+		h.SimilarityHandler.GetSimilar(w, r)
+	default:
+		http.NotFound(w, r)
+	}
 }
 
 func (h *Handlers) registerRoutes(cfg *Config) http.Handler {
 	mux := http.NewServeMux()
+	adminOnly := h.AuthHandler.RequireRole(object.RoleAdmin)
+	moderatorOnly := h.AuthHandler.RequireRole(object.RoleModerator, object.RoleAdmin)
 
+	mux.HandleFunc("GET /.well-known/jwks.json", h.JWKSHandler.GetKeys)
 	mux.HandleFunc("POST /api/user/register", h.UserHandler.Register)
 	mux.HandleFunc("POST /api/user/auth", h.UserHandler.Authenticate)
+	mux.HandleFunc("POST /api/user/refresh", h.SessionHandler.Refresh)
+	mux.HandleFunc("POST /api/user/logout", h.SessionHandler.Logout)
+	mux.HandleFunc("GET /api/user/sessions", h.SessionHandler.GetSessions)
+	mux.HandleFunc("DELETE /api/user/sessions/{id}", h.SessionHandler.RevokeSession)
+	mux.HandleFunc("POST /api/user/sessions/revoke-others", h.SessionHandler.RevokeOtherSessions)
 	mux.HandleFunc("GET /api/user", h.UserHandler.GetUser)
+	mux.HandleFunc("PATCH /api/user", h.UserHandler.UpdateUser)
+	mux.HandleFunc("POST /api/user/password", h.UserHandler.ChangePassword)
+	mux.HandleFunc("POST /api/user/password/reset", h.UserHandler.RequestPasswordReset)
+	mux.HandleFunc("POST /api/user/password/reset/confirm", h.UserHandler.ResetPassword)
+	mux.HandleFunc("POST /api/user/verify", h.UserHandler.VerifyEmail)
+	mux.HandleFunc("POST /api/user/verify/resend", h.UserHandler.ResendVerification)
 
 	mux.HandleFunc("GET /api/movie", h.MovieHandler.GetMovie)
 	mux.HandleFunc("GET /api/movie/all", h.MovieHandler.GetMovies)
+	mux.HandleFunc("GET /api/movie/search", h.MovieHandler.SearchMovies)
+	mux.HandleFunc("GET /api/movie/top-rated", h.RatingHandler.GetTopRated)
+	mux.HandleFunc("GET /api/movie/trending", h.TrendingHandler.GetTrending)
+	mux.HandleFunc("GET /api/movie/{id}", h.MovieHandler.GetMovieByID)
+	mux.HandleFunc("GET /api/movie/{id}/{resource}", h.getMovieResource)
+	mux.HandleFunc("GET /api/search/semantic", h.SemanticSearchHandler.Search)
+
+	mux.HandleFunc("GET /api/images/{id}/{variant}", h.ImageHandler.GetImage)
+
+	mux.HandleFunc("GET /api/series", h.SeriesHandler.GetSeriesList)
+	mux.HandleFunc("GET /api/series/{id}", h.SeriesHandler.GetSeries)
+	mux.HandleFunc("GET /api/series/by-slug/{slug}", h.SeriesHandler.GetSeries)
+	mux.HandleFunc("GET /api/series/{id}/seasons/{season}", h.SeriesHandler.GetSeason)
+	mux.HandleFunc("GET /api/series/{id}/seasons/{season}/episodes/{episode}", h.SeriesHandler.GetEpisode)
+
+	mux.HandleFunc("GET /api/collections", h.CollectionHandler.GetCollections)
+	mux.HandleFunc("GET /api/collections/{slug}", h.CollectionHandler.GetCollection)
+
+	mux.HandleFunc("GET /api/genre", h.GenreHandler.GetGenres)
+	mux.HandleFunc("GET /api/genre/{slug}/movies", h.GenreHandler.GetGenreMovies)
+
+	mux.HandleFunc("GET /api/person", h.PersonHandler.SearchPeople)
+	mux.HandleFunc("GET /api/person/{id}", h.PersonHandler.GetPerson)
+
+	mux.Handle("POST /api/admin/movie", adminOnly(http.HandlerFunc(h.MovieHandler.CreateMovie)))
+	mux.Handle("PUT /api/admin/movie", adminOnly(http.HandlerFunc(h.MovieHandler.UpdateMovie)))
+	mux.Handle("PATCH /api/admin/movie", adminOnly(http.HandlerFunc(h.MovieHandler.PatchMovie)))
+	mux.Handle("DELETE /api/admin/movie", adminOnly(http.HandlerFunc(h.MovieHandler.DeleteMovie)))
+	mux.Handle("POST /api/admin/movie/import", adminOnly(http.HandlerFunc(h.ImportHandler.ImportMovies)))
+	mux.Handle("POST /api/admin/movie/{id}/enrich", adminOnly(http.HandlerFunc(h.EnrichmentHandler.EnrichMovie)))
+	mux.Handle("PUT /api/admin/movie/{id}/images/{kind}", adminOnly(http.HandlerFunc(h.ImageHandler.UploadMovieImage)))
+	mux.Handle("DELETE /api/admin/movie/{id}/images/{kind}", adminOnly(http.HandlerFunc(h.ImageHandler.DeleteMovieImage)))
+	mux.Handle("PUT /api/admin/movie/{id}/relations", adminOnly(http.HandlerFunc(h.MovieHandler.SaveRelatedMovie)))
+	mux.Handle("DELETE /api/admin/movie/{id}/relations/{related}", adminOnly(http.HandlerFunc(h.MovieHandler.DeleteRelatedMovie)))
+	mux.Handle("PUT /api/admin/movie/{id}/translations/{language}", adminOnly(http.HandlerFunc(h.TranslationHandler.SaveTranslation)))
+	mux.Handle("DELETE /api/admin/movie/{id}/translations/{language}", adminOnly(http.HandlerFunc(h.TranslationHandler.DeleteTranslation)))
+	mux.Handle("PUT /api/admin/movie/{id}/alternate-titles", adminOnly(http.HandlerFunc(h.TranslationHandler.SaveAlternateTitles)))
+	mux.Handle("POST /api/admin/genre", adminOnly(http.HandlerFunc(h.GenreHandler.CreateGenre)))
+	mux.Handle("PATCH /api/admin/genre/{slug}", adminOnly(http.HandlerFunc(h.GenreHandler.PatchGenre)))
+	mux.Handle("DELETE /api/admin/genre/{slug}", adminOnly(http.HandlerFunc(h.GenreHandler.DeleteGenre)))
+	mux.Handle("POST /api/admin/series", adminOnly(http.HandlerFunc(h.SeriesHandler.CreateSeries)))
+	mux.Handle("PUT /api/admin/series/{id}", adminOnly(http.HandlerFunc(h.SeriesHandler.UpdateSeries)))
+	mux.Handle("DELETE /api/admin/series/{id}", adminOnly(http.HandlerFunc(h.SeriesHandler.DeleteSeries)))
+	mux.Handle("PUT /api/admin/series/{id}/seasons/{season}", adminOnly(http.HandlerFunc(h.SeriesHandler.SaveSeason)))
+	mux.Handle("DELETE /api/admin/series/{id}/seasons/{season}", adminOnly(http.HandlerFunc(h.SeriesHandler.DeleteSeason)))
+	mux.Handle("PUT /api/admin/series/{id}/seasons/{season}/episodes/{episode}", adminOnly(http.HandlerFunc(h.SeriesHandler.SaveEpisode)))
+	mux.Handle("DELETE /api/admin/series/{id}/seasons/{season}/episodes/{episode}", adminOnly(http.HandlerFunc(h.SeriesHandler.DeleteEpisode)))
+	mux.Handle("POST /api/admin/collections", adminOnly(http.HandlerFunc(h.CollectionHandler.CreateCollection)))
+	mux.Handle("PUT /api/admin/collections/{slug}", adminOnly(http.HandlerFunc(h.CollectionHandler.UpdateCollection)))
+	mux.Handle("DELETE /api/admin/collections/{slug}", adminOnly(http.HandlerFunc(h.CollectionHandler.DeleteCollection)))
+	mux.Handle("PATCH /api/admin/user/role", adminOnly(http.HandlerFunc(h.UserHandler.ChangeRole)))
 
 	mux.HandleFunc("PATCH /api/user/movie/rating", h.UserMovieHandler.SaveRating)
 	mux.HandleFunc("PATCH /api/user/movie/list", h.UserMovieHandler.SaveListType)
 	mux.HandleFunc("GET /api/user/movie", h.UserMovieHandler.GetUserMovie)
 	mux.HandleFunc("GET /api/user/movie/all", h.UserMovieHandler.GetUserMovies)
+	mux.HandleFunc("GET /api/user/series", h.UserMovieHandler.GetUserSeries)
+	mux.HandleFunc("GET /api/user/series/all", h.UserMovieHandler.GetUserSeriesList)
+	mux.HandleFunc("PUT /api/user/series/{id}/progress", h.UserMovieHandler.SaveProgress)
+	mux.HandleFunc("GET /api/user/series/{id}/progress", h.UserMovieHandler.GetProgress)
+	mux.HandleFunc("DELETE /api/user/series/{id}/progress", h.UserMovieHandler.DeleteProgress)
+	mux.HandleFunc("GET /api/user/collections/{slug}", h.CollectionHandler.GetCompletion)
+	mux.HandleFunc("GET /api/user/recommendations", h.RecommendationHandler.GetRecommendations)
 
 	mux.HandleFunc("PUT /api/user/movie/review", h.ReviewHandler.SaveReview)
 	mux.HandleFunc("DELETE /api/user/movie/review", h.ReviewHandler.DeleteReview)
@@ -53,6 +190,7 @@ func (h *Handlers) registerRoutes(cfg *Config) http.Handler {
 	mux.HandleFunc("GET /api/movie/review/all", h.ReviewHandler.GetReviews)
 	mux.HandleFunc("GET /api/movie/review/user/all", h.ReviewHandler.GetReviewsForUser)
 	mux.HandleFunc("GET /api/movie/summary", h.ReviewHandler.GetSummaryReviews)
+	mux.Handle("DELETE /api/moderator/review", moderatorOnly(http.HandlerFunc(h.ReviewHandler.ModerateDeleteReview)))
 
 	mux.HandleFunc("POST /api/movie/review/like", h.ReviewLikeHandler.Like)
 	mux.HandleFunc("POST /api/movie/review/unlike", h.ReviewLikeHandler.UnLike)
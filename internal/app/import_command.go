@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io"
+	"log/slog"
+	"os"
+
+	importresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/movieimport/response"
+	importdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/object"
+)
+
+func RunImport(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	formatName := flags.String("format", "csv", "input format: csv, jsonl or imdb")
+	mainPath := flags.String("file", "", "path to the csv/jsonl file or IMDb title.basics.tsv")
+	principalsPath := flags.String("principals", "", "path to IMDb title.principals.tsv")
+	namesPath := flags.String("names", "", "path to IMDb name.basics.tsv")
+	dryRun := flags.Bool("dry-run", false, "validate and report without writing")
+	batchSize := flags.Int("batch-size", importdomain.DefaultBatchSize, "records per transaction")
+	createGenres := flags.Bool("create-genres", false, "create genres missing from the taxonomy")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	format, err := object.NewFormat(*formatName)
+	if err != nil {
+		slog.Error("Invalid import format", "error", err, "format", *formatName)
+		return err
+	}
+
+	var sources importdomain.Sources
+	for _, source := range []struct {
+		path   string
+		target *io.Reader
+	}{
+		{path: *mainPath, target: &sources.Main},
+		{path: *principalsPath, target: &sources.Principals},
+		{path: *namesPath, target: &sources.Names},
+	} {
+		if source.path == "" {
+			continue
+		}
+		file, err := os.Open(source.path)
+		if err != nil {
+			slog.Error("Error opening import file", "error", err, "path", source.path)
+			return err
+		}
+		defer file.Close()
+		*source.target = file
+	}
+
+	application, err := NewApp()
+	if err != nil {
+		return err
+	}
+	defer application.db.Close()
+
+	err = application.RunMigrations()
+	if err != nil {
+		return err
+	}
+
+	options := importdomain.Options{DryRun: *dryRun, BatchSize: *batchSize, CreateGenres: *createGenres}
+	report, err := application.services.ImportService.Import(context.Background(), format, sources, options)
+	if report != nil {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if encodeErr := encoder.Encode(importresponse.NewImportResponse(report)); encodeErr != nil {
+			slog.Error("Error writing import report", "error", encodeErr)
+		}
+	}
+	if err != nil {
+		slog.Error("Error importing movies", "error", err)
+		return err
+	}
+	return nil
+}
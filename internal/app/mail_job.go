@@ -0,0 +1,27 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const defaultMailJobInterval = 30 * time.Second
+
+func (a *App) runMailJob(ctx context.Context) {
+	interval := a.config.MailerConfig.JobInterval
+	if interval <= 0 {
+		interval = defaultMailJobInterval
+	}
+
+	a.runPeriodic(ctx, "Mail", interval, func(ctx context.Context) error {
+		sent, err := a.services.MailService.DeliverPending(ctx)
+		if err != nil {
+			return err
+		}
+		if sent > 0 {
+			slog.Info("Mail job finished", "sent", sent)
+		}
+		return nil
+	})
+}
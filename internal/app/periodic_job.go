@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+func (a *App) runPeriodic(ctx context.Context, name string, interval time.Duration, fn func(ctx context.Context) error) {
+	a.startJob(ctx, func(ctx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		slog.Info(name+" job started", "interval", interval)
+
+		for {
+			if err := fn(ctx); err != nil && ctx.Err() == nil {
+				slog.Error(name+" job failed", "error", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				slog.Info(name + " job stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const defaultRecommendationJobInterval = time.Hour
+
+func (a *App) runRecommendationJob(ctx context.Context) {
+	interval := a.config.RecommendationConfig.JobInterval
+	if interval <= 0 {
+		interval = defaultRecommendationJobInterval
+	}
+
+	a.runPeriodic(ctx, "Recommendation", interval, func(ctx context.Context) error {
+		pairs, err := a.services.RecommendationService.RebuildModel(ctx)
+		if err != nil {
+			return err
+		}
+		slog.Info("Recommendation job finished", "pairs", pairs)
+		return nil
+	})
+}
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log/slog"
+	"os"
+
+	statsresponse "github.com/Vlad-Ali/Movies-service-back/internal/adapter/stats/response"
+)
+
+func RunReconcileStats(args []string) error {
+	flags := flag.NewFlagSet("reconcile-stats", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	application, err := NewApp()
+	if err != nil {
+		return err
+	}
+	defer application.db.Close()
+
+	err = application.RunMigrations()
+	if err != nil {
+		return err
+	}
+
+	report, err := application.services.StatsService.Reconcile(context.Background())
+	if err != nil {
+		slog.Error("Error reconciling stats", "error", err)
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(statsresponse.NewReconcileResponse(report)); err != nil {
+		slog.Error("Error writing reconcile report", "error", err)
+		return err
+	}
+	return nil
+}
@@ -3,27 +3,64 @@ package app
 import (
 	"database/sql"
 
+	collectiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection"
+	embeddingdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding"
+	genredomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre"
+	imagedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/image"
+	maildomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail"
 	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	recommendationdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation"
 	reviewdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/review"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/reviewlike"
+	seriesdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/series"
+	sessiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/session"
+	statsdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/stats"
 	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
 	usermoviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
+	collectionrepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/collection"
+	embeddingrepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/embedding"
+	genrerepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/genre"
+	imagerepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/image"
+	mailrepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/mail"
 	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/movie"
+	personrepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/person"
+	recommendationrepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/recommendation"
 	reviewrepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/review"
 	reviewlike2 "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/reviewlike"
+	seriesrepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/series"
+	sessionrepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/session"
+	statsrepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/stats"
 	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/user"
 	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/usermovie"
 )
 
 type Repositories struct {
-	MovieRepository      moviedomain.Repository
-	UserRepository       userdomain.Repository
-	UserMovieRepository  usermoviedomain.Repository
-	ReviewRepository     reviewdomain.Repository
-	ReviewLikeRepository reviewlike.Repository
+	MovieRepository          moviedomain.Repository
+	UserRepository           userdomain.Repository
+	UserMovieRepository      usermoviedomain.Repository
+	ReviewRepository         reviewdomain.Repository
+	ReviewLikeRepository     reviewlike.Repository
+	PersonRepository         persondomain.Repository
+	GenreRepository          genredomain.Repository
+	ImageRepository          imagedomain.Repository
+	SeriesRepository         seriesdomain.Repository
+	CollectionRepository     collectiondomain.Repository
+	StatsRepository          statsdomain.Repository
+	RecommendationRepository recommendationdomain.Repository
+	EmbeddingRepository      embeddingdomain.Repository
+	SessionRepository        sessiondomain.Repository
+	OutboxRepository         maildomain.Repository
 }
 
 func NewRepositories(db *sql.DB) *Repositories {
 	return &Repositories{MovieRepository: movie.NewMovieRepository(db), UserRepository: user.NewUserRepository(db), UserMovieRepository: usermovie.NewUserMovieRepository(db),
-		ReviewRepository: reviewrepo.NewReviewRepository(db), ReviewLikeRepository: reviewlike2.NewReviewLikeRepository(db)}
+		ReviewRepository: reviewrepo.NewReviewRepository(db), ReviewLikeRepository: reviewlike2.NewReviewLikeRepository(db),
+		PersonRepository: personrepo.NewPersonRepository(db), GenreRepository: genrerepo.NewGenreRepository(db),
+		ImageRepository: imagerepo.NewImageRepository(db), SeriesRepository: seriesrepo.NewSeriesRepository(db),
+		CollectionRepository: collectionrepo.NewCollectionRepository(db), StatsRepository: statsrepo.NewStatsRepository(db),
+		RecommendationRepository: recommendationrepo.NewRecommendationRepository(db),
+		EmbeddingRepository:      embeddingrepo.NewEmbeddingRepository(db),
+		SessionRepository:        sessionrepo.NewSessionRepository(db),
+		OutboxRepository:         mailrepo.NewOutboxRepository(db)}
 }
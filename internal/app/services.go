@@ -3,45 +3,137 @@ package app
 import (
 	"database/sql"
 
+	collectionservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/collection"
+	embeddingservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/embedding"
+	genreservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/genre"
+	imageservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/image"
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/jwt"
+	mailservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/mail"
 	movie2 "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/movie/ratingconfig"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/movie/trendingconfig"
+	importservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/movieimport"
+	personservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/person"
+	recommendationservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/recommendation"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/recommendation/recommendationconfig"
 	reviewservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/review"
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/review/modelconfig"
 	reviewlike2 "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/reviewlike"
+	seriesservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/series"
+	sessionservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/session"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/session/sessionconfig"
+	statsservice "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/stats"
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/user"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/user/accountconfig"
 	usermovie2 "github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/usermovie"
+	collectiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection"
+	embeddingdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding"
+	genredomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre"
+	imagedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/image"
+	maildomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	importdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	recommendationdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation"
 	reviewdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/review"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/reviewlike"
+	seriesdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/series"
+	sessiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/session"
+	statsdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/stats"
 	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
+	imageinfra "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/image"
+	importinfra "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/movieimport"
 )
 
 type Services struct {
-	UserService       userdomain.Service
-	MovieService      movie.Service
-	UserMovieService  usermovie.Service
-	TokenService      userdomain.TokenService
-	ReviewService     reviewdomain.Service
-	ReviewProvider    reviewdomain.Provider
-	ReviewLikeService reviewlike.Service
+	UserService           userdomain.Service
+	MovieService          movie.Service
+	UserMovieService      usermovie.Service
+	TokenService          userdomain.TokenService
+	ReviewService         reviewdomain.Service
+	ReviewProvider        reviewdomain.Provider
+	ReviewLikeService     reviewlike.Service
+	PersonService         persondomain.Service
+	GenreService          genredomain.Service
+	ImportService         importdomain.Service
+	EnrichmentService     movie.EnrichmentService
+	ImageService          imagedomain.Service
+	SeriesService         seriesdomain.Service
+	CollectionService     collectiondomain.Service
+	TranslationService    movie.TranslationService
+	RatingService         movie.RatingService
+	StatsService          statsdomain.Service
+	TrendingService       movie.TrendingService
+	RecommendationService recommendationdomain.Service
+	SimilarityService     movie.SimilarityService
+	EmbeddingService      embeddingdomain.Service
+	SessionService        sessiondomain.Service
+	MailService           maildomain.Service
 }
 
-func NewServices(db *sql.DB, repos *Repositories, secretKey string, transactionUser transactionmanager.TransactionUser, config modelconfig.ModelConfig) *Services {
-	tokenService := jwt.NewJwtService(secretKey)
-	userService := user.NewUserService(tokenService, repos.UserRepository, transactionmanager.NewTransactionManager[*userdomain.User](db),
-		transactionmanager.NewTransactionManager[*object.AuthResponse](db))
+func NewServices(db *sql.DB, repos *Repositories, keyRing *userdomain.KeyRing, transactionUser transactionmanager.TransactionUser, config modelconfig.ModelConfig, metadataProvider movie.MetadataProvider,
+	blobStore imagedomain.BlobStore, maxUploadBytes int64, ratingConfig ratingconfig.RatingConfig,
+	trendingConfig trendingconfig.TrendingConfig, recommendationConfig recommendationconfig.RecommendationConfig,
+	similarityStrategy movie.SimilarityStrategy, embedder embeddingdomain.Embedder, embeddingBatchSize int, sessionConfig sessionconfig.SessionConfig,
+	mailer maildomain.Mailer, mailBatchSize int, verificationSigner userdomain.VerificationSigner, accountConfig accountconfig.AccountConfig) *Services {
+	revocations := sessionservice.NewRevocationCache(repos.SessionRepository, sessionConfig.AccessTokenTTL)
+	activity := sessionservice.NewActivityTracker()
+	tokenService := jwt.NewJwtService(keyRing, sessionConfig.AccessTokenTTL, revocations, activity)
+	sessionService := sessionservice.NewSessionService(repos.SessionRepository, repos.UserRepository, tokenService, revocations, activity,
+		transactionmanager.NewTransactionManager[*object.AuthResponse](db), transactionmanager.NewTransactionManager[[]*sessiondomain.Session](db), transactionUser, sessionConfig.AccessTokenTTL, sessionConfig.RefreshTokenTTL)
+	userService := user.NewUserService(sessionService, repos.UserRepository, repos.OutboxRepository, transactionmanager.NewTransactionManager[*userdomain.User](db),
+		transactionmanager.NewTransactionManager[*object.AuthResponse](db), transactionUser, verificationSigner, accountConfig)
 	movieService := movie2.NewMovieService(repos.MovieRepository, transactionmanager.NewTransactionManager[*movie.Movie](db),
-		transactionmanager.NewTransactionManager[[]*movie.Movie](db))
-	userMovieService := usermovie2.NewUserMovieService(repos.MovieRepository, repos.UserMovieRepository,
+		transactionmanager.NewTransactionManager[*movie.MoviePage](db), transactionmanager.NewTransactionManager[*movie.SearchResult](db), transactionUser, repos.UserRepository, repos.PersonRepository, repos.GenreRepository,
+		ratingConfig.MinVotes)
+	userMovieService := usermovie2.NewUserMovieService(repos.MovieRepository, repos.SeriesRepository, repos.UserMovieRepository,
 		transactionmanager.NewTransactionManager[[]*usermovie.MovieUserInfo](db), transactionmanager.NewTransactionManager[*usermovie.MovieUserInfo](db),
-		transactionUser)
-	reviewService := reviewservice.NewReviewService(repos.MovieRepository, repos.ReviewRepository, transactionUser, transactionmanager.NewTransactionManager[*reviewdomain.Review](db),
-		transactionmanager.NewTransactionManager[[]*reviewdomain.ReviewInfo](db))
-	reviewProvider := reviewservice.NewReviewProvider(reviewService, config)
-	reviewLikeService := reviewlike2.NewReviewLikeService(repos.ReviewRepository, repos.ReviewLikeRepository, transactionUser)
+		transactionmanager.NewTransactionManager[[]*usermovie.SeriesUserInfo](db), transactionmanager.NewTransactionManager[*usermovie.SeriesUserInfo](db),
+		transactionmanager.NewTransactionManager[*usermovie.WatchProgress](db), transactionUser, repos.StatsRepository)
+	reviewService := reviewservice.NewReviewService(repos.MovieRepository, repos.SeriesRepository, repos.ReviewRepository, transactionUser, transactionmanager.NewTransactionManager[*reviewdomain.Review](db),
+		transactionmanager.NewTransactionManager[[]*reviewdomain.ReviewInfo](db), repos.UserRepository, repos.StatsRepository, accountConfig.RequireVerifiedToReview)
+	reviewProvider := reviewservice.NewReviewProvider(reviewService, movieService, config)
+	reviewLikeService := reviewlike2.NewReviewLikeService(repos.ReviewRepository, repos.ReviewLikeRepository, transactionUser, repos.StatsRepository,
+		repos.UserRepository, accountConfig.RequireVerifiedToLike)
+	personService := personservice.NewPersonService(repos.PersonRepository, transactionmanager.NewTransactionManager[*persondomain.Profile](db),
+		transactionmanager.NewTransactionManager[[]*persondomain.Person](db))
+	genreService := genreservice.NewGenreService(repos.GenreRepository, repos.UserRepository, transactionmanager.NewTransactionManager[*genredomain.Genre](db),
+		transactionmanager.NewTransactionManager[[]*genredomain.GenreStats](db), transactionUser)
+	importService := importservice.NewMovieImportService(importinfra.NewParserFactory(), repos.MovieRepository, repos.PersonRepository, repos.GenreRepository,
+		repos.UserRepository, transactionUser)
+	enrichmentService := movie2.NewMovieEnrichmentService(metadataProvider, repos.MovieRepository, repos.PersonRepository, repos.GenreRepository, repos.UserRepository,
+		transactionmanager.NewTransactionManager[*movie.Movie](db))
+	imageService := imageservice.NewImageService(repos.ImageRepository, repos.MovieRepository, repos.UserRepository, blobStore, imageinfra.NewStdProcessor(),
+		transactionmanager.NewTransactionManager[*imagedomain.Image](db), transactionmanager.NewTransactionManager[movieobject.MovieID](db), maxUploadBytes)
+	seriesService := seriesservice.NewSeriesService(repos.SeriesRepository, repos.UserRepository, transactionmanager.NewTransactionManager[*seriesdomain.Series](db),
+		transactionmanager.NewTransactionManager[*seriesdomain.SeriesPage](db), transactionmanager.NewTransactionManager[[]*seriesdomain.Season](db),
+		transactionmanager.NewTransactionManager[*seriesdomain.Season](db), transactionmanager.NewTransactionManager[*seriesdomain.Episode](db), transactionUser)
+	collectionService := collectionservice.NewCollectionService(repos.CollectionRepository, repos.MovieRepository, repos.UserRepository,
+		transactionmanager.NewTransactionManager[*collectiondomain.Collection](db), transactionmanager.NewTransactionManager[[]*collectiondomain.Collection](db),
+		transactionmanager.NewTransactionManager[*collectiondomain.Completion](db), transactionUser)
+	translationService := movie2.NewMovieTranslationService(repos.MovieRepository, repos.UserRepository, transactionmanager.NewTransactionManager[[]*movie.Translation](db),
+		transactionmanager.NewTransactionManager[*movie.Translation](db), transactionmanager.NewTransactionManager[[]*movie.AlternateTitle](db), transactionUser)
+	ratingService := movie2.NewMovieRatingService(repos.MovieRepository, transactionmanager.NewTransactionManager[*movie.RatingSummary](db),
+		transactionmanager.NewTransactionManager[[]*movie.Movie](db), ratingConfig.MinVotes)
+	statsService := statsservice.NewStatsService(repos.StatsRepository, transactionmanager.NewTransactionManager[*statsdomain.ReconcileReport](db))
+	trendingService := movie2.NewMovieTrendingService(repos.MovieRepository, transactionmanager.NewTransactionManager[[]*movie.TrendingMovie](db), transactionUser,
+		ratingConfig.MinVotes, trendingConfig.Size)
+	recommendationService := recommendationservice.NewRecommendationService(repos.RecommendationRepository, repos.MovieRepository,
+		transactionmanager.NewTransactionManager[[]*recommendationdomain.Recommendation](db), transactionUser,
+		recommendationdomain.NewModelParams(recommendationConfig.Neighbors, recommendationConfig.MinCoRaters, recommendationConfig.Shrinkage), ratingConfig.MinVotes)
+	similarityService := movie2.NewMovieSimilarityService(repos.MovieRepository, similarityStrategy, transactionmanager.NewTransactionManager[[]*movie.SimilarMovie](db),
+		ratingConfig.MinVotes)
+	embeddingService := embeddingservice.NewEmbeddingService(repos.EmbeddingRepository, repos.MovieRepository, repos.ReviewRepository, embedder,
+		transactionmanager.NewTransactionManager[*embeddingdomain.SearchResult](db), embeddingBatchSize, ratingConfig.MinVotes)
+	mailService := mailservice.NewMailService(repos.OutboxRepository, mailer, mailBatchSize)
 	return &Services{UserService: userService, MovieService: movieService, UserMovieService: userMovieService, TokenService: tokenService, ReviewService: reviewService, ReviewProvider: reviewProvider,
-		ReviewLikeService: reviewLikeService}
+		ReviewLikeService: reviewLikeService, PersonService: personService, GenreService: genreService, ImportService: importService, EnrichmentService: enrichmentService, ImageService: imageService,
+		SeriesService: seriesService, CollectionService: collectionService, TranslationService: translationService, RatingService: ratingService, StatsService: statsService, TrendingService: trendingService,
+		RecommendationService: recommendationService, SimilarityService: similarityService,
+		EmbeddingService: embeddingService, SessionService: sessionService,
+		MailService: mailService}
 }
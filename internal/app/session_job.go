@@ -0,0 +1,29 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const defaultSessionJobInterval = 30 * time.Second
+
+func (a *App) runSessionJob(ctx context.Context) {
+	interval := a.config.SessionConfig.SyncInterval
+	if interval <= 0 {
+		interval = defaultSessionJobInterval
+	}
+
+	a.runPeriodic(ctx, "Session", interval, func(ctx context.Context) error {
+		touched, err := a.services.SessionService.FlushActivity(ctx)
+		if err != nil && ctx.Err() == nil {
+			slog.Error("Session job failed to flush activity", "error", err)
+		}
+		revoked, err := a.services.SessionService.SyncRevocations(ctx)
+		if err != nil {
+			return err
+		}
+		slog.Debug("Session job finished", "revoked", revoked, "touched", touched)
+		return nil
+	})
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const defaultTrendingJobInterval = 15 * time.Minute
+
+func (a *App) runTrendingJob(ctx context.Context) {
+	interval := a.config.TrendingConfig.JobInterval
+	if interval <= 0 {
+		interval = defaultTrendingJobInterval
+	}
+
+	a.runPeriodic(ctx, "Trending", interval, func(ctx context.Context) error {
+		ranked, err := a.services.TrendingService.RefreshTrending(ctx)
+		if err != nil {
+			return err
+		}
+		slog.Info("Trending job finished", "ranked", ranked)
+		return nil
+	})
+}
@@ -3,8 +3,10 @@ package jwtclaims
 import "github.com/golang-jwt/jwt/v5"
 
 type JWTClaims struct {
-	UserID   string `json:"userID"`
-	Email    string `json:"email"`
-	Username string `json:"username"`
+	UserID    string `json:"userID"`
+	Email     string `json:"email"`
+	Username  string `json:"username"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
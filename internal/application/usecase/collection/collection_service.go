@@ -0,0 +1,195 @@
+package collection
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	collectiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/collection/object"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type CollectionService struct {
+	collectionRepo      collectiondomain.Repository
+	movieRepo           moviedomain.Repository
+	userRepo            userdomain.Repository
+	collectionTxManager transactionmanager.TransactionManager[*collectiondomain.Collection]
+	listTxManager       transactionmanager.TransactionManager[[]*collectiondomain.Collection]
+	completionTxManager transactionmanager.TransactionManager[*collectiondomain.Completion]
+	txUser              transactionmanager.TransactionUser
+}
+
+func NewCollectionService(collectionRepo collectiondomain.Repository, movieRepo moviedomain.Repository, userRepo userdomain.Repository, collectionTxManager transactionmanager.TransactionManager[*collectiondomain.Collection], listTxManager transactionmanager.TransactionManager[[]*collectiondomain.Collection], completionTxManager transactionmanager.TransactionManager[*collectiondomain.Completion], txUser transactionmanager.TransactionUser) *CollectionService {
+	return &CollectionService{collectionRepo: collectionRepo, movieRepo: movieRepo, userRepo: userRepo, collectionTxManager: collectionTxManager, listTxManager: listTxManager, completionTxManager: completionTxManager, txUser: txUser}
+}
+
+func (c *CollectionService) GetAll(ctx context.Context) ([]*collectiondomain.Collection, error) {
+	return c.listTxManager.InTransaction(ctx, func(ctx context.Context) ([]*collectiondomain.Collection, error) {
+		collections, err := c.collectionRepo.GetAll(ctx)
+		if err != nil {
+			slog.Error("CollectionService.GetAll failed to get collections", "error", err)
+			return nil, err
+		}
+		slog.Debug("CollectionService.GetAll collections successfully found", "count", len(collections))
+		return collections, nil
+	})
+}
+
+func (c *CollectionService) FindBySlug(ctx context.Context, slug string, order object.CollectionOrder) (*collectiondomain.Collection, error) {
+	return c.collectionTxManager.InTransaction(ctx, func(ctx context.Context) (*collectiondomain.Collection, error) {
+		return c.findWithItems(ctx, slug, order)
+	})
+}
+
+func (c *CollectionService) FindCompletion(ctx context.Context, userID userobject.UserID, slug string, order object.CollectionOrder) (*collectiondomain.Completion, error) {
+	return c.completionTxManager.InTransaction(ctx, func(ctx context.Context) (*collectiondomain.Completion, error) {
+		collection, err := c.findWithItems(ctx, slug, order)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses, err := c.collectionRepo.GetUserStatuses(ctx, collection.ID(), userID)
+		if err != nil {
+			slog.Error("CollectionService.FindCompletion failed to get user statuses", "error", err)
+			return nil, err
+		}
+		completion := collectiondomain.NewCompletion(collection, statuses)
+		slog.Debug("CollectionService.FindCompletion completion successfully found", "collectionID", collection.ID().ID(), "userID", userID.ID())
+		return completion, nil
+	})
+}
+
+func (c *CollectionService) CreateCollection(ctx context.Context, actorID userobject.UserID, collection *collectiondomain.Collection, movies []movieobject.MovieRef) (*collectiondomain.Collection, error) {
+	err := collectiondomain.ValidateCollection(collection)
+	if err != nil {
+		slog.Error("CollectionService.CreateCollection validation failed", "error", err)
+		return nil, err
+	}
+	return c.collectionTxManager.InTransaction(ctx, func(ctx context.Context) (*collectiondomain.Collection, error) {
+		err := userdomain.CheckPermission(ctx, c.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("CollectionService.CreateCollection permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		movieIDs, err := c.resolveMovies(ctx, movies)
+		if err != nil {
+			slog.Error("CollectionService.CreateCollection failed to resolve movies", "error", err)
+			return nil, err
+		}
+
+		err = c.collectionRepo.Save(ctx, collection)
+		if err != nil {
+			slog.Error("CollectionService.CreateCollection failed to save collection", "error", err)
+			return nil, err
+		}
+		err = c.collectionRepo.ReplaceMovies(ctx, collection.ID(), movieIDs)
+		if err != nil {
+			slog.Error("CollectionService.CreateCollection failed to save movies", "error", err)
+			return nil, err
+		}
+		slog.Debug("CollectionService.CreateCollection collection successfully created", "collectionID", collection.ID().ID())
+		return c.findWithItems(ctx, collection.Slug, object.CollectionOrderPosition)
+	})
+}
+
+func (c *CollectionService) UpdateCollection(ctx context.Context, actorID userobject.UserID, slug string, collection *collectiondomain.Collection, movies []movieobject.MovieRef) (*collectiondomain.Collection, error) {
+	err := collectiondomain.ValidateCollection(collection)
+	if err != nil {
+		slog.Error("CollectionService.UpdateCollection validation failed", "error", err)
+		return nil, err
+	}
+	return c.collectionTxManager.InTransaction(ctx, func(ctx context.Context) (*collectiondomain.Collection, error) {
+		err := userdomain.CheckPermission(ctx, c.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("CollectionService.UpdateCollection permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		existing, err := c.collectionRepo.GetBySlug(ctx, slug)
+		if err != nil {
+			slog.Error("CollectionService.UpdateCollection failed to get collection", "error", err)
+			return nil, err
+		}
+		_ = collection.SetID(existing.ID())
+
+		movieIDs, err := c.resolveMovies(ctx, movies)
+		if err != nil {
+			slog.Error("CollectionService.UpdateCollection failed to resolve movies", "error", err)
+			return nil, err
+		}
+
+		err = c.collectionRepo.Update(ctx, collection)
+		if err != nil {
+			slog.Error("CollectionService.UpdateCollection failed to update collection", "error", err)
+			return nil, err
+		}
+		err = c.collectionRepo.ReplaceMovies(ctx, collection.ID(), movieIDs)
+		if err != nil {
+			slog.Error("CollectionService.UpdateCollection failed to save movies", "error", err)
+			return nil, err
+		}
+		slog.Debug("CollectionService.UpdateCollection collection successfully updated", "collectionID", collection.ID().ID())
+		return c.findWithItems(ctx, collection.Slug, object.CollectionOrderPosition)
+	})
+}
+
+func (c *CollectionService) DeleteCollection(ctx context.Context, actorID userobject.UserID, slug string) error {
+	return c.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		err := userdomain.CheckPermission(ctx, c.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("CollectionService.DeleteCollection permission check failed", "error", err, "actorID", actorID.ID())
+			return err
+		}
+
+		collection, err := c.collectionRepo.GetBySlug(ctx, slug)
+		if err != nil {
+			slog.Error("CollectionService.DeleteCollection failed to get collection", "error", err)
+			return err
+		}
+
+		err = c.collectionRepo.Delete(ctx, collection.ID())
+		if err != nil {
+			slog.Error("CollectionService.DeleteCollection failed to delete collection", "error", err)
+			return err
+		}
+		slog.Debug("CollectionService.DeleteCollection collection successfully deleted", "collectionID", collection.ID().ID())
+		return nil
+	})
+}
+
+func (c *CollectionService) findWithItems(ctx context.Context, slug string, order object.CollectionOrder) (*collectiondomain.Collection, error) {
+	collection, err := c.collectionRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		slog.Error("CollectionService.findWithItems failed to get collection", "error", err)
+		return nil, err
+	}
+
+	items, err := c.collectionRepo.GetItems(ctx, collection.ID())
+	if err != nil {
+		slog.Error("CollectionService.findWithItems failed to get items", "error", err)
+		return nil, err
+	}
+	collection.SetItems(items, order)
+	return collection, nil
+}
+
+func (c *CollectionService) resolveMovies(ctx context.Context, movies []movieobject.MovieRef) ([]movieobject.MovieID, error) {
+	movieIDs := make([]movieobject.MovieID, 0, len(movies))
+	for _, ref := range movies {
+		movieID, err := moviedomain.FindIDByRef(ctx, c.movieRepo, ref)
+		if err != nil {
+			return nil, err
+		}
+		movieIDs = append(movieIDs, movieID)
+	}
+	err := collectiondomain.ValidateMovies(movieIDs)
+	if err != nil {
+		return nil, err
+	}
+	return movieIDs, nil
+}
@@ -0,0 +1,200 @@
+package embedding
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	embeddingdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/object"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	reviewdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/review"
+	reviewobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
+)
+
+type EmbeddingService struct {
+	embeddingRepo   embeddingdomain.Repository
+	moviesRepo      moviedomain.Repository
+	reviewsRepo     reviewdomain.Repository
+	embedder        embeddingdomain.Embedder
+	resultTxManager transactionmanager.TransactionManager[*embeddingdomain.SearchResult]
+	batchSize       int
+	minVotes        int
+}
+
+func NewEmbeddingService(embeddingRepo embeddingdomain.Repository, moviesRepo moviedomain.Repository, reviewsRepo reviewdomain.Repository, embedder embeddingdomain.Embedder,
+	resultTxManager transactionmanager.TransactionManager[*embeddingdomain.SearchResult], batchSize int, minVotes int) *EmbeddingService {
+	if batchSize <= 0 {
+		batchSize = embeddingdomain.DefaultBatchSize
+	}
+	return &EmbeddingService{embeddingRepo: embeddingRepo, moviesRepo: moviesRepo, reviewsRepo: reviewsRepo, embedder: embedder, resultTxManager: resultTxManager,
+		batchSize: batchSize, minVotes: minVotes}
+}
+
+func (e *EmbeddingService) Search(ctx context.Context, query object.SemanticQuery) (*embeddingdomain.SearchResult, error) {
+	if e.embedder == nil {
+		return nil, error2.ErrEmbedderIsNotConfigured
+	}
+
+	vectors, err := e.embedder.Embed(ctx, []string{query.Text})
+	if err != nil {
+		slog.Error("EmbeddingService.Search failed to embed query", "error", err)
+		return nil, err
+	}
+
+	return e.resultTxManager.InTransaction(ctx, func(ctx context.Context) (*embeddingdomain.SearchResult, error) {
+		matches, err := e.embeddingRepo.Search(ctx, e.embedder.Model(), vectors[0], query.Types, query.Limit)
+		if err != nil {
+			slog.Error("EmbeddingService.Search failed to search embeddings", "error", err)
+			return nil, err
+		}
+
+		movieIDs := make([]movieobject.MovieID, 0, len(matches))
+		reviewIDs := make([]reviewobject.ReviewID, 0, len(matches))
+		for _, match := range matches {
+			movieID, err := movieobject.NewMovieID(match.MovieID)
+			if err != nil {
+				slog.Error("EmbeddingService.Search invalid movie id", "error", err)
+				return nil, err
+			}
+			movieIDs = append(movieIDs, movieID)
+			if match.EntityType == object.EntityTypeReview {
+				reviewID, err := reviewobject.NewReviewID(match.EntityID)
+				if err != nil {
+					slog.Error("EmbeddingService.Search invalid review id", "error", err)
+					return nil, err
+				}
+				reviewIDs = append(reviewIDs, reviewID)
+			}
+		}
+
+		movies, err := e.moviesRepo.GetByIDs(ctx, movieIDs)
+		if err != nil {
+			slog.Error("EmbeddingService.Search failed to get movies", "error", err)
+			return nil, err
+		}
+		stats, err := moviedomain.LoadRatingStats(ctx, e.moviesRepo, e.minVotes)
+		if err != nil {
+			slog.Error("EmbeddingService.Search failed to get rating stats", "error", err)
+			return nil, err
+		}
+		stats.Apply(movies...)
+		reviews, err := e.reviewsRepo.GetReviewsByIDs(ctx, reviewIDs)
+		if err != nil {
+			slog.Error("EmbeddingService.Search failed to get reviews", "error", err)
+			return nil, err
+		}
+
+		moviesByID := make(map[string]*moviedomain.Movie, len(movies))
+		for _, movie := range movies {
+			moviesByID[movie.ID().ID()] = movie
+		}
+		reviewsByID := make(map[string]*reviewdomain.ReviewInfo, len(reviews))
+		for _, review := range reviews {
+			reviewsByID[review.ID] = review
+		}
+
+		result := &embeddingdomain.SearchResult{Query: query.Text, Model: e.embedder.Model(), Hits: make([]*embeddingdomain.Hit, 0, len(matches))}
+		for _, match := range matches {
+			hit := &embeddingdomain.Hit{EntityType: match.EntityType, Similarity: match.Similarity, Movie: moviesByID[match.MovieID]}
+			if hit.Movie == nil {
+				continue
+			}
+			if match.EntityType == object.EntityTypeReview {
+				if hit.Review = reviewsByID[match.EntityID]; hit.Review == nil {
+					continue
+				}
+			}
+			result.Hits = append(result.Hits, hit)
+		}
+		slog.Debug("EmbeddingService.Search semantic search finished", "query", query.Text, "count", len(result.Hits))
+		return result, nil
+	})
+}
+
+func (e *EmbeddingService) ProcessPending(ctx context.Context) (int, error) {
+	if e.embedder == nil {
+		return 0, error2.ErrEmbedderIsNotConfigured
+	}
+
+	requeued, err := e.embeddingRepo.Requeue(ctx, e.embedder.Model())
+	if err != nil {
+		slog.Error("EmbeddingService.ProcessPending failed to requeue stale embeddings", "error", err)
+		return 0, err
+	}
+	if requeued > 0 {
+		slog.Info("EmbeddingService.ProcessPending requeued embeddings of another model", "count", requeued, "model", e.embedder.Model())
+	}
+
+	processed := 0
+	for {
+		documents, err := e.embeddingRepo.GetPending(ctx, e.batchSize, embeddingdomain.MaxAttempts)
+		if err != nil {
+			slog.Error("EmbeddingService.ProcessPending failed to get pending documents", "error", err)
+			return processed, err
+		}
+		if len(documents) == 0 {
+			return processed, nil
+		}
+
+		embedded, err := e.embedBatch(ctx, documents)
+		processed += embedded
+		if err != nil {
+			return processed, err
+		}
+		if len(documents) < e.batchSize {
+			return processed, nil
+		}
+	}
+}
+
+func (e *EmbeddingService) embedBatch(ctx context.Context, documents []*embeddingdomain.Document) (int, error) {
+	texts := make([]string, 0, len(documents))
+	for _, document := range documents {
+		texts = append(texts, document.Text)
+	}
+
+	vectors, err := e.embedder.Embed(ctx, texts)
+	if err == nil {
+		for i, document := range documents {
+			if err = e.embeddingRepo.Save(ctx, document, e.embedder.Model(), vectors[i]); err != nil {
+				slog.Error("EmbeddingService.ProcessPending failed to save embedding", "error", err)
+				return i, err
+			}
+		}
+		return len(documents), nil
+	}
+	slog.Warn("EmbeddingService.ProcessPending batch failed, embedding documents one by one", "error", err)
+
+	embedded := 0
+	failed := make([]*embeddingdomain.Document, 0)
+	var lastErr error
+	for _, document := range documents {
+		vectors, err := e.embedder.Embed(ctx, []string{document.Text})
+		if err != nil {
+			lastErr = err
+			failed = append(failed, document)
+			continue
+		}
+		if err = e.embeddingRepo.Save(ctx, document, e.embedder.Model(), vectors[0]); err != nil {
+			slog.Error("EmbeddingService.ProcessPending failed to save embedding", "error", err)
+			return embedded, err
+		}
+		embedded++
+	}
+	if embedded == 0 {
+		slog.Error("EmbeddingService.ProcessPending embedder is unavailable", "error", lastErr)
+		return 0, lastErr
+	}
+
+	for _, document := range failed {
+		slog.Warn("EmbeddingService.ProcessPending failed to embed document", "entityType", document.EntityType.String(), "entityID", document.EntityID)
+		if err = e.embeddingRepo.MarkFailed(ctx, document); err != nil {
+			slog.Error("EmbeddingService.ProcessPending failed to mark document", "error", err)
+			return embedded, err
+		}
+	}
+	return embedded, nil
+}
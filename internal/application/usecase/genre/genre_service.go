@@ -0,0 +1,124 @@
+package genre
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	genredomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type GenreService struct {
+	genreRepo      genredomain.Repository
+	userRepo       userdomain.Repository
+	genreTxManager transactionmanager.TransactionManager[*genredomain.Genre]
+	statsTxManager transactionmanager.TransactionManager[[]*genredomain.GenreStats]
+	txUser         transactionmanager.TransactionUser
+}
+
+func NewGenreService(genreRepo genredomain.Repository, userRepo userdomain.Repository, genreTxManager transactionmanager.TransactionManager[*genredomain.Genre], statsTxManager transactionmanager.TransactionManager[[]*genredomain.GenreStats], txUser transactionmanager.TransactionUser) *GenreService {
+	return &GenreService{genreRepo: genreRepo, userRepo: userRepo, genreTxManager: genreTxManager, statsTxManager: statsTxManager, txUser: txUser}
+}
+
+func (g *GenreService) GetAll(ctx context.Context) ([]*genredomain.GenreStats, error) {
+	return g.statsTxManager.InTransaction(ctx, func(ctx context.Context) ([]*genredomain.GenreStats, error) {
+		stats, err := g.genreRepo.GetAllWithStats(ctx)
+		if err != nil {
+			slog.Error("GenreService.GetAll failed to get genres", "error", err)
+			return nil, err
+		}
+		slog.Debug("GenreService.GetAll genres successfully found", "count", len(stats))
+		return stats, nil
+	})
+}
+
+func (g *GenreService) FindBySlug(ctx context.Context, slug string) (*genredomain.Genre, error) {
+	return g.genreTxManager.InTransaction(ctx, func(ctx context.Context) (*genredomain.Genre, error) {
+		genre, err := g.genreRepo.GetBySlug(ctx, slug)
+		if err != nil {
+			slog.Error("GenreService.FindBySlug failed to get genre", "error", err)
+			return nil, err
+		}
+		return genre, nil
+	})
+}
+
+func (g *GenreService) CreateGenre(ctx context.Context, actorID userobject.UserID, genre *genredomain.Genre) (*genredomain.Genre, error) {
+	err := genredomain.ValidateGenre(genre)
+	if err != nil {
+		slog.Error("GenreService.CreateGenre validation failed", "error", err)
+		return nil, err
+	}
+	return g.genreTxManager.InTransaction(ctx, func(ctx context.Context) (*genredomain.Genre, error) {
+		err := userdomain.CheckPermission(ctx, g.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("GenreService.CreateGenre permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		err = g.genreRepo.Save(ctx, genre)
+		if err != nil {
+			slog.Error("GenreService.CreateGenre failed to save genre", "error", err)
+			return nil, err
+		}
+		slog.Debug("GenreService.CreateGenre genre successfully created", "genreID", genre.ID().ID())
+		return genre, nil
+	})
+}
+
+func (g *GenreService) UpdateGenre(ctx context.Context, actorID userobject.UserID, slug string, patch genredomain.GenrePatch) (*genredomain.Genre, error) {
+	return g.genreTxManager.InTransaction(ctx, func(ctx context.Context) (*genredomain.Genre, error) {
+		err := userdomain.CheckPermission(ctx, g.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("GenreService.UpdateGenre permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		genre, err := g.genreRepo.GetBySlug(ctx, slug)
+		if err != nil {
+			slog.Error("GenreService.UpdateGenre failed to get genre", "error", err)
+			return nil, err
+		}
+
+		genre.ApplyPatch(patch)
+		err = genredomain.ValidateGenre(genre)
+		if err != nil {
+			slog.Error("GenreService.UpdateGenre validation failed", "error", err)
+			return nil, err
+		}
+
+		err = g.genreRepo.Update(ctx, genre)
+		if err != nil {
+			slog.Error("GenreService.UpdateGenre failed to update genre", "error", err)
+			return nil, err
+		}
+		slog.Debug("GenreService.UpdateGenre genre successfully updated", "genreID", genre.ID().ID())
+		return genre, nil
+	})
+}
+
+func (g *GenreService) DeleteGenre(ctx context.Context, actorID userobject.UserID, slug string) error {
+	return g.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		err := userdomain.CheckPermission(ctx, g.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("GenreService.DeleteGenre permission check failed", "error", err, "actorID", actorID.ID())
+			return err
+		}
+
+		genre, err := g.genreRepo.GetBySlug(ctx, slug)
+		if err != nil {
+			slog.Error("GenreService.DeleteGenre failed to get genre", "error", err)
+			return err
+		}
+
+		err = g.genreRepo.Delete(ctx, genre.ID())
+		if err != nil {
+			slog.Error("GenreService.DeleteGenre failed to delete genre", "error", err)
+			return err
+		}
+		slog.Debug("GenreService.DeleteGenre genre successfully deleted", "genreID", genre.ID().ID())
+		return nil
+	})
+}
@@ -0,0 +1,170 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	imagedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/image"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/image/object"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type ImageService struct {
+	imageRepo      imagedomain.Repository
+	moviesRepo     moviedomain.Repository
+	userRepo       userdomain.Repository
+	blobStore      imagedomain.BlobStore
+	processor      imagedomain.Processor
+	imageTxManager transactionmanager.TransactionManager[*imagedomain.Image]
+	movieIDManager transactionmanager.TransactionManager[movieobject.MovieID]
+	maxUploadBytes int64
+}
+
+func NewImageService(imageRepo imagedomain.Repository, moviesRepo moviedomain.Repository, userRepo userdomain.Repository, blobStore imagedomain.BlobStore, processor imagedomain.Processor, imageTxManager transactionmanager.TransactionManager[*imagedomain.Image], movieIDManager transactionmanager.TransactionManager[movieobject.MovieID], maxUploadBytes int64) *ImageService {
+	if maxUploadBytes <= 0 {
+		maxUploadBytes = imagedomain.DefaultMaxUploadBytes
+	}
+	return &ImageService{imageRepo: imageRepo, moviesRepo: moviesRepo, userRepo: userRepo, blobStore: blobStore, processor: processor,
+		imageTxManager: imageTxManager, movieIDManager: movieIDManager, maxUploadBytes: maxUploadBytes}
+}
+
+func (i *ImageService) UploadMovieImage(ctx context.Context, actorID userobject.UserID, ref movieobject.MovieRef, kind object.Kind, contentType string, data []byte) (*imagedomain.Image, error) {
+	if int64(len(data)) > i.maxUploadBytes {
+		slog.Error("ImageService.UploadMovieImage image is too large", "size", len(data))
+		return nil, error2.ErrImageIsTooLarge
+	}
+	err := imagedomain.ValidateContentType(contentType)
+	if err != nil {
+		slog.Error("ImageService.UploadMovieImage content type is not allowed", "contentType", contentType)
+		return nil, err
+	}
+
+	movieID, err := i.findMovieForActor(ctx, actorID, ref)
+	if err != nil {
+		slog.Error("ImageService.UploadMovieImage failed to get movie", "error", err)
+		return nil, err
+	}
+
+	processed, err := i.processor.Process(data, kind)
+	if err != nil {
+		slog.Error("ImageService.UploadMovieImage failed to process image", "error", err)
+		return nil, err
+	}
+	if processed.ContentType != contentType {
+		slog.Error("ImageService.UploadMovieImage content type mismatch", "declared", contentType, "detected", processed.ContentType)
+		return nil, error2.ErrImageContentTypeIsNotAllowed
+	}
+
+	image := imagedomain.NewImage(movieID, kind, processed.ContentType, processed.Width, processed.Height, int64(len(data)))
+	err = i.storeVariants(ctx, image, processed)
+	if err != nil {
+		slog.Error("ImageService.UploadMovieImage failed to store image", "error", err)
+		return nil, err
+	}
+
+	previous, err := i.imageTxManager.InTransaction(ctx, func(ctx context.Context) (*imagedomain.Image, error) {
+		previous, err := i.imageRepo.GetByMovieAndKind(ctx, movieID, kind)
+		if err != nil && !errors.Is(err, error2.ErrImageIsNotFound) {
+			return nil, err
+		}
+		if previous != nil {
+			if err = i.imageRepo.Delete(ctx, previous.ID()); err != nil {
+				return nil, err
+			}
+		}
+		return previous, i.imageRepo.Save(ctx, image)
+	})
+	if err != nil {
+		slog.Error("ImageService.UploadMovieImage failed to save image", "error", err)
+		i.deleteBlobs(ctx, image)
+		return nil, err
+	}
+	if previous != nil {
+		i.deleteBlobs(ctx, previous)
+	}
+	slog.Debug("ImageService.UploadMovieImage image successfully uploaded", "imageID", image.ID().ID(), "movieID", movieID.ID())
+	return image, nil
+}
+
+func (i *ImageService) DeleteMovieImage(ctx context.Context, actorID userobject.UserID, ref movieobject.MovieRef, kind object.Kind) error {
+	image, err := i.imageTxManager.InTransaction(ctx, func(ctx context.Context) (*imagedomain.Image, error) {
+		err := userdomain.CheckPermission(ctx, i.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			return nil, err
+		}
+		movieID, err := moviedomain.FindIDByRef(ctx, i.moviesRepo, ref)
+		if err != nil {
+			return nil, err
+		}
+		image, err := i.imageRepo.GetByMovieAndKind(ctx, movieID, kind)
+		if err != nil {
+			return nil, err
+		}
+		return image, i.imageRepo.Delete(ctx, image.ID())
+	})
+	if err != nil {
+		slog.Error("ImageService.DeleteMovieImage failed to delete image", "error", err)
+		return err
+	}
+
+	i.deleteBlobs(ctx, image)
+	slog.Debug("ImageService.DeleteMovieImage image successfully deleted", "imageID", image.ID().ID())
+	return nil
+}
+
+func (i *ImageService) OpenVariant(ctx context.Context, imageID object.ImageID, variant object.Variant) (*imagedomain.Image, *imagedomain.Blob, error) {
+	image, err := i.imageTxManager.InTransaction(ctx, func(ctx context.Context) (*imagedomain.Image, error) {
+		return i.imageRepo.GetByID(ctx, imageID)
+	})
+	if err != nil {
+		slog.Error("ImageService.OpenVariant failed to get image", "error", err)
+		return nil, nil, err
+	}
+
+	blob, err := i.blobStore.Get(ctx, image.BlobKey(variant))
+	if errors.Is(err, error2.ErrBlobIsNotFound) {
+		slog.Error("ImageService.OpenVariant blob is missing", "imageID", imageID.ID(), "variant", variant)
+		return nil, nil, error2.ErrImageIsNotFound
+	}
+	if err != nil {
+		slog.Error("ImageService.OpenVariant failed to open blob", "error", err)
+		return nil, nil, err
+	}
+	return image, blob, nil
+}
+
+func (i *ImageService) findMovieForActor(ctx context.Context, actorID userobject.UserID, ref movieobject.MovieRef) (movieobject.MovieID, error) {
+	return i.movieIDManager.InTransaction(ctx, func(ctx context.Context) (movieobject.MovieID, error) {
+		err := userdomain.CheckPermission(ctx, i.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			return movieobject.MovieID{}, err
+		}
+		return moviedomain.FindIDByRef(ctx, i.moviesRepo, ref)
+	})
+}
+
+func (i *ImageService) storeVariants(ctx context.Context, image *imagedomain.Image, processed *imagedomain.ProcessedImage) error {
+	for variant, encoded := range processed.Variants {
+		err := i.blobStore.Put(ctx, image.BlobKey(variant), bytes.NewReader(encoded.Data))
+		if err != nil {
+			i.deleteBlobs(ctx, image)
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *ImageService) deleteBlobs(ctx context.Context, image *imagedomain.Image) {
+	for _, key := range image.BlobKeys() {
+		if err := i.blobStore.Delete(context.WithoutCancel(ctx), key); err != nil {
+			slog.Error("ImageService.deleteBlobs failed to delete blob", "error", err, "key", key)
+		}
+	}
+}
@@ -7,62 +7,108 @@ import (
 	"time"
 
 	jwtclaims "github.com/Vlad-Ali/Movies-service-back/internal/application/dto/jwt"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/session"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
 	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type JwtService struct {
-	secretKey string
+	keyRing     *user.KeyRing
+	accessTTL   time.Duration
+	revocations session.RevocationList
+	activity    session.ActivityTracker
 }
 
-func NewJwtService(secretKey string) *JwtService {
-	return &JwtService{secretKey: secretKey}
+func NewJwtService(keyRing *user.KeyRing, accessTTL time.Duration, revocations session.RevocationList, activity session.ActivityTracker) *JwtService {
+	if accessTTL <= 0 {
+		accessTTL = session.DefaultAccessTokenTTL
+	}
+	return &JwtService{keyRing: keyRing, accessTTL: accessTTL, revocations: revocations, activity: activity}
 }
 
-func (j *JwtService) GenerateToken(ctx context.Context, user *user.User) (string, error) {
+func (j *JwtService) GenerateToken(ctx context.Context, user *user.User, sessionID string) (object.AccessToken, error) {
 	now := time.Now()
-	duration := 24 * time.Hour
+	expiresAt := now.Add(j.accessTTL)
+	tokenID := uuid.NewString()
 	claims := jwtclaims.JWTClaims{
-		Username: user.Username(),
-		Email:    user.Email(),
-		UserID:   user.ID().ID(),
+		Username:  user.Username(),
+		Email:     user.Email(),
+		UserID:    user.ID().ID(),
+		Role:      user.Role().String(),
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+			ID:        tokenID,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
 	slog.Debug("generate token with id", "userID", user.ID().ID())
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(j.secretKey))
+	key := j.keyRing.Signing()
+	jwtToken := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	if key.ID != "" {
+		jwtToken.Header["kid"] = key.ID
+	}
+	token, err := jwtToken.SignedString(key.Private)
+	if err != nil {
+		return object.AccessToken{}, err
+	}
+	return object.AccessToken{Token: token, ID: tokenID, ExpiresAt: expiresAt}, nil
 }
 
-func (j *JwtService) ValidateToken(ctx context.Context, token string) (object.UserID, error) {
+func (j *JwtService) ValidateToken(ctx context.Context, token string) (object.TokenClaims, error) {
 	jwtToken, err := jwt.ParseWithClaims(token, &jwtclaims.JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := j.keyRing.Lookup(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key: %s", kid)
+		}
+		if token.Method.Alg() != key.Algorithm {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-
-		return []byte(j.secretKey), nil
+		return key.Public, nil
 	})
 
 	if err != nil {
 		slog.Error("validate token error", "error", err)
-		return object.UserID{}, usererror.ErrFailedToAuthorizeUser
+		return object.TokenClaims{}, usererror.ErrFailedToAuthorizeUser
 	}
 
 	if claims, ok := jwtToken.Claims.(*jwtclaims.JWTClaims); ok && jwtToken.Valid {
 		userID, err := object.NewUserID(claims.UserID)
 		if err != nil {
 			slog.Error("userID is incorrect", "error", err)
-			return object.UserID{}, err
+			return object.TokenClaims{}, err
+		}
+		role, err := object.NewRole(claims.Role)
+		if err != nil {
+			slog.Error("role is incorrect", "error", err)
+			return object.TokenClaims{}, usererror.ErrFailedToAuthorizeUser
+		}
+		revoked, err := j.revocations.IsRevoked(ctx, claims.ID, claims.SessionID)
+		if err != nil {
+			slog.Error("failed to check token revocation", "error", err)
+			return object.TokenClaims{}, usererror.ErrFailedToAuthorizeUser
+		}
+		if revoked {
+			slog.Error("token is revoked", "tokenID", claims.ID, "sessionID", claims.SessionID)
+			return object.TokenClaims{}, usererror.ErrFailedToAuthorizeUser
+		}
+		j.activity.Touch(claims.SessionID, time.Now())
+		var expiresAt time.Time
+		if claims.ExpiresAt != nil {
+			expiresAt = claims.ExpiresAt.Time
 		}
 		slog.Debug("validation of token is successful with ID", "ID", claims.UserID)
-		return userID, nil
+		return object.NewTokenClaims(userID, role, claims.ID, claims.SessionID, expiresAt), nil
 	}
 
 	slog.Error("validate token error", "error", err)
-	return object.UserID{}, usererror.ErrFailedToAuthorizeUser
+	return object.TokenClaims{}, usererror.ErrFailedToAuthorizeUser
+}
+
+func (j *JwtService) PublicKeys(ctx context.Context) []object.SigningKey {
+	return j.keyRing.PublicKeys()
 }
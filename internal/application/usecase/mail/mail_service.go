@@ -0,0 +1,60 @@
+package mail
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	maildomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail"
+)
+
+const DefaultBatchSize = 20
+
+type MailService struct {
+	outboxRepo maildomain.Repository
+	mailer     maildomain.Mailer
+	batchSize  int
+}
+
+func NewMailService(outboxRepo maildomain.Repository, mailer maildomain.Mailer, batchSize int) *MailService {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	return &MailService{outboxRepo: outboxRepo, mailer: mailer, batchSize: batchSize}
+}
+
+func (m *MailService) DeliverPending(ctx context.Context) (int, error) {
+	messages, err := m.outboxRepo.ClaimPending(ctx, m.batchSize, maildomain.ClaimLease)
+	if err != nil {
+		slog.Error("MailService.DeliverPending failed to claim messages", "error", err)
+		return 0, err
+	}
+
+	sent := 0
+	for _, message := range messages {
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+		sendErr := m.mailer.Send(ctx, message.Message)
+		if sendErr != nil {
+			slog.Error("MailService.DeliverPending failed to send message", "error", sendErr, "id", message.ID, "attempts", message.Attempts)
+			if message.Attempts >= maildomain.MaxAttempts {
+				slog.Warn("MailService.DeliverPending message dropped after max attempts", "id", message.ID, "to", message.To)
+			}
+			err = m.outboxRepo.MarkFailed(ctx, message.ID, sendErr.Error(), time.Now().Add(maildomain.RetryDelay(message.Attempts)))
+			if err != nil {
+				slog.Error("MailService.DeliverPending failed to mark message failed", "error", err, "id", message.ID)
+				return sent, err
+			}
+			continue
+		}
+
+		err = m.outboxRepo.MarkSent(ctx, message.ID)
+		if err != nil {
+			slog.Error("MailService.DeliverPending failed to mark message sent", "error", err, "id", message.ID)
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
@@ -0,0 +1,160 @@
+package movie
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	genredomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type MovieEnrichmentService struct {
+	provider       moviedomain.MetadataProvider
+	moviesRepo     moviedomain.Repository
+	personRepo     persondomain.Repository
+	genreRepo      genredomain.Repository
+	userRepo       userdomain.Repository
+	movieTxManager transactionmanager.TransactionManager[*moviedomain.Movie]
+}
+
+func NewMovieEnrichmentService(provider moviedomain.MetadataProvider, moviesRepo moviedomain.Repository, personRepo persondomain.Repository, genreRepo genredomain.Repository, userRepo userdomain.Repository, movieTxManager transactionmanager.TransactionManager[*moviedomain.Movie]) *MovieEnrichmentService {
+	return &MovieEnrichmentService{provider: provider, moviesRepo: moviesRepo, personRepo: personRepo, genreRepo: genreRepo, userRepo: userRepo, movieTxManager: movieTxManager}
+}
+
+func (m *MovieEnrichmentService) EnrichMovie(ctx context.Context, actorID userobject.UserID, ref object2.MovieRef) (*moviedomain.Movie, error) {
+	if m.provider == nil {
+		slog.Error("MovieEnrichmentService.EnrichMovie provider is not configured")
+		return nil, error2.ErrMetadataProviderIsNotConfigured
+	}
+
+	movie, err := m.movieTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.Movie, error) {
+		err := userdomain.CheckPermission(ctx, m.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("MovieEnrichmentService.EnrichMovie permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+		return moviedomain.FindByRef(ctx, m.moviesRepo, ref)
+	})
+	if err != nil {
+		slog.Error("MovieEnrichmentService.EnrichMovie failed to get movie", "error", err)
+		return nil, err
+	}
+
+	enriched, err := m.enrich(ctx, movie)
+	if err != nil {
+		slog.Error("MovieEnrichmentService.EnrichMovie failed to enrich movie", "error", err, "movieID", movie.ID().ID())
+		return nil, err
+	}
+	slog.Debug("MovieEnrichmentService.EnrichMovie movie successfully enriched", "movieID", movie.ID().ID())
+	return enriched, nil
+}
+
+func (m *MovieEnrichmentService) EnrichStale(ctx context.Context, staleBefore time.Time, limit int) (moviedomain.EnrichmentResult, error) {
+	var result moviedomain.EnrichmentResult
+	if m.provider == nil {
+		slog.Error("MovieEnrichmentService.EnrichStale provider is not configured")
+		return result, error2.ErrMetadataProviderIsNotConfigured
+	}
+
+	movies, err := m.moviesRepo.ListForEnrichment(ctx, staleBefore, limit)
+	if err != nil {
+		slog.Error("MovieEnrichmentService.EnrichStale failed to list movies", "error", err)
+		return result, err
+	}
+
+	for _, movie := range movies {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		_, err = m.enrich(ctx, movie)
+		switch {
+		case err == nil:
+			result.Enriched++
+		case errors.Is(err, error2.ErrMetadataIsNotFound):
+			result.NotFound++
+		case errors.Is(err, error2.ErrMetadataProviderRequestFailed):
+			slog.Error("MovieEnrichmentService.EnrichStale provider request failed", "error", err, "movieID", movie.ID().ID())
+			return result, err
+		default:
+			slog.Error("MovieEnrichmentService.EnrichStale failed to enrich movie", "error", err, "movieID", movie.ID().ID())
+			m.markAttempted(ctx, movie)
+			result.Failed++
+		}
+	}
+	slog.Debug("MovieEnrichmentService.EnrichStale movies processed", "enriched", result.Enriched, "notFound", result.NotFound,
+		"failed", result.Failed, "total", len(movies))
+	return result, nil
+}
+
+func (m *MovieEnrichmentService) enrich(ctx context.Context, movie *moviedomain.Movie) (*moviedomain.Movie, error) {
+	metadata, err := m.provider.FetchMetadata(ctx, movie)
+	if errors.Is(err, error2.ErrMetadataIsNotFound) {
+		m.markAttempted(ctx, movie)
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return m.movieTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.Movie, error) {
+		current, err := m.moviesRepo.GetByID(ctx, movie.ID())
+		if err != nil {
+			return nil, err
+		}
+		credits, err := m.personRepo.GetMovieCredits(ctx, current.ID())
+		if err != nil {
+			return nil, err
+		}
+		current.SetCredits(credits)
+		current.EnrichedAt = time.Now().UTC()
+
+		if len(metadata.Genres) > 0 {
+			genres, err := m.genreRepo.FindByNames(ctx, metadata.Genres)
+			if err != nil {
+				return nil, err
+			}
+			metadata.Genres = genredomain.GenreNames(genres)
+		}
+		applied := current.ApplyMetadata(m.provider.Name(), metadata)
+		err = moviedomain.ValidateMovie(current)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.moviesRepo.Update(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(applied, object2.MetadataFieldCredits) || slices.Contains(applied, object2.MetadataFieldGenres) {
+			err = moviedomain.SaveRelations(ctx, m.personRepo, m.genreRepo, current)
+			if err != nil {
+				return nil, err
+			}
+		}
+		slog.Debug("MovieEnrichmentService.enrich movie metadata applied", "movieID", current.ID().ID(), "fields", applied)
+		return current, nil
+	})
+}
+
+func (m *MovieEnrichmentService) markAttempted(ctx context.Context, movie *moviedomain.Movie) {
+	_, err := m.movieTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.Movie, error) {
+		current, err := m.moviesRepo.GetByID(ctx, movie.ID())
+		if err != nil {
+			return nil, err
+		}
+		current.EnrichedAt = time.Now().UTC()
+		return current, m.moviesRepo.Update(ctx, current)
+	})
+	if err != nil {
+		slog.Error("MovieEnrichmentService.markAttempted failed to update movie", "error", err, "movieID", movie.ID().ID())
+	}
+}
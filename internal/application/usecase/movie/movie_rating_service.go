@@ -0,0 +1,64 @@
+package movie
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type MovieRatingService struct {
+	moviesRepo       moviedomain.Repository
+	summaryTxManager transactionmanager.TransactionManager[*moviedomain.RatingSummary]
+	moviesTxManager  transactionmanager.TransactionManager[[]*moviedomain.Movie]
+	minVotes         int
+}
+
+func NewMovieRatingService(moviesRepo moviedomain.Repository, summaryTxManager transactionmanager.TransactionManager[*moviedomain.RatingSummary], moviesTxManager transactionmanager.TransactionManager[[]*moviedomain.Movie], minVotes int) *MovieRatingService {
+	return &MovieRatingService{moviesRepo: moviesRepo, summaryTxManager: summaryTxManager, moviesTxManager: moviesTxManager, minVotes: minVotes}
+}
+
+func (m *MovieRatingService) GetRatings(ctx context.Context, ref object2.MovieRef) (*moviedomain.RatingSummary, error) {
+	return m.summaryTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.RatingSummary, error) {
+		movie, err := moviedomain.FindByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieRatingService.GetRatings failed to get movie", "error", err)
+			return nil, err
+		}
+
+		stats, err := moviedomain.LoadRatingStats(ctx, m.moviesRepo, m.minVotes)
+		if err != nil {
+			slog.Error("MovieRatingService.GetRatings failed to get rating stats", "error", err)
+			return nil, err
+		}
+
+		counts, err := m.moviesRepo.GetRatingHistogram(ctx, movie.ID())
+		if err != nil {
+			slog.Error("MovieRatingService.GetRatings failed to get rating histogram", "error", err)
+			return nil, err
+		}
+		slog.Debug("MovieRatingService.GetRatings ratings successfully found", "movieID", movie.ID().ID(), "count", movie.RatingCount)
+		return moviedomain.NewRatingSummary(movie, stats, counts), nil
+	})
+}
+
+func (m *MovieRatingService) TopRated(ctx context.Context, query object2.TopRatedQuery) ([]*moviedomain.Movie, error) {
+	return m.moviesTxManager.InTransaction(ctx, func(ctx context.Context) ([]*moviedomain.Movie, error) {
+		stats, err := moviedomain.LoadRatingStats(ctx, m.moviesRepo, m.minVotes)
+		if err != nil {
+			slog.Error("MovieRatingService.TopRated failed to get rating stats", "error", err)
+			return nil, err
+		}
+
+		movies, err := m.moviesRepo.TopRated(ctx, query, stats)
+		if err != nil {
+			slog.Error("MovieRatingService.TopRated failed to get movies", "error", err)
+			return nil, err
+		}
+		stats.Apply(movies...)
+		slog.Debug("MovieRatingService.TopRated movies successfully found", "count", len(movies))
+		return movies, nil
+	})
+}
@@ -5,18 +5,29 @@ import (
 	"log/slog"
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	genredomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre"
 	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
 	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type MovieService struct {
-	moviesRepo          moviedomain.Repository
-	movieTxManager      transactionmanager.TransactionManager[*moviedomain.Movie]
-	movieSliceTxManager transactionmanager.TransactionManager[[]*moviedomain.Movie]
+	moviesRepo      moviedomain.Repository
+	movieTxManager  transactionmanager.TransactionManager[*moviedomain.Movie]
+	pageTxManager   transactionmanager.TransactionManager[*moviedomain.MoviePage]
+	searchTxManager transactionmanager.TransactionManager[*moviedomain.SearchResult]
+	txUser          transactionmanager.TransactionUser
+	userRepo        userdomain.Repository
+	personRepo      persondomain.Repository
+	genreRepo       genredomain.Repository
+	minVotes        int
 }
 
-func NewMovieService(moviesRepo moviedomain.Repository, txManager transactionmanager.TransactionManager[*moviedomain.Movie], movieSliceTxManager transactionmanager.TransactionManager[[]*moviedomain.Movie]) *MovieService {
-	return &MovieService{moviesRepo: moviesRepo, movieTxManager: txManager, movieSliceTxManager: movieSliceTxManager}
+func NewMovieService(moviesRepo moviedomain.Repository, txManager transactionmanager.TransactionManager[*moviedomain.Movie], pageTxManager transactionmanager.TransactionManager[*moviedomain.MoviePage], searchTxManager transactionmanager.TransactionManager[*moviedomain.SearchResult], txUser transactionmanager.TransactionUser, userRepo userdomain.Repository, personRepo persondomain.Repository, genreRepo genredomain.Repository, minVotes int) *MovieService {
+	return &MovieService{moviesRepo: moviesRepo, movieTxManager: txManager, pageTxManager: pageTxManager, searchTxManager: searchTxManager, txUser: txUser, userRepo: userRepo, personRepo: personRepo, genreRepo: genreRepo, minVotes: minVotes}
 }
 
 func (m *MovieService) FindByReleaseDateAndTitle(ctx context.Context, info object2.MovieInfo) (*moviedomain.Movie, error) {
@@ -31,14 +42,311 @@ func (m *MovieService) FindByReleaseDateAndTitle(ctx context.Context, info objec
 	})
 }
 
-func (m *MovieService) GetAll(ctx context.Context) ([]*moviedomain.Movie, error) {
-	return m.movieSliceTxManager.InTransaction(ctx, func(ctx context.Context) ([]*moviedomain.Movie, error) {
-		movies, err := m.moviesRepo.GetAll(ctx)
+func (m *MovieService) FindByRef(ctx context.Context, ref object2.MovieRef) (*moviedomain.Movie, error) {
+	return m.movieTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.Movie, error) {
+		movie, err := moviedomain.FindByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieService.FindByRef failed to get movie", "error", err)
+			return nil, err
+		}
+
+		credits, err := m.personRepo.GetMovieCredits(ctx, movie.ID())
+		if err != nil {
+			slog.Error("MovieService.FindByRef failed to get credits", "error", err)
+			return nil, err
+		}
+		movie.SetCredits(credits)
+
+		movie.Related, err = m.moviesRepo.GetRelatedMovies(ctx, movie.ID())
+		if err != nil {
+			slog.Error("MovieService.FindByRef failed to get related movies", "error", err)
+			return nil, err
+		}
+
+		movie.AlternateTitles, err = m.moviesRepo.GetAlternateTitles(ctx, movie.ID())
+		if err != nil {
+			slog.Error("MovieService.FindByRef failed to get alternate titles", "error", err)
+			return nil, err
+		}
+
+		stats, err := moviedomain.LoadRatingStats(ctx, m.moviesRepo, m.minVotes)
+		if err != nil {
+			slog.Error("MovieService.FindByRef failed to get rating stats", "error", err)
+			return nil, err
+		}
+		stats.Apply(movie)
+		slog.Debug("MovieService.FindByRef movie successfully found", "movieID", movie.ID().ID())
+		return movie, nil
+	})
+}
+
+func (m *MovieService) List(ctx context.Context, query object2.MovieListQuery) (*moviedomain.MoviePage, error) {
+	return m.pageTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.MoviePage, error) {
+		total, err := m.moviesRepo.Count(ctx, query.Filter)
+		if err != nil {
+			slog.Error("MovieService.List failed to count movies", "error", err)
+			return nil, err
+		}
+
+		movies, err := m.moviesRepo.List(ctx, query)
+		if err != nil {
+			slog.Error("MovieService.List failed to get movies", "error", err)
+			return nil, err
+		}
+
+		stats, err := moviedomain.LoadRatingStats(ctx, m.moviesRepo, m.minVotes)
+		if err != nil {
+			slog.Error("MovieService.List failed to get rating stats", "error", err)
+			return nil, err
+		}
+		stats.Apply(movies...)
+
+		page := &moviedomain.MoviePage{Movies: movies, Total: total}
+		if len(movies) > query.Limit {
+			page.Movies = movies[:query.Limit]
+			page.NextCursor = moviedomain.CursorForMovie(page.Movies[query.Limit-1], query).Encode()
+		}
+		slog.Debug("MovieService.List movies successfully found", "count", len(page.Movies), "total", total)
+		return page, nil
+	})
+}
+
+func (m *MovieService) Search(ctx context.Context, query object2.MovieSearchQuery) (*moviedomain.SearchResult, error) {
+	return m.searchTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.SearchResult, error) {
+		stats, err := moviedomain.LoadRatingStats(ctx, m.moviesRepo, m.minVotes)
+		if err != nil {
+			slog.Error("MovieService.Search failed to get rating stats", "error", err)
+			return nil, err
+		}
+
+		hits, err := m.moviesRepo.Search(ctx, query)
+		if err != nil {
+			slog.Error("MovieService.Search failed to search movies", "error", err)
+			return nil, err
+		}
+		if len(hits) > 0 {
+			result := &moviedomain.SearchResult{Hits: hits}
+			stats.Apply(result.Movies()...)
+			slog.Debug("MovieService.Search movies successfully found", "count", len(hits))
+			return result, nil
+		}
+
+		hits, err = m.moviesRepo.SearchSimilar(ctx, query)
+		if err != nil {
+			slog.Error("MovieService.Search failed to search similar movies", "error", err)
+			return nil, err
+		}
+		result := &moviedomain.SearchResult{Hits: hits, Fuzzy: true}
+		stats.Apply(result.Movies()...)
+		slog.Debug("MovieService.Search similar movies found", "count", len(hits))
+		return result, nil
+	})
+}
+
+func (m *MovieService) CreateMovie(ctx context.Context, actorID userobject.UserID, movie *moviedomain.Movie) (*moviedomain.Movie, error) {
+	err := moviedomain.ValidateMovie(movie)
+	if err != nil {
+		slog.Error("MovieService.CreateMovie validation failed", "error", err)
+		return nil, err
+	}
+	return m.movieTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.Movie, error) {
+		err := userdomain.CheckPermission(ctx, m.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("MovieService.CreateMovie permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		movie.MarkSource(object2.SourceManual, object2.MetadataFields...)
+
+		err = moviedomain.SaveWithUniqueSlug(ctx, m.moviesRepo, movie)
+		if err != nil {
+			slog.Error("MovieService.CreateMovie failed to save movie", "error", err)
+			return nil, err
+		}
+
+		err = moviedomain.SaveRelations(ctx, m.personRepo, m.genreRepo, movie)
+		if err != nil {
+			slog.Error("MovieService.CreateMovie failed to save credits and genres", "error", err)
+			return nil, err
+		}
+		slog.Debug("MovieService.CreateMovie movie successfully created", "movieID", movie.ID().ID())
+		return movie, nil
+	})
+}
+
+func (m *MovieService) UpdateMovie(ctx context.Context, actorID userobject.UserID, ref object2.MovieRef, movie *moviedomain.Movie) (*moviedomain.Movie, error) {
+	err := moviedomain.ValidateMovie(movie)
+	if err != nil {
+		slog.Error("MovieService.UpdateMovie validation failed", "error", err)
+		return nil, err
+	}
+	return m.movieTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.Movie, error) {
+		err := userdomain.CheckPermission(ctx, m.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("MovieService.UpdateMovie permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		existing, err := moviedomain.FindByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieService.UpdateMovie failed to get movie", "error", err)
+			return nil, err
+		}
+
+		err = movie.SetID(existing.ID())
+		if err != nil {
+			slog.Error("MovieService.UpdateMovie failed to set movie id", "error", err)
+			return nil, err
+		}
+		_ = movie.SetSlug(existing.Slug())
+		movie.Rating = existing.Rating
+		movie.RatingCount = existing.RatingCount
+		movie.EnrichedAt = existing.EnrichedAt
+		movie.MarkSource(object2.SourceManual, object2.MetadataFields...)
+
+		err = m.moviesRepo.Update(ctx, movie)
+		if err != nil {
+			slog.Error("MovieService.UpdateMovie failed to update movie", "error", err)
+			return nil, err
+		}
+
+		err = moviedomain.SaveRelations(ctx, m.personRepo, m.genreRepo, movie)
+		if err != nil {
+			slog.Error("MovieService.UpdateMovie failed to save credits and genres", "error", err)
+			return nil, err
+		}
+		slog.Debug("MovieService.UpdateMovie movie successfully updated", "movieID", movie.ID().ID())
+		return movie, nil
+	})
+}
+
+func (m *MovieService) PatchMovie(ctx context.Context, actorID userobject.UserID, ref object2.MovieRef, patch moviedomain.MoviePatch) (*moviedomain.Movie, error) {
+	return m.movieTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.Movie, error) {
+		err := userdomain.CheckPermission(ctx, m.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("MovieService.PatchMovie permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		movie, err := moviedomain.FindByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieService.PatchMovie failed to get movie", "error", err)
+			return nil, err
+		}
+
+		credits, err := m.personRepo.GetMovieCredits(ctx, movie.ID())
+		if err != nil {
+			slog.Error("MovieService.PatchMovie failed to get credits", "error", err)
+			return nil, err
+		}
+		movie.SetCredits(credits)
+
+		movie.ApplyPatch(patch)
+		movie.MarkSource(object2.SourceManual, patch.Fields()...)
+		err = moviedomain.ValidateMovie(movie)
+		if err != nil {
+			slog.Error("MovieService.PatchMovie validation failed", "error", err)
+			return nil, err
+		}
+
+		err = m.moviesRepo.Update(ctx, movie)
+		if err != nil {
+			slog.Error("MovieService.PatchMovie failed to update movie", "error", err)
+			return nil, err
+		}
+
+		err = moviedomain.SaveRelations(ctx, m.personRepo, m.genreRepo, movie)
+		if err != nil {
+			slog.Error("MovieService.PatchMovie failed to save credits and genres", "error", err)
+			return nil, err
+		}
+		slog.Debug("MovieService.PatchMovie movie successfully patched", "movieID", movie.ID().ID())
+		return movie, nil
+	})
+}
+
+func (m *MovieService) DeleteMovie(ctx context.Context, actorID userobject.UserID, ref object2.MovieRef) error {
+	return m.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		err := userdomain.CheckPermission(ctx, m.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("MovieService.DeleteMovie permission check failed", "error", err, "actorID", actorID.ID())
+			return err
+		}
+
+		movieID, err := moviedomain.FindIDByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieService.DeleteMovie failed to get movie", "error", err)
+			return err
+		}
+
+		err = m.moviesRepo.Delete(ctx, movieID)
+		if err != nil {
+			slog.Error("MovieService.DeleteMovie failed to delete movie", "error", err)
+			return err
+		}
+		slog.Debug("MovieService.DeleteMovie movie successfully deleted", "movieID", movieID.ID())
+		return nil
+	})
+}
+
+func (m *MovieService) SaveRelatedMovie(ctx context.Context, actorID userobject.UserID, ref object2.MovieRef, relatedRef object2.MovieRef, relationType object2.RelationType) (*moviedomain.Movie, error) {
+	return m.movieTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.Movie, error) {
+		err := userdomain.CheckPermission(ctx, m.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("MovieService.SaveRelatedMovie permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		movieID, err := moviedomain.FindIDByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieService.SaveRelatedMovie failed to get movie", "error", err)
+			return nil, err
+		}
+		relatedID, err := moviedomain.FindIDByRef(ctx, m.moviesRepo, relatedRef)
+		if err != nil {
+			slog.Error("MovieService.SaveRelatedMovie failed to get related movie", "error", err)
+			return nil, err
+		}
+		if movieID == relatedID {
+			slog.Error("MovieService.SaveRelatedMovie movie can not be related to itself", "movieID", movieID.ID())
+			return nil, error2.ErrMovieRelationIsNotValid
+		}
+
+		err = m.moviesRepo.SaveRelatedMovie(ctx, movieID, relatedID, relationType)
+		if err != nil {
+			slog.Error("MovieService.SaveRelatedMovie failed to save relation", "error", err)
+			return nil, err
+		}
+		slog.Debug("MovieService.SaveRelatedMovie relation successfully saved", "movieID", movieID.ID(), "relatedID", relatedID.ID())
+		return m.FindByRef(ctx, object2.NewMovieRefByID(movieID))
+	})
+}
+
+func (m *MovieService) DeleteRelatedMovie(ctx context.Context, actorID userobject.UserID, ref object2.MovieRef, relatedRef object2.MovieRef) (*moviedomain.Movie, error) {
+	return m.movieTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.Movie, error) {
+		err := userdomain.CheckPermission(ctx, m.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("MovieService.DeleteRelatedMovie permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		movieID, err := moviedomain.FindIDByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieService.DeleteRelatedMovie failed to get movie", "error", err)
+			return nil, err
+		}
+		relatedID, err := moviedomain.FindIDByRef(ctx, m.moviesRepo, relatedRef)
+		if err != nil {
+			slog.Error("MovieService.DeleteRelatedMovie failed to get related movie", "error", err)
+			return nil, err
+		}
+
+		err = m.moviesRepo.DeleteRelatedMovie(ctx, movieID, relatedID)
 		if err != nil {
-			slog.Error("MovieService.GetAll failed to get movies", "error", err)
+			slog.Error("MovieService.DeleteRelatedMovie failed to delete relation", "error", err)
 			return nil, err
 		}
-		slog.Debug("MovieService.GetAll all movies successfully found")
-		return movies, nil
+		slog.Debug("MovieService.DeleteRelatedMovie relation successfully deleted", "movieID", movieID.ID(), "relatedID", relatedID.ID())
+		return m.FindByRef(ctx, object2.NewMovieRefByID(movieID))
 	})
 }
@@ -0,0 +1,69 @@
+package movie
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type MovieSimilarityService struct {
+	moviesRepo       moviedomain.Repository
+	strategy         moviedomain.SimilarityStrategy
+	similarTxManager transactionmanager.TransactionManager[[]*moviedomain.SimilarMovie]
+	minVotes         int
+}
+
+func NewMovieSimilarityService(moviesRepo moviedomain.Repository, strategy moviedomain.SimilarityStrategy, similarTxManager transactionmanager.TransactionManager[[]*moviedomain.SimilarMovie], minVotes int) *MovieSimilarityService {
+	return &MovieSimilarityService{moviesRepo: moviesRepo, strategy: strategy, similarTxManager: similarTxManager, minVotes: minVotes}
+}
+
+func (m *MovieSimilarityService) Similar(ctx context.Context, ref object2.MovieRef, query object2.SimilarQuery) ([]*moviedomain.SimilarMovie, error) {
+	return m.similarTxManager.InTransaction(ctx, func(ctx context.Context) ([]*moviedomain.SimilarMovie, error) {
+		movie, err := moviedomain.FindByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieSimilarityService.Similar failed to get movie", "error", err)
+			return nil, err
+		}
+
+		matches, err := m.strategy.Similar(ctx, movie.ID(), query.Limit)
+		if err != nil {
+			slog.Error("MovieSimilarityService.Similar failed to find similar movies", "error", err, "strategy", m.strategy.Name())
+			return nil, err
+		}
+
+		movieIDs := make([]object2.MovieID, 0, len(matches))
+		for _, match := range matches {
+			movieIDs = append(movieIDs, match.MovieID)
+		}
+		movies, err := m.moviesRepo.GetByIDs(ctx, movieIDs)
+		if err != nil {
+			slog.Error("MovieSimilarityService.Similar failed to get movies", "error", err)
+			return nil, err
+		}
+
+		stats, err := moviedomain.LoadRatingStats(ctx, m.moviesRepo, m.minVotes)
+		if err != nil {
+			slog.Error("MovieSimilarityService.Similar failed to get rating stats", "error", err)
+			return nil, err
+		}
+		stats.Apply(movies...)
+
+		moviesByID := make(map[object2.MovieID]*moviedomain.Movie, len(movies))
+		for _, similar := range movies {
+			moviesByID[similar.ID()] = similar
+		}
+		similarMovies := make([]*moviedomain.SimilarMovie, 0, len(matches))
+		for _, match := range matches {
+			similar, ok := moviesByID[match.MovieID]
+			if !ok {
+				continue
+			}
+			similarMovies = append(similarMovies, &moviedomain.SimilarMovie{Movie: similar, Score: match.Score, Reasons: match.Reasons})
+		}
+		slog.Debug("MovieSimilarityService.Similar similar movies successfully found", "movieID", movie.ID().ID(), "strategy", m.strategy.Name(), "count", len(similarMovies))
+		return similarMovies, nil
+	})
+}
@@ -0,0 +1,149 @@
+package movie
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type MovieTranslationService struct {
+	moviesRepo            moviedomain.Repository
+	userRepo              userdomain.Repository
+	translationsTxManager transactionmanager.TransactionManager[[]*moviedomain.Translation]
+	translationTxManager  transactionmanager.TransactionManager[*moviedomain.Translation]
+	titlesTxManager       transactionmanager.TransactionManager[[]*moviedomain.AlternateTitle]
+	txUser                transactionmanager.TransactionUser
+}
+
+func NewMovieTranslationService(moviesRepo moviedomain.Repository, userRepo userdomain.Repository, translationsTxManager transactionmanager.TransactionManager[[]*moviedomain.Translation], translationTxManager transactionmanager.TransactionManager[*moviedomain.Translation], titlesTxManager transactionmanager.TransactionManager[[]*moviedomain.AlternateTitle], txUser transactionmanager.TransactionUser) *MovieTranslationService {
+	return &MovieTranslationService{moviesRepo: moviesRepo, userRepo: userRepo, translationsTxManager: translationsTxManager, translationTxManager: translationTxManager, titlesTxManager: titlesTxManager, txUser: txUser}
+}
+
+func (m *MovieTranslationService) GetTranslations(ctx context.Context, ref object2.MovieRef) ([]*moviedomain.Translation, error) {
+	return m.translationsTxManager.InTransaction(ctx, func(ctx context.Context) ([]*moviedomain.Translation, error) {
+		movieID, err := moviedomain.FindIDByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieTranslationService.GetTranslations failed to get movie", "error", err)
+			return nil, err
+		}
+
+		translations, err := m.moviesRepo.GetTranslations(ctx, []object2.MovieID{movieID}, nil)
+		if err != nil {
+			slog.Error("MovieTranslationService.GetTranslations failed to get translations", "error", err)
+			return nil, err
+		}
+		slog.Debug("MovieTranslationService.GetTranslations translations successfully found", "movieID", movieID.ID(), "count", len(translations[movieID]))
+		if translations[movieID] == nil {
+			return make([]*moviedomain.Translation, 0), nil
+		}
+		return translations[movieID], nil
+	})
+}
+
+func (m *MovieTranslationService) SaveTranslation(ctx context.Context, actorID userobject.UserID, ref object2.MovieRef, translation *moviedomain.Translation) (*moviedomain.Translation, error) {
+	err := moviedomain.ValidateTranslation(translation)
+	if err != nil {
+		slog.Error("MovieTranslationService.SaveTranslation validation failed", "error", err)
+		return nil, err
+	}
+	return m.translationTxManager.InTransaction(ctx, func(ctx context.Context) (*moviedomain.Translation, error) {
+		err := userdomain.CheckPermission(ctx, m.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("MovieTranslationService.SaveTranslation permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		movieID, err := moviedomain.FindIDByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieTranslationService.SaveTranslation failed to get movie", "error", err)
+			return nil, err
+		}
+
+		err = m.moviesRepo.SaveTranslation(ctx, movieID, translation)
+		if err != nil {
+			slog.Error("MovieTranslationService.SaveTranslation failed to save translation", "error", err)
+			return nil, err
+		}
+		slog.Debug("MovieTranslationService.SaveTranslation translation successfully saved", "movieID", movieID.ID(), "language", translation.Language.String())
+		return translation, nil
+	})
+}
+
+func (m *MovieTranslationService) DeleteTranslation(ctx context.Context, actorID userobject.UserID, ref object2.MovieRef, language object2.LanguageTag) error {
+	return m.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		err := userdomain.CheckPermission(ctx, m.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("MovieTranslationService.DeleteTranslation permission check failed", "error", err, "actorID", actorID.ID())
+			return err
+		}
+
+		movieID, err := moviedomain.FindIDByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieTranslationService.DeleteTranslation failed to get movie", "error", err)
+			return err
+		}
+
+		err = m.moviesRepo.DeleteTranslation(ctx, movieID, language)
+		if err != nil {
+			slog.Error("MovieTranslationService.DeleteTranslation failed to delete translation", "error", err)
+			return err
+		}
+		slog.Debug("MovieTranslationService.DeleteTranslation translation successfully deleted", "movieID", movieID.ID(), "language", language.String())
+		return nil
+	})
+}
+
+func (m *MovieTranslationService) SaveAlternateTitles(ctx context.Context, actorID userobject.UserID, ref object2.MovieRef, titles []*moviedomain.AlternateTitle) ([]*moviedomain.AlternateTitle, error) {
+	err := moviedomain.ValidateAlternateTitles(titles)
+	if err != nil {
+		slog.Error("MovieTranslationService.SaveAlternateTitles validation failed", "error", err)
+		return nil, err
+	}
+	return m.titlesTxManager.InTransaction(ctx, func(ctx context.Context) ([]*moviedomain.AlternateTitle, error) {
+		err := userdomain.CheckPermission(ctx, m.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("MovieTranslationService.SaveAlternateTitles permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		movieID, err := moviedomain.FindIDByRef(ctx, m.moviesRepo, ref)
+		if err != nil {
+			slog.Error("MovieTranslationService.SaveAlternateTitles failed to get movie", "error", err)
+			return nil, err
+		}
+
+		err = m.moviesRepo.ReplaceAlternateTitles(ctx, movieID, titles)
+		if err != nil {
+			slog.Error("MovieTranslationService.SaveAlternateTitles failed to save titles", "error", err)
+			return nil, err
+		}
+		slog.Debug("MovieTranslationService.SaveAlternateTitles titles successfully saved", "movieID", movieID.ID(), "count", len(titles))
+		return m.moviesRepo.GetAlternateTitles(ctx, movieID)
+	})
+}
+
+func (m *MovieTranslationService) Localize(ctx context.Context, locale object2.Locale, items ...moviedomain.Localizable) error {
+	if locale.IsEmpty() || len(items) == 0 {
+		return nil
+	}
+	return m.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		movieIDs := make([]object2.MovieID, 0, len(items))
+		for _, item := range items {
+			movieIDs = append(movieIDs, item.LocalizationID())
+		}
+
+		translations, err := m.moviesRepo.GetTranslations(ctx, movieIDs, locale.Bases())
+		if err != nil {
+			slog.Error("MovieTranslationService.Localize failed to get translations", "error", err)
+			return err
+		}
+		moviedomain.Localize(items, translations, locale)
+		slog.Debug("MovieTranslationService.Localize movies successfully localized", "count", len(items))
+		return nil
+	})
+}
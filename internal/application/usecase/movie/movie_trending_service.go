@@ -0,0 +1,68 @@
+package movie
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type MovieTrendingService struct {
+	moviesRepo        moviedomain.Repository
+	trendingTxManager transactionmanager.TransactionManager[[]*moviedomain.TrendingMovie]
+	txUser            transactionmanager.TransactionUser
+	minVotes          int
+	size              int
+}
+
+func NewMovieTrendingService(moviesRepo moviedomain.Repository, trendingTxManager transactionmanager.TransactionManager[[]*moviedomain.TrendingMovie], txUser transactionmanager.TransactionUser, minVotes int, size int) *MovieTrendingService {
+	if size <= 0 {
+		size = moviedomain.DefaultTrendingSize
+	}
+	return &MovieTrendingService{moviesRepo: moviesRepo, trendingTxManager: trendingTxManager, txUser: txUser, minVotes: minVotes, size: size}
+}
+
+func (m *MovieTrendingService) Trending(ctx context.Context, query object2.TrendingQuery) ([]*moviedomain.TrendingMovie, error) {
+	return m.trendingTxManager.InTransaction(ctx, func(ctx context.Context) ([]*moviedomain.TrendingMovie, error) {
+		trending, err := m.moviesRepo.GetTrending(ctx, query.Window, query.Limit)
+		if err != nil {
+			slog.Error("MovieTrendingService.Trending failed to get trending movies", "error", err)
+			return nil, err
+		}
+
+		stats, err := moviedomain.LoadRatingStats(ctx, m.moviesRepo, m.minVotes)
+		if err != nil {
+			slog.Error("MovieTrendingService.Trending failed to get rating stats", "error", err)
+			return nil, err
+		}
+		for _, item := range trending {
+			stats.Apply(item.Movie)
+		}
+		slog.Debug("MovieTrendingService.Trending movies successfully found", "window", query.Window.String(), "count", len(trending))
+		return trending, nil
+	})
+}
+
+func (m *MovieTrendingService) RefreshTrending(ctx context.Context) (int, error) {
+	ranked := 0
+	err := m.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		for _, window := range object2.TrendingWindows() {
+			count, err := m.moviesRepo.RefreshTrending(ctx, window, now, moviedomain.DefaultTrendingWeights, m.size)
+			if err != nil {
+				slog.Error("MovieTrendingService.RefreshTrending failed to refresh window", "error", err, "window", window.String())
+				return err
+			}
+			ranked += count
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	slog.Debug("MovieTrendingService.RefreshTrending trending movies refreshed", "ranked", ranked)
+	return ranked, nil
+}
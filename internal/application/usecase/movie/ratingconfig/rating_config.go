@@ -0,0 +1,5 @@
+package ratingconfig
+
+type RatingConfig struct {
+	MinVotes int `yaml:"min_votes"`
+}
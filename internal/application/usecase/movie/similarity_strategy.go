@@ -0,0 +1,41 @@
+package movie
+
+import (
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/movie/similarityconfig"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+)
+
+const (
+	SimilarityStrategyContent  = "content"
+	SimilarityStrategyCoRating = "co_rating"
+	SimilarityStrategyHybrid   = "hybrid"
+
+	defaultContentWeight  = 0.5
+	defaultCoRatingWeight = 0.5
+)
+
+func NewSimilarityStrategy(moviesRepo moviedomain.Repository, config similarityconfig.SimilarityConfig) (moviedomain.SimilarityStrategy, error) {
+	content := moviedomain.NewContentSimilarity(moviesRepo, moviedomain.DefaultContentWeights)
+	coRating := moviedomain.NewCoRatingSimilarity(moviesRepo)
+
+	switch config.Strategy {
+	case SimilarityStrategyContent:
+		return content, nil
+	case SimilarityStrategyCoRating:
+		return coRating, nil
+	case "", SimilarityStrategyHybrid:
+		contentWeight, coRatingWeight := config.ContentWeight, config.CoRatingWeight
+		if contentWeight <= 0 && coRatingWeight <= 0 {
+			contentWeight, coRatingWeight = defaultContentWeight, defaultCoRatingWeight
+		}
+		return moviedomain.NewHybridSimilarity(
+			moviedomain.WeightedStrategy{Strategy: content, Weight: contentWeight},
+			moviedomain.WeightedStrategy{Strategy: coRating, Weight: coRatingWeight},
+		), nil
+	}
+	slog.Error("Unknown similarity strategy", "strategy", config.Strategy)
+	return nil, error2.ErrSimilarityStrategyIsNotValid
+}
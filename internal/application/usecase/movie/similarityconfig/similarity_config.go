@@ -0,0 +1,7 @@
+package similarityconfig
+
+type SimilarityConfig struct {
+	Strategy       string  `yaml:"strategy"`
+	ContentWeight  float64 `yaml:"content_weight"`
+	CoRatingWeight float64 `yaml:"co_rating_weight"`
+}
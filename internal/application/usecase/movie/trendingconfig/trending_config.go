@@ -0,0 +1,8 @@
+package trendingconfig
+
+import "time"
+
+type TrendingConfig struct {
+	JobInterval time.Duration `yaml:"job_interval"`
+	Size        int           `yaml:"size"`
+}
@@ -0,0 +1,5 @@
+package importconfig
+
+type ImportConfig struct {
+	MaxUploadBytes int64 `yaml:"max_upload_bytes"`
+}
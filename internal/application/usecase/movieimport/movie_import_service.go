@@ -0,0 +1,234 @@
+package movieimport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	genredomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre"
+	genreobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre/object"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	movieerror "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	importdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/object"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+var errDryRunRollback = errors.New("dry run rollback")
+
+type MovieImportService struct {
+	parserFactory importdomain.ParserFactory
+	moviesRepo    moviedomain.Repository
+	personRepo    persondomain.Repository
+	genreRepo     genredomain.Repository
+	userRepo      userdomain.Repository
+	txUser        transactionmanager.TransactionUser
+}
+
+func NewMovieImportService(parserFactory importdomain.ParserFactory, moviesRepo moviedomain.Repository, personRepo persondomain.Repository, genreRepo genredomain.Repository, userRepo userdomain.Repository, txUser transactionmanager.TransactionUser) *MovieImportService {
+	return &MovieImportService{parserFactory: parserFactory, moviesRepo: moviesRepo, personRepo: personRepo, genreRepo: genreRepo, userRepo: userRepo, txUser: txUser}
+}
+
+func (m *MovieImportService) ImportAs(ctx context.Context, actorID userobject.UserID, format object.Format, sources importdomain.Sources, options importdomain.Options) (*importdomain.Report, error) {
+	err := userdomain.CheckPermission(ctx, m.userRepo, actorID, userobject.PermissionManageCatalog)
+	if err != nil {
+		slog.Error("MovieImportService.ImportAs permission check failed", "error", err, "actorID", actorID.ID())
+		return nil, err
+	}
+	return m.Import(ctx, format, sources, options)
+}
+
+func (m *MovieImportService) Import(ctx context.Context, format object.Format, sources importdomain.Sources, options importdomain.Options) (*importdomain.Report, error) {
+	if options.BatchSize == 0 {
+		options.BatchSize = importdomain.DefaultBatchSize
+	}
+	if options.BatchSize < 0 || options.BatchSize > importdomain.MaxBatchSize {
+		slog.Error("MovieImportService.Import invalid batch size", "batchSize", options.BatchSize)
+		return nil, error2.ErrImportOptionsAreInvalid
+	}
+
+	parser, err := m.parserFactory.NewParser(format, sources)
+	if err != nil {
+		slog.Error("MovieImportService.Import failed to create parser", "error", err)
+		return nil, err
+	}
+
+	report := importdomain.NewReport(options.DryRun)
+	batch := make([]*importdomain.Record, 0, options.BatchSize)
+	for {
+		record, err := parser.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			slog.Error("MovieImportService.Import failed to read record", "error", err)
+			return report, err
+		}
+		if record.Skip {
+			report.Skipped++
+			continue
+		}
+		if record.Err != nil {
+			report.AddError(record.Line, record.Err)
+			continue
+		}
+		if err = moviedomain.ValidateMovie(record.Movie); err != nil {
+			report.AddError(record.Line, err)
+			continue
+		}
+
+		batch = append(batch, record)
+		if len(batch) == options.BatchSize {
+			if err = m.importBatch(ctx, batch, options, report); err != nil {
+				return report, err
+			}
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		if err = m.importBatch(ctx, batch, options, report); err != nil {
+			return report, err
+		}
+	}
+
+	slog.Info("MovieImportService.Import finished", "created", report.Created, "updated", report.Updated,
+		"skipped", report.Skipped, "failed", report.Failed, "dryRun", report.DryRun)
+	return report, nil
+}
+
+func (m *MovieImportService) importBatch(ctx context.Context, batch []*importdomain.Record, options importdomain.Options, report *importdomain.Report) error {
+	var created, updated int
+	lineErrors := make([]importdomain.LineError, 0)
+	err := m.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		created, updated = 0, 0
+		lineErrors = lineErrors[:0]
+		for _, record := range batch {
+			var isNew bool
+			err := transactionmanager.WithSavepoint(ctx, "import_record", func(ctx context.Context) error {
+				var err error
+				isNew, err = m.upsert(ctx, record.Movie, options)
+				return err
+			})
+			if err != nil {
+				lineErrors = append(lineErrors, importdomain.LineError{Line: record.Line, Message: err.Error()})
+				continue
+			}
+			if isNew {
+				created++
+			} else {
+				updated++
+			}
+		}
+		if options.DryRun {
+			return errDryRunRollback
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRunRollback) {
+		slog.Error("MovieImportService.importBatch failed to import batch", "error", err)
+		return err
+	}
+
+	report.Created += created
+	report.Updated += updated
+	for _, lineError := range lineErrors {
+		report.AddError(lineError.Line, errors.New(lineError.Message))
+	}
+	return nil
+}
+
+func (m *MovieImportService) upsert(ctx context.Context, movie *moviedomain.Movie, options importdomain.Options) (bool, error) {
+	if options.CreateGenres {
+		if err := m.ensureGenres(ctx, movie.Genres); err != nil {
+			return false, err
+		}
+	}
+
+	date := movie.ReleaseDate
+	movieID, err := m.moviesRepo.GetIDByReleaseDateAndTitle(ctx, movie.Title, date.Year(), int(date.Month()), date.Day())
+	if err != nil && !errors.Is(err, movieerror.ErrMovieIsNotFound) {
+		return false, err
+	}
+
+	created := err != nil
+	if created {
+		movie.MarkSource(movieobject.SourceImport, movieobject.MetadataFields...)
+		if err = moviedomain.SaveWithUniqueSlug(ctx, m.moviesRepo, movie); err != nil {
+			return false, err
+		}
+	} else {
+		existing, err := m.moviesRepo.GetByID(ctx, movieID)
+		if err != nil {
+			return false, err
+		}
+		credits, err := m.personRepo.GetMovieCredits(ctx, movieID)
+		if err != nil {
+			return false, err
+		}
+		existing.SetCredits(credits)
+
+		patch := importPatch(existing, movie)
+		existing.ApplyPatch(patch)
+		existing.MarkSource(movieobject.SourceImport, patch.Fields()...)
+		movie = existing
+		if err = m.moviesRepo.Update(ctx, movie); err != nil {
+			return false, err
+		}
+	}
+
+	if err = moviedomain.SaveRelations(ctx, m.personRepo, m.genreRepo, movie); err != nil {
+		return false, err
+	}
+	return created, nil
+}
+
+func importPatch(existing, movie *moviedomain.Movie) moviedomain.MoviePatch {
+	var patch moviedomain.MoviePatch
+	if movie.Description != "" && !existing.IsManual(movieobject.MetadataFieldDescription) {
+		patch.Description = &movie.Description
+	}
+	if len(movie.Genres) > 0 && !existing.IsManual(movieobject.MetadataFieldGenres) {
+		patch.Genres = &movie.Genres
+	}
+	if len(movie.Credits) > 0 && !existing.IsManual(movieobject.MetadataFieldCredits) {
+		patch.Credits = &movie.Credits
+	}
+	return patch
+}
+
+func (m *MovieImportService) ensureGenres(ctx context.Context, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	genres, err := m.genreRepo.FindByNames(ctx, names)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]bool)
+	for _, genre := range genres {
+		known[genre.Slug] = true
+		known[strings.ToLower(genre.Name)] = true
+	}
+	for _, name := range names {
+		slug := genreobject.NewGenreSlug(name)
+		if known[strings.ToLower(strings.TrimSpace(name))] || known[slug] {
+			continue
+		}
+		genre := genredomain.NewGenre(name, "")
+		if err = genredomain.ValidateGenre(genre); err != nil {
+			return err
+		}
+		if err = m.genreRepo.Save(ctx, genre); err != nil {
+			return err
+		}
+		known[slug] = true
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package person
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+)
+
+const searchLimit = 50
+
+type PersonService struct {
+	personRepo       persondomain.Repository
+	profileTxManager transactionmanager.TransactionManager[*persondomain.Profile]
+	peopleTxManager  transactionmanager.TransactionManager[[]*persondomain.Person]
+}
+
+func NewPersonService(personRepo persondomain.Repository, profileTxManager transactionmanager.TransactionManager[*persondomain.Profile], peopleTxManager transactionmanager.TransactionManager[[]*persondomain.Person]) *PersonService {
+	return &PersonService{personRepo: personRepo, profileTxManager: profileTxManager, peopleTxManager: peopleTxManager}
+}
+
+func (p *PersonService) FindByID(ctx context.Context, personID object.PersonID) (*persondomain.Profile, error) {
+	return p.profileTxManager.InTransaction(ctx, func(ctx context.Context) (*persondomain.Profile, error) {
+		person, err := p.personRepo.GetByID(ctx, personID)
+		if err != nil {
+			slog.Error("PersonService.FindByID failed to get person", "error", err)
+			return nil, err
+		}
+
+		filmography, err := p.personRepo.GetFilmography(ctx, personID)
+		if err != nil {
+			slog.Error("PersonService.FindByID failed to get filmography", "error", err)
+			return nil, err
+		}
+		slog.Debug("PersonService.FindByID person successfully found", "personID", personID.ID())
+		return &persondomain.Profile{Person: person, Filmography: filmography}, nil
+	})
+}
+
+func (p *PersonService) SearchByName(ctx context.Context, name string) ([]*persondomain.Person, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, error2.ErrPersonDataValidationFailed
+	}
+	return p.peopleTxManager.InTransaction(ctx, func(ctx context.Context) ([]*persondomain.Person, error) {
+		people, err := p.personRepo.SearchByName(ctx, name, searchLimit)
+		if err != nil {
+			slog.Error("PersonService.SearchByName failed to search people", "error", err)
+			return nil, err
+		}
+		slog.Debug("PersonService.SearchByName people successfully found", "count", len(people))
+		return people, nil
+	})
+}
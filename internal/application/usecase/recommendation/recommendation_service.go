@@ -0,0 +1,133 @@
+package recommendation
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	recommendationdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type RecommendationService struct {
+	recommendationRepo recommendationdomain.Repository
+	moviesRepo         moviedomain.Repository
+	txManager          transactionmanager.TransactionManager[[]*recommendationdomain.Recommendation]
+	txUser             transactionmanager.TransactionUser
+	params             recommendationdomain.ModelParams
+	minVotes           int
+}
+
+func NewRecommendationService(recommendationRepo recommendationdomain.Repository, moviesRepo moviedomain.Repository, txManager transactionmanager.TransactionManager[[]*recommendationdomain.Recommendation], txUser transactionmanager.TransactionUser, params recommendationdomain.ModelParams, minVotes int) *RecommendationService {
+	return &RecommendationService{recommendationRepo: recommendationRepo, moviesRepo: moviesRepo, txManager: txManager, txUser: txUser, params: params, minVotes: minVotes}
+}
+
+func (r *RecommendationService) Recommend(ctx context.Context, userID userobject.UserID, query object.RecommendationQuery) ([]*recommendationdomain.Recommendation, error) {
+	return r.txManager.InTransaction(ctx, func(ctx context.Context) ([]*recommendationdomain.Recommendation, error) {
+		neighbors, err := r.recommendationRepo.GetNeighbors(ctx, userID)
+		if err != nil {
+			slog.Error("RecommendationService.Recommend failed to get neighbors", "error", err)
+			return nil, err
+		}
+		collaborative := recommendationdomain.RankCollaborative(neighbors)
+		candidates := recommendationdomain.Merge(query.Limit, collaborative)
+
+		stats, err := moviedomain.LoadRatingStats(ctx, r.moviesRepo, r.minVotes)
+		if err != nil {
+			slog.Error("RecommendationService.Recommend failed to get rating stats", "error", err)
+			return nil, err
+		}
+
+		if len(candidates) < query.Limit {
+			seeds, err := r.recommendationRepo.GetSeeds(ctx, userID, recommendationdomain.LikedRating, recommendationdomain.MaxSeeds)
+			if err != nil {
+				slog.Error("RecommendationService.Recommend failed to get seeds", "error", err)
+				return nil, err
+			}
+			seedIDs := make([]movieobject.MovieID, 0, len(seeds))
+			for _, seed := range seeds {
+				seedIDs = append(seedIDs, seed.MovieID)
+			}
+
+			var content []*recommendationdomain.Candidate
+			if len(seeds) > 0 {
+				matches, err := r.recommendationRepo.GetContentMatches(ctx, userID, seedIDs)
+				if err != nil {
+					slog.Error("RecommendationService.Recommend failed to get content matches", "error", err)
+					return nil, err
+				}
+				content = recommendationdomain.RankContent(seeds, matches)
+				candidates = recommendationdomain.Merge(query.Limit, collaborative, content)
+			}
+
+			if len(candidates) < query.Limit {
+				rated, err := r.recommendationRepo.GetRatedMovieIDs(ctx, userID)
+				if err != nil {
+					slog.Error("RecommendationService.Recommend failed to get rated movies", "error", err)
+					return nil, err
+				}
+				exclude := append(rated, seedIDs...)
+				popular, err := r.moviesRepo.TopRated(ctx, movieobject.TopRatedQuery{Limit: query.Limit + len(exclude)}, stats)
+				if err != nil {
+					slog.Error("RecommendationService.Recommend failed to get popular movies", "error", err)
+					return nil, err
+				}
+				stats.Apply(popular...)
+				candidates = recommendationdomain.Merge(query.Limit, collaborative, content, recommendationdomain.PopularCandidates(popular, exclude))
+			}
+		}
+
+		movieIDs := make([]movieobject.MovieID, 0, len(candidates))
+		for _, candidate := range candidates {
+			movieIDs = append(movieIDs, candidate.MovieID)
+		}
+		movies, err := r.moviesRepo.GetByIDs(ctx, movieIDs)
+		if err != nil {
+			slog.Error("RecommendationService.Recommend failed to get movies", "error", err)
+			return nil, err
+		}
+		stats.Apply(movies...)
+
+		moviesByID := make(map[movieobject.MovieID]*moviedomain.Movie, len(movies))
+		for _, movie := range movies {
+			moviesByID[movie.ID()] = movie
+		}
+		recommendations := make([]*recommendationdomain.Recommendation, 0, len(candidates))
+		for _, candidate := range candidates {
+			movie, ok := moviesByID[candidate.MovieID]
+			if !ok {
+				continue
+			}
+			recommendations = append(recommendations, &recommendationdomain.Recommendation{
+				Movie:  movie,
+				Rank:   len(recommendations) + 1,
+				Score:  candidate.Score,
+				Source: candidate.Source,
+				Reason: candidate.Reason,
+			})
+		}
+		slog.Debug("RecommendationService.Recommend recommendations successfully found", "userID", userID.ID(), "count", len(recommendations))
+		return recommendations, nil
+	})
+}
+
+func (r *RecommendationService) RebuildModel(ctx context.Context) (int, error) {
+	pairs := 0
+	err := r.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		pairs, err = r.recommendationRepo.RebuildSimilarities(ctx, r.params)
+		if err != nil {
+			slog.Error("RecommendationService.RebuildModel failed to rebuild similarities", "error", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	slog.Debug("RecommendationService.RebuildModel model rebuilt", "pairs", pairs)
+	return pairs, nil
+}
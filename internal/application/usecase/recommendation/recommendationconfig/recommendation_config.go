@@ -0,0 +1,10 @@
+package recommendationconfig
+
+import "time"
+
+type RecommendationConfig struct {
+	JobInterval time.Duration `yaml:"job_interval"`
+	Neighbors   int           `yaml:"neighbors"`
+	MinCoRaters int           `yaml:"min_co_raters"`
+	Shrinkage   float64       `yaml:"shrinkage"`
+}
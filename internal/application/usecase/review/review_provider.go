@@ -11,18 +11,21 @@ import (
 	"time"
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/review/modelconfig"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
 	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
 	reviewdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/review"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/ollama/ollama/api"
 )
 
 type ReviewProvider struct {
 	reviewService reviewdomain.Service
+	movieService  moviedomain.Service
 	client        *api.Client
 	config        modelconfig.ModelConfig
 }
 
-func NewReviewProvider(reviewService reviewdomain.Service, config modelconfig.ModelConfig) *ReviewProvider {
+func NewReviewProvider(reviewService reviewdomain.Service, movieService moviedomain.Service, config modelconfig.ModelConfig) *ReviewProvider {
 	baseURL, err := url.Parse(config.OllamaHost)
 	if err != nil {
 		slog.Error("Error while parsing the URL ", "Error", err)
@@ -31,11 +34,16 @@ func NewReviewProvider(reviewService reviewdomain.Service, config modelconfig.Mo
 
 	client := api.NewClient(baseURL, http.DefaultClient)
 
-	return &ReviewProvider{reviewService: reviewService, config: config, client: client}
+	return &ReviewProvider{reviewService: reviewService, movieService: movieService, config: config, client: client}
 }
 
-func (r *ReviewProvider) ProvideMovieReviews(ctx context.Context, movieInfo object2.MovieInfo) (string, error) {
-	reviews, err := r.reviewService.GetReviewsByMovie(ctx, movieInfo)
+func (r *ReviewProvider) ProvideMovieReviews(ctx context.Context, ref object2.MovieRef) (string, error) {
+	movie, err := r.movieService.FindByRef(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+
+	reviews, err := r.reviewService.GetReviews(ctx, titleobject.NewMovieTargetRef(object2.NewMovieRefByID(movie.ID())))
 
 	if err != nil {
 		return "", err
@@ -52,7 +60,7 @@ func (r *ReviewProvider) ProvideMovieReviews(ctx context.Context, movieInfo obje
 
 	reviewsText := strings.Join(texts, "\n")
 
-	finalUserPrompt := fmt.Sprintf(r.config.UserPrompt, movieInfo.Title, reviewsText)
+	finalUserPrompt := fmt.Sprintf(r.config.UserPrompt, movie.Title, reviewsText)
 
 	request := &api.ChatRequest{
 		Model: r.config.Name,
@@ -8,25 +8,35 @@ import (
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
 	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
-	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
 	reviewdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/review"
 	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/error"
+	object3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
+	seriesdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/series"
+	statsdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/stats"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/title"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type ReviewService struct {
 	movieRepo        moviedomain.Repository
+	seriesRepo       seriesdomain.Repository
 	reviewRepo       reviewdomain.Repository
 	txUser           transactionmanager.TransactionUser
 	reviewTxManager  transactionmanager.TransactionManager[*reviewdomain.Review]
 	reviewsTxManager transactionmanager.TransactionManager[[]*reviewdomain.ReviewInfo]
+	userRepo         userdomain.Repository
+	statsRepo        statsdomain.Repository
+	requireVerified  bool
 }
 
-func NewReviewService(movieRepo moviedomain.Repository, reviewRepo reviewdomain.Repository, txUser transactionmanager.TransactionUser, reviewTxManager transactionmanager.TransactionManager[*reviewdomain.Review], reviewsTxManager transactionmanager.TransactionManager[[]*reviewdomain.ReviewInfo]) *ReviewService {
-	return &ReviewService{movieRepo: movieRepo, reviewRepo: reviewRepo, txUser: txUser, reviewTxManager: reviewTxManager, reviewsTxManager: reviewsTxManager}
+func NewReviewService(movieRepo moviedomain.Repository, seriesRepo seriesdomain.Repository, reviewRepo reviewdomain.Repository, txUser transactionmanager.TransactionUser, reviewTxManager transactionmanager.TransactionManager[*reviewdomain.Review], reviewsTxManager transactionmanager.TransactionManager[[]*reviewdomain.ReviewInfo], userRepo userdomain.Repository, statsRepo statsdomain.Repository, requireVerified bool) *ReviewService {
+	return &ReviewService{movieRepo: movieRepo, seriesRepo: seriesRepo, reviewRepo: reviewRepo, txUser: txUser, reviewTxManager: reviewTxManager, reviewsTxManager: reviewsTxManager, userRepo: userRepo, statsRepo: statsRepo,
+		requireVerified: requireVerified}
 }
 
-func (r *ReviewService) SaveReview(ctx context.Context, userID object.UserID, movieInfo object2.MovieInfo, text string, writingDate time.Time) error {
+func (r *ReviewService) SaveReview(ctx context.Context, userID object.UserID, ref titleobject.TargetRef, text string, writingDate time.Time) error {
 	return r.txUser.UseTransaction(ctx, func(ctx context.Context) error {
 		err := reviewdomain.ValidateReviewText(text)
 		if err != nil {
@@ -34,19 +44,27 @@ func (r *ReviewService) SaveReview(ctx context.Context, userID object.UserID, mo
 			return err
 		}
 
-		movieID, err := r.movieRepo.GetIDByReleaseDateAndTitle(ctx, movieInfo.Title, movieInfo.Year, movieInfo.Month, movieInfo.Day)
+		if r.requireVerified {
+			err = userdomain.CheckEmailVerified(ctx, r.userRepo, userID)
+			if err != nil {
+				slog.Error("ReviewSrv.SaveReview Error email verification check failed", "error", err, "userID", userID.ID())
+				return err
+			}
+		}
+
+		target, err := title.ResolveTarget(ctx, r.movieRepo, r.seriesRepo, ref)
 		if err != nil {
-			slog.Error("ReviewSrv.SaveReview Error while getting movie", "error", err)
+			slog.Error("ReviewSrv.SaveReview Error while resolving target", "error", err)
 			return err
 		}
 
-		review, err := r.reviewRepo.GetReviewByUserAndMovie(ctx, userID, movieID)
+		review, err := r.reviewRepo.GetReviewByUserAndTarget(ctx, userID, target)
 		if err != nil && !errors.Is(err, error2.ErrReviewNotFound) {
 			slog.Error("ReviewSrv.SaveReview Error while getting review", "error", err)
 			return err
 		} else if errors.Is(err, error2.ErrReviewNotFound) {
-			slog.Debug("ReviewSrv.SaveReview Error", "error", err, "movieID", movieID.ID(), "userID", userID.ID())
-			review = reviewdomain.NewReview(userID, movieID)
+			slog.Debug("ReviewSrv.SaveReview Error", "error", err, "targetID", target.ID(), "userID", userID.ID())
+			review = reviewdomain.NewReview(userID, target)
 		}
 
 		err = review.SetText(text)
@@ -55,45 +73,60 @@ func (r *ReviewService) SaveReview(ctx context.Context, userID object.UserID, mo
 			return err
 		}
 
+		isNew := review.ID().IsEmpty()
 		review.SetWritingDate(writingDate)
 		err = r.reviewRepo.Save(ctx, review)
 		if err != nil {
 			slog.Error("ReviewSrv.SaveReview Error while saving review", "error", err)
 			return err
 		}
+
+		if isNew {
+			err = statsdomain.ApplyTargetDelta(ctx, r.statsRepo, target, statsdomain.MovieStatsDelta{ReviewCount: 1})
+			if err != nil {
+				slog.Error("ReviewSrv.SaveReview Error while updating stats", "error", err)
+				return err
+			}
+		}
 		return nil
 	})
 }
 
-func (r *ReviewService) DeleteReview(ctx context.Context, userID object.UserID, info object2.MovieInfo) error {
+func (r *ReviewService) DeleteReview(ctx context.Context, userID object.UserID, ref titleobject.TargetRef) error {
 	return r.txUser.UseTransaction(ctx, func(ctx context.Context) error {
-		movieID, err := r.movieRepo.GetIDByReleaseDateAndTitle(ctx, info.Title, info.Year, info.Month, info.Day)
+		target, err := title.ResolveTarget(ctx, r.movieRepo, r.seriesRepo, ref)
 		if err != nil {
-			slog.Error("ReviewSrv.DeleteReview Error while getting movie", "error", err)
+			slog.Error("ReviewSrv.DeleteReview Error while resolving target", "error", err)
 			return err
 		}
 
-		review := reviewdomain.NewReview(userID, movieID)
-		slog.Debug("ReviewSrv.DeleteReview", "movieID", movieID, "userID", userID.ID())
+		review := reviewdomain.NewReview(userID, target)
+		slog.Debug("ReviewSrv.DeleteReview", "targetID", target.ID(), "userID", userID.ID())
 		err = r.reviewRepo.Delete(ctx, review)
 		if err != nil {
 			slog.Error("ReviewSrv.DeleteReview Error while deleting review", "error", err)
 			return err
 		}
 
+		err = statsdomain.ApplyTargetDelta(ctx, r.statsRepo, target, statsdomain.MovieStatsDelta{ReviewCount: -1})
+		if err != nil {
+			slog.Error("ReviewSrv.DeleteReview Error while updating stats", "error", err)
+			return err
+		}
+
 		return nil
 	})
 }
 
-func (r *ReviewService) GetUserReview(ctx context.Context, userID object.UserID, info object2.MovieInfo) (*reviewdomain.Review, error) {
+func (r *ReviewService) GetUserReview(ctx context.Context, userID object.UserID, ref titleobject.TargetRef) (*reviewdomain.Review, error) {
 	return r.reviewTxManager.InTransaction(ctx, func(ctx context.Context) (*reviewdomain.Review, error) {
-		movieID, err := r.movieRepo.GetIDByReleaseDateAndTitle(ctx, info.Title, info.Year, info.Month, info.Day)
+		target, err := title.ResolveTarget(ctx, r.movieRepo, r.seriesRepo, ref)
 		if err != nil {
-			slog.Error("ReviewSrv.GetUserReview Error while getting movie", "error", err)
+			slog.Error("ReviewSrv.GetUserReview Error while resolving target", "error", err)
 			return nil, err
 		}
 
-		review, err := r.reviewRepo.GetReviewByUserAndMovie(ctx, userID, movieID)
+		review, err := r.reviewRepo.GetReviewByUserAndTarget(ctx, userID, target)
 		if err != nil {
 			slog.Error("ReviewSrv.GetUserReview Error while getting review", "error", err)
 			return nil, err
@@ -103,15 +136,15 @@ func (r *ReviewService) GetUserReview(ctx context.Context, userID object.UserID,
 	})
 }
 
-func (r *ReviewService) GetReviewsByMovie(ctx context.Context, info object2.MovieInfo) ([]*reviewdomain.ReviewInfo, error) {
+func (r *ReviewService) GetReviews(ctx context.Context, ref titleobject.TargetRef) ([]*reviewdomain.ReviewInfo, error) {
 	return r.reviewsTxManager.InTransaction(ctx, func(ctx context.Context) ([]*reviewdomain.ReviewInfo, error) {
-		movieID, err := r.movieRepo.GetIDByReleaseDateAndTitle(ctx, info.Title, info.Year, info.Month, info.Day)
+		target, err := title.ResolveTarget(ctx, r.movieRepo, r.seriesRepo, ref)
 		if err != nil {
-			slog.Error("ReviewSrv.GetUserReviews Error while getting movie", "error", err)
+			slog.Error("ReviewSrv.GetUserReviews Error while resolving target", "error", err)
 			return nil, err
 		}
 
-		reviews, err := r.reviewRepo.GetReviewsByMovie(ctx, movieID)
+		reviews, err := r.reviewRepo.GetReviewsByTarget(ctx, target)
 		if err != nil {
 			slog.Error("ReviewSrv.GetUserReviews Error while getting reviews", "error", err)
 			return nil, err
@@ -121,15 +154,15 @@ func (r *ReviewService) GetReviewsByMovie(ctx context.Context, info object2.Movi
 	})
 }
 
-func (r *ReviewService) GetReviewsByMovieForUser(ctx context.Context, info object2.MovieInfo, userID object.UserID) ([]*reviewdomain.ReviewInfo, error) {
+func (r *ReviewService) GetReviewsForUser(ctx context.Context, ref titleobject.TargetRef, userID object.UserID) ([]*reviewdomain.ReviewInfo, error) {
 	return r.reviewsTxManager.InTransaction(ctx, func(ctx context.Context) ([]*reviewdomain.ReviewInfo, error) {
-		movieID, err := r.movieRepo.GetIDByReleaseDateAndTitle(ctx, info.Title, info.Year, info.Month, info.Day)
+		target, err := title.ResolveTarget(ctx, r.movieRepo, r.seriesRepo, ref)
 		if err != nil {
-			slog.Error("ReviewSrv.GetUserReviews Error while getting movie", "error", err)
+			slog.Error("ReviewSrv.GetUserReviews Error while resolving target", "error", err)
 			return nil, err
 		}
 
-		reviews, err := r.reviewRepo.GetReviewByMovieForUser(ctx, movieID, userID)
+		reviews, err := r.reviewRepo.GetReviewsByTargetForUser(ctx, target, userID)
 		if err != nil {
 			slog.Error("ReviewSrv.GetUserReviews Error while getting reviews", "error", err)
 			return nil, err
@@ -138,3 +171,33 @@ func (r *ReviewService) GetReviewsByMovieForUser(ctx context.Context, info objec
 		return reviews, nil
 	})
 }
+
+func (r *ReviewService) DeleteReviewByID(ctx context.Context, actorID object.UserID, reviewID object3.ReviewID) error {
+	return r.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		err := userdomain.CheckPermission(ctx, r.userRepo, actorID, object.PermissionManageReviews)
+		if err != nil {
+			slog.Error("ReviewSrv.DeleteReviewByID permission check failed", "error", err, "actorID", actorID.ID())
+			return err
+		}
+
+		review, err := r.reviewRepo.GetReviewByID(ctx, reviewID)
+		if err != nil {
+			slog.Error("ReviewSrv.DeleteReviewByID Error while getting review", "error", err)
+			return err
+		}
+
+		err = r.reviewRepo.Delete(ctx, review)
+		if err != nil {
+			slog.Error("ReviewSrv.DeleteReviewByID Error while deleting review", "error", err)
+			return err
+		}
+
+		err = statsdomain.ApplyTargetDelta(ctx, r.statsRepo, review.Target(), statsdomain.MovieStatsDelta{ReviewCount: -1})
+		if err != nil {
+			slog.Error("ReviewSrv.DeleteReviewByID Error while updating stats", "error", err)
+			return err
+		}
+		slog.Info("ReviewSrv.DeleteReviewByID review removed by moderator", "reviewID", reviewID.ID(), "actorID", actorID.ID())
+		return nil
+	})
+}
@@ -9,6 +9,8 @@ import (
 	object3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
 	reviewlikedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/reviewlike"
 	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/reviewlike/error"
+	statsdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/stats"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
@@ -16,14 +18,26 @@ type ReviewLikeService struct {
 	reviewRepository     reviewdomain.Repository
 	reviewLikeRepository reviewlikedomain.Repository
 	txUser               transactionmanager.TransactionUser
+	statsRepo            statsdomain.Repository
+	userRepo             userdomain.Repository
+	requireVerified      bool
 }
 
-func NewReviewLikeService(reviewRepository reviewdomain.Repository, reviewLikeRepository reviewlikedomain.Repository, txUser transactionmanager.TransactionUser) *ReviewLikeService {
-	return &ReviewLikeService{reviewRepository: reviewRepository, reviewLikeRepository: reviewLikeRepository, txUser: txUser}
+func NewReviewLikeService(reviewRepository reviewdomain.Repository, reviewLikeRepository reviewlikedomain.Repository, txUser transactionmanager.TransactionUser, statsRepo statsdomain.Repository,
+	userRepo userdomain.Repository, requireVerified bool) *ReviewLikeService {
+	return &ReviewLikeService{reviewRepository: reviewRepository, reviewLikeRepository: reviewLikeRepository, txUser: txUser, statsRepo: statsRepo, userRepo: userRepo, requireVerified: requireVerified}
 }
 
 func (r *ReviewLikeService) LikeReview(ctx context.Context, userID object.UserID, reviewID object3.ReviewID) error {
 	return r.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		if r.requireVerified {
+			err := userdomain.CheckEmailVerified(ctx, r.userRepo, userID)
+			if err != nil {
+				slog.Error("ReviewLikeService.LikeReview email verification check failed", "error", err, "userID", userID)
+				return err
+			}
+		}
+
 		_, err := r.reviewRepository.GetReviewByID(ctx, reviewID)
 		if err != nil {
 			slog.Error("ReviewLikeService.LikeReview Get review error", "error", err)
@@ -47,6 +61,12 @@ func (r *ReviewLikeService) LikeReview(ctx context.Context, userID object.UserID
 			return err
 		}
 
+		err = r.statsRepo.ApplyReviewDelta(ctx, reviewID, 1)
+		if err != nil {
+			slog.Error("ReviewLikeService.LikeReview stats error", "error", err)
+			return err
+		}
+
 		return nil
 	})
 }
@@ -76,6 +96,12 @@ func (r *ReviewLikeService) UnLikeReview(ctx context.Context, userID object.User
 			return err
 		}
 
+		err = r.statsRepo.ApplyReviewDelta(ctx, reviewID, -1)
+		if err != nil {
+			slog.Error("ReviewLikeService.UnLikeReview stats error", "error", err)
+			return err
+		}
+
 		return nil
 	})
 }
@@ -0,0 +1,342 @@
+package series
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	seriesdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/series"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type SeriesService struct {
+	seriesRepo       seriesdomain.Repository
+	userRepo         userdomain.Repository
+	seriesTxManager  transactionmanager.TransactionManager[*seriesdomain.Series]
+	pageTxManager    transactionmanager.TransactionManager[*seriesdomain.SeriesPage]
+	seasonsTxManager transactionmanager.TransactionManager[[]*seriesdomain.Season]
+	seasonTxManager  transactionmanager.TransactionManager[*seriesdomain.Season]
+	episodeTxManager transactionmanager.TransactionManager[*seriesdomain.Episode]
+	txUser           transactionmanager.TransactionUser
+}
+
+func NewSeriesService(seriesRepo seriesdomain.Repository, userRepo userdomain.Repository, seriesTxManager transactionmanager.TransactionManager[*seriesdomain.Series],
+	pageTxManager transactionmanager.TransactionManager[*seriesdomain.SeriesPage], seasonsTxManager transactionmanager.TransactionManager[[]*seriesdomain.Season],
+	seasonTxManager transactionmanager.TransactionManager[*seriesdomain.Season], episodeTxManager transactionmanager.TransactionManager[*seriesdomain.Episode],
+	txUser transactionmanager.TransactionUser) *SeriesService {
+	return &SeriesService{seriesRepo: seriesRepo, userRepo: userRepo, seriesTxManager: seriesTxManager, pageTxManager: pageTxManager, seasonsTxManager: seasonsTxManager,
+		seasonTxManager: seasonTxManager, episodeTxManager: episodeTxManager, txUser: txUser}
+}
+
+func (s *SeriesService) List(ctx context.Context, limit int, offset int) (*seriesdomain.SeriesPage, error) {
+	return s.pageTxManager.InTransaction(ctx, func(ctx context.Context) (*seriesdomain.SeriesPage, error) {
+		seriesList, err := s.seriesRepo.List(ctx, limit, offset)
+		if err != nil {
+			slog.Error("SeriesService.List failed to get series", "error", err)
+			return nil, err
+		}
+
+		total, err := s.seriesRepo.Count(ctx)
+		if err != nil {
+			slog.Error("SeriesService.List failed to count series", "error", err)
+			return nil, err
+		}
+		slog.Debug("SeriesService.List series successfully found", "count", len(seriesList))
+		return &seriesdomain.SeriesPage{Series: seriesList, Total: total}, nil
+	})
+}
+
+func (s *SeriesService) FindByRef(ctx context.Context, ref object.SeriesRef) (*seriesdomain.Series, error) {
+	return s.seriesTxManager.InTransaction(ctx, func(ctx context.Context) (*seriesdomain.Series, error) {
+		series, err := seriesdomain.FindByRef(ctx, s.seriesRepo, ref)
+		if err != nil {
+			slog.Error("SeriesService.FindByRef failed to get series", "error", err)
+			return nil, err
+		}
+		return series, nil
+	})
+}
+
+func (s *SeriesService) FindSeasons(ctx context.Context, ref object.SeriesRef) ([]*seriesdomain.Season, error) {
+	return s.seasonsTxManager.InTransaction(ctx, func(ctx context.Context) ([]*seriesdomain.Season, error) {
+		seriesID, err := seriesdomain.FindIDByRef(ctx, s.seriesRepo, ref)
+		if err != nil {
+			slog.Error("SeriesService.FindSeasons failed to get series", "error", err)
+			return nil, err
+		}
+
+		seasons, err := s.seriesRepo.GetSeasons(ctx, seriesID)
+		if err != nil {
+			slog.Error("SeriesService.FindSeasons failed to get seasons", "error", err)
+			return nil, err
+		}
+		return seasons, nil
+	})
+}
+
+func (s *SeriesService) FindSeason(ctx context.Context, ref object.SeriesRef, number int) (*seriesdomain.Season, error) {
+	return s.seasonTxManager.InTransaction(ctx, func(ctx context.Context) (*seriesdomain.Season, error) {
+		seriesID, err := seriesdomain.FindIDByRef(ctx, s.seriesRepo, ref)
+		if err != nil {
+			slog.Error("SeriesService.FindSeason failed to get series", "error", err)
+			return nil, err
+		}
+
+		season, err := s.seriesRepo.GetSeason(ctx, seriesID, number)
+		if err != nil {
+			slog.Error("SeriesService.FindSeason failed to get season", "error", err)
+			return nil, err
+		}
+
+		season.Episodes, err = s.seriesRepo.GetEpisodes(ctx, season.ID())
+		if err != nil {
+			slog.Error("SeriesService.FindSeason failed to get episodes", "error", err)
+			return nil, err
+		}
+		return season, nil
+	})
+}
+
+func (s *SeriesService) FindEpisode(ctx context.Context, ref object.SeriesRef, code object.EpisodeCode) (*seriesdomain.Episode, error) {
+	return s.episodeTxManager.InTransaction(ctx, func(ctx context.Context) (*seriesdomain.Episode, error) {
+		seriesID, err := seriesdomain.FindIDByRef(ctx, s.seriesRepo, ref)
+		if err != nil {
+			slog.Error("SeriesService.FindEpisode failed to get series", "error", err)
+			return nil, err
+		}
+
+		episode, err := s.seriesRepo.GetEpisode(ctx, seriesID, code)
+		if err != nil {
+			slog.Error("SeriesService.FindEpisode failed to get episode", "error", err, "code", code.String())
+			return nil, err
+		}
+		return episode, nil
+	})
+}
+
+func (s *SeriesService) CreateSeries(ctx context.Context, actorID userobject.UserID, series *seriesdomain.Series) (*seriesdomain.Series, error) {
+	err := seriesdomain.ValidateSeries(series)
+	if err != nil {
+		slog.Error("SeriesService.CreateSeries validation failed", "error", err)
+		return nil, err
+	}
+	return s.seriesTxManager.InTransaction(ctx, func(ctx context.Context) (*seriesdomain.Series, error) {
+		err := userdomain.CheckPermission(ctx, s.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("SeriesService.CreateSeries permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		slug, err := seriesdomain.GenerateUniqueSlug(ctx, s.seriesRepo, series)
+		if err != nil {
+			slog.Error("SeriesService.CreateSeries failed to generate slug", "error", err)
+			return nil, err
+		}
+		_ = series.SetSlug(slug)
+
+		err = s.seriesRepo.Save(ctx, series)
+		if err != nil {
+			slog.Error("SeriesService.CreateSeries failed to save series", "error", err)
+			return nil, err
+		}
+		slog.Debug("SeriesService.CreateSeries series successfully created", "seriesID", series.ID().ID())
+		return series, nil
+	})
+}
+
+func (s *SeriesService) UpdateSeries(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef, series *seriesdomain.Series) (*seriesdomain.Series, error) {
+	err := seriesdomain.ValidateSeries(series)
+	if err != nil {
+		slog.Error("SeriesService.UpdateSeries validation failed", "error", err)
+		return nil, err
+	}
+	return s.seriesTxManager.InTransaction(ctx, func(ctx context.Context) (*seriesdomain.Series, error) {
+		err := userdomain.CheckPermission(ctx, s.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("SeriesService.UpdateSeries permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		existing, err := seriesdomain.FindByRef(ctx, s.seriesRepo, ref)
+		if err != nil {
+			slog.Error("SeriesService.UpdateSeries failed to get series", "error", err)
+			return nil, err
+		}
+
+		err = series.SetID(existing.ID())
+		if err != nil {
+			slog.Error("SeriesService.UpdateSeries failed to set series id", "error", err)
+			return nil, err
+		}
+		_ = series.SetSlug(existing.Slug())
+		series.Rating = existing.Rating
+		series.RatingCount = existing.RatingCount
+		series.SeasonCount = existing.SeasonCount
+		series.EpisodeCount = existing.EpisodeCount
+
+		err = s.seriesRepo.Update(ctx, series)
+		if err != nil {
+			slog.Error("SeriesService.UpdateSeries failed to update series", "error", err)
+			return nil, err
+		}
+		slog.Debug("SeriesService.UpdateSeries series successfully updated", "seriesID", series.ID().ID())
+		return series, nil
+	})
+}
+
+func (s *SeriesService) DeleteSeries(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef) error {
+	return s.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		err := userdomain.CheckPermission(ctx, s.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("SeriesService.DeleteSeries permission check failed", "error", err, "actorID", actorID.ID())
+			return err
+		}
+
+		seriesID, err := seriesdomain.FindIDByRef(ctx, s.seriesRepo, ref)
+		if err != nil {
+			slog.Error("SeriesService.DeleteSeries failed to get series", "error", err)
+			return err
+		}
+
+		err = s.seriesRepo.Delete(ctx, seriesID)
+		if err != nil {
+			slog.Error("SeriesService.DeleteSeries failed to delete series", "error", err)
+			return err
+		}
+		slog.Info("SeriesService.DeleteSeries series removed", "seriesID", seriesID.ID(), "actorID", actorID.ID())
+		return nil
+	})
+}
+
+func (s *SeriesService) SaveSeason(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef, season *seriesdomain.Season) (*seriesdomain.Season, error) {
+	err := seriesdomain.ValidateSeason(season)
+	if err != nil {
+		slog.Error("SeriesService.SaveSeason validation failed", "error", err)
+		return nil, err
+	}
+	return s.seasonTxManager.InTransaction(ctx, func(ctx context.Context) (*seriesdomain.Season, error) {
+		err := userdomain.CheckPermission(ctx, s.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("SeriesService.SaveSeason permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		season.SeriesID, err = seriesdomain.FindIDByRef(ctx, s.seriesRepo, ref)
+		if err != nil {
+			slog.Error("SeriesService.SaveSeason failed to get series", "error", err)
+			return nil, err
+		}
+
+		err = s.seriesRepo.SaveSeason(ctx, season)
+		if err != nil {
+			slog.Error("SeriesService.SaveSeason failed to save season", "error", err)
+			return nil, err
+		}
+
+		saved, err := s.seriesRepo.GetSeason(ctx, season.SeriesID, season.Number)
+		if err != nil {
+			slog.Error("SeriesService.SaveSeason failed to get season", "error", err)
+			return nil, err
+		}
+
+		saved.Episodes, err = s.seriesRepo.GetEpisodes(ctx, saved.ID())
+		if err != nil {
+			slog.Error("SeriesService.SaveSeason failed to get episodes", "error", err)
+			return nil, err
+		}
+		slog.Debug("SeriesService.SaveSeason season successfully saved", "seasonID", saved.ID().ID())
+		return saved, nil
+	})
+}
+
+func (s *SeriesService) DeleteSeason(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef, number int) error {
+	return s.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		err := userdomain.CheckPermission(ctx, s.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("SeriesService.DeleteSeason permission check failed", "error", err, "actorID", actorID.ID())
+			return err
+		}
+
+		seriesID, err := seriesdomain.FindIDByRef(ctx, s.seriesRepo, ref)
+		if err != nil {
+			slog.Error("SeriesService.DeleteSeason failed to get series", "error", err)
+			return err
+		}
+
+		err = s.seriesRepo.DeleteSeason(ctx, seriesID, number)
+		if err != nil {
+			slog.Error("SeriesService.DeleteSeason failed to delete season", "error", err)
+			return err
+		}
+		slog.Info("SeriesService.DeleteSeason season removed", "seriesID", seriesID.ID(), "season", number, "actorID", actorID.ID())
+		return nil
+	})
+}
+
+func (s *SeriesService) SaveEpisode(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef, seasonNumber int, episode *seriesdomain.Episode) (*seriesdomain.Episode, error) {
+	err := seriesdomain.ValidateEpisode(episode)
+	if err != nil {
+		slog.Error("SeriesService.SaveEpisode validation failed", "error", err)
+		return nil, err
+	}
+	return s.episodeTxManager.InTransaction(ctx, func(ctx context.Context) (*seriesdomain.Episode, error) {
+		err := userdomain.CheckPermission(ctx, s.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("SeriesService.SaveEpisode permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		seriesID, err := seriesdomain.FindIDByRef(ctx, s.seriesRepo, ref)
+		if err != nil {
+			slog.Error("SeriesService.SaveEpisode failed to get series", "error", err)
+			return nil, err
+		}
+
+		season, err := s.seriesRepo.GetSeason(ctx, seriesID, seasonNumber)
+		if err != nil {
+			slog.Error("SeriesService.SaveEpisode failed to get season", "error", err)
+			return nil, err
+		}
+		episode.AttachTo(season)
+
+		err = s.seriesRepo.SaveEpisode(ctx, episode)
+		if err != nil {
+			slog.Error("SeriesService.SaveEpisode failed to save episode", "error", err)
+			return nil, err
+		}
+
+		saved, err := s.seriesRepo.GetEpisode(ctx, seriesID, episode.Code())
+		if err != nil {
+			slog.Error("SeriesService.SaveEpisode failed to get episode", "error", err)
+			return nil, err
+		}
+		slog.Debug("SeriesService.SaveEpisode episode successfully saved", "episodeID", saved.ID().ID(), "code", saved.Code().String())
+		return saved, nil
+	})
+}
+
+func (s *SeriesService) DeleteEpisode(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef, code object.EpisodeCode) error {
+	return s.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		err := userdomain.CheckPermission(ctx, s.userRepo, actorID, userobject.PermissionManageCatalog)
+		if err != nil {
+			slog.Error("SeriesService.DeleteEpisode permission check failed", "error", err, "actorID", actorID.ID())
+			return err
+		}
+
+		seriesID, err := seriesdomain.FindIDByRef(ctx, s.seriesRepo, ref)
+		if err != nil {
+			slog.Error("SeriesService.DeleteEpisode failed to get series", "error", err)
+			return err
+		}
+
+		err = s.seriesRepo.DeleteEpisode(ctx, seriesID, code)
+		if err != nil {
+			slog.Error("SeriesService.DeleteEpisode failed to delete episode", "error", err)
+			return err
+		}
+		slog.Info("SeriesService.DeleteEpisode episode removed", "seriesID", seriesID.ID(), "code", code.String(), "actorID", actorID.ID())
+		return nil
+	})
+}
@@ -0,0 +1,32 @@
+package session
+
+import (
+	"sync"
+	"time"
+)
+
+type ActivityTracker struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+func NewActivityTracker() *ActivityTracker {
+	return &ActivityTracker{seen: make(map[string]time.Time)}
+}
+
+func (a *ActivityTracker) Touch(sessionID string, at time.Time) {
+	if sessionID == "" {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.seen[sessionID] = later(a.seen[sessionID], at)
+}
+
+func (a *ActivityTracker) Drain() map[string]time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	seen := a.seen
+	a.seen = make(map[string]time.Time)
+	return seen
+}
@@ -0,0 +1,106 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sessiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/session"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/session/object"
+)
+
+type RevocationCache struct {
+	mu          sync.RWMutex
+	tokens      map[string]time.Time
+	sessions    map[string]time.Time
+	sessionRepo sessiondomain.Repository
+	accessTTL   time.Duration
+}
+
+func NewRevocationCache(sessionRepo sessiondomain.Repository, accessTTL time.Duration) *RevocationCache {
+	if accessTTL <= 0 {
+		accessTTL = sessiondomain.DefaultAccessTokenTTL
+	}
+	return &RevocationCache{tokens: make(map[string]time.Time), sessions: make(map[string]time.Time), sessionRepo: sessionRepo, accessTTL: accessTTL}
+}
+
+func (r *RevocationCache) IsRevoked(ctx context.Context, tokenID string, sessionID string) (bool, error) {
+	if r.isCached(tokenID, sessionID) {
+		return true, nil
+	}
+
+	familyID := ""
+	if id, err := object.NewSessionID(sessionID); err == nil {
+		familyID = id.ID()
+	}
+	revoked, err := r.sessionRepo.IsRevoked(ctx, tokenID, familyID)
+	if err != nil {
+		return false, err
+	}
+	if revoked {
+		until := time.Now().Add(r.accessTTL)
+		if tokenID != "" {
+			r.RevokeToken(tokenID, until)
+		}
+		if familyID != "" {
+			r.RevokeSession(familyID, until)
+		}
+	}
+	return revoked, nil
+}
+
+func (r *RevocationCache) isCached(tokenID string, sessionID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	now := time.Now()
+	if until, ok := r.tokens[tokenID]; ok && tokenID != "" && now.Before(until) {
+		return true
+	}
+	if until, ok := r.sessions[sessionID]; ok && sessionID != "" && now.Before(until) {
+		return true
+	}
+	return false
+}
+
+func (r *RevocationCache) RevokeToken(tokenID string, until time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens[tokenID] = later(r.tokens[tokenID], until)
+}
+
+func (r *RevocationCache) RevokeSession(sessionID string, until time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[sessionID] = later(r.sessions[sessionID], until)
+}
+
+func (r *RevocationCache) Sync(revocations *sessiondomain.Revocations) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	r.tokens = merge(r.tokens, revocations.Tokens, now)
+	r.sessions = merge(r.sessions, revocations.Sessions, now)
+	return len(r.tokens) + len(r.sessions)
+}
+
+func merge(current map[string]time.Time, loaded map[string]time.Time, now time.Time) map[string]time.Time {
+	merged := make(map[string]time.Time, len(loaded))
+	for id, until := range loaded {
+		if now.Before(until) {
+			merged[id] = until
+		}
+	}
+	for id, until := range current {
+		if now.Before(until) {
+			merged[id] = later(merged[id], until)
+		}
+	}
+	return merged
+}
+
+func later(a time.Time, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,259 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	sessiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/session"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/session/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/session/object"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type SessionService struct {
+	sessionRepo       sessiondomain.Repository
+	userRepo          userdomain.Repository
+	tokenService      userdomain.TokenService
+	revocations       sessiondomain.RevocationList
+	activity          sessiondomain.ActivityTracker
+	authTxManager     transactionmanager.TransactionManager[*userobject.AuthResponse]
+	sessionsTxManager transactionmanager.TransactionManager[[]*sessiondomain.Session]
+	txUser            transactionmanager.TransactionUser
+	accessTTL         time.Duration
+	refreshTTL        time.Duration
+}
+
+func NewSessionService(sessionRepo sessiondomain.Repository, userRepo userdomain.Repository, tokenService userdomain.TokenService, revocations sessiondomain.RevocationList,
+	activity sessiondomain.ActivityTracker, authTxManager transactionmanager.TransactionManager[*userobject.AuthResponse],
+	sessionsTxManager transactionmanager.TransactionManager[[]*sessiondomain.Session], txUser transactionmanager.TransactionUser, accessTTL time.Duration, refreshTTL time.Duration) *SessionService {
+	if accessTTL <= 0 {
+		accessTTL = sessiondomain.DefaultAccessTokenTTL
+	}
+	if refreshTTL <= 0 {
+		refreshTTL = sessiondomain.DefaultRefreshTokenTTL
+	}
+	return &SessionService{sessionRepo: sessionRepo, userRepo: userRepo, tokenService: tokenService, revocations: revocations, activity: activity,
+		authTxManager: authTxManager, sessionsTxManager: sessionsTxManager, txUser: txUser, accessTTL: accessTTL, refreshTTL: refreshTTL}
+}
+
+func (s *SessionService) Start(ctx context.Context, user *userdomain.User, client object.ClientInfo) (*userobject.AuthResponse, error) {
+	return s.authTxManager.InTransaction(ctx, func(ctx context.Context) (*userobject.AuthResponse, error) {
+		authResp, err := s.issue(ctx, user, object.GenerateSessionID(), time.Time{}, client)
+		if err != nil {
+			slog.Error("SessionService.Start failed to issue tokens", "error", err, "userID", user.ID().ID())
+			return nil, err
+		}
+		slog.Debug("SessionService.Start session started", "userID", user.ID().ID())
+		return authResp, nil
+	})
+}
+
+func (s *SessionService) Refresh(ctx context.Context, token object.RefreshToken, client object.ClientInfo) (*userobject.AuthResponse, error) {
+	var reused *sessiondomain.Session
+	authResp, err := s.authTxManager.InTransaction(ctx, func(ctx context.Context) (*userobject.AuthResponse, error) {
+		session, err := s.sessionRepo.GetByTokenHash(ctx, token.Hash())
+		if err != nil {
+			slog.Error("SessionService.Refresh failed to find session", "error", err)
+			if errors.Is(err, error2.ErrSessionIsNotFound) {
+				return nil, error2.ErrRefreshTokenIsNotValid
+			}
+			return nil, err
+		}
+		if session.IsRevoked() {
+			slog.Error("SessionService.Refresh session is revoked", "sessionID", session.FamilyID.ID())
+			return nil, error2.ErrRefreshTokenIsNotValid
+		}
+		if session.IsExpired(time.Now()) {
+			slog.Error("SessionService.Refresh refresh token is expired", "sessionID", session.FamilyID.ID())
+			return nil, error2.ErrRefreshTokenIsNotValid
+		}
+
+		rotated := false
+		if !session.IsRotated() {
+			rotated, err = s.sessionRepo.MarkRotated(ctx, session.ID)
+			if err != nil {
+				slog.Error("SessionService.Refresh failed to rotate refresh token", "error", err)
+				return nil, err
+			}
+		}
+		if !rotated {
+			err = s.sessionRepo.RevokeFamily(ctx, session.FamilyID)
+			if err != nil {
+				slog.Error("SessionService.Refresh failed to revoke token family", "error", err)
+				return nil, err
+			}
+			reused = session
+			return nil, nil
+		}
+
+		user, err := s.userRepo.GetByUserID(ctx, session.UserID)
+		if err != nil {
+			slog.Error("SessionService.Refresh failed to find user", "error", err, "userID", session.UserID.ID())
+			return nil, err
+		}
+		return s.issue(ctx, user, session.FamilyID, session.StartedAt, client)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reused != nil {
+		s.revocations.RevokeSession(reused.FamilyID.ID(), time.Now().Add(s.accessTTL))
+		slog.Warn("SessionService.Refresh refresh token reuse detected, token family revoked", "sessionID", reused.FamilyID.ID(), "userID", reused.UserID.ID())
+		return nil, error2.ErrRefreshTokenReused
+	}
+	slog.Debug("SessionService.Refresh tokens rotated", "username", authResp.Username)
+	return authResp, nil
+}
+
+func (s *SessionService) Logout(ctx context.Context, claims userobject.TokenClaims) error {
+	sessionID, sessionErr := object.NewSessionID(claims.SessionID())
+	err := s.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		if sessionErr == nil {
+			err := s.sessionRepo.RevokeFamily(ctx, sessionID)
+			if err != nil {
+				slog.Error("SessionService.Logout failed to revoke session", "error", err, "sessionID", sessionID.ID())
+				return err
+			}
+		}
+		if claims.TokenID() != "" {
+			err := s.sessionRepo.RevokeToken(ctx, claims.TokenID(), claims.ExpiresAt())
+			if err != nil {
+				slog.Error("SessionService.Logout failed to revoke access token", "error", err)
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if sessionErr == nil {
+		s.revocations.RevokeSession(sessionID.ID(), time.Now().Add(s.accessTTL))
+	}
+	if claims.TokenID() != "" {
+		s.revocations.RevokeToken(claims.TokenID(), claims.ExpiresAt())
+	}
+	slog.Info("SessionService.Logout user logged out", "userID", claims.UserID().ID(), "sessionID", claims.SessionID())
+	return nil
+}
+
+func (s *SessionService) ListSessions(ctx context.Context, userID userobject.UserID) ([]*sessiondomain.Session, error) {
+	return s.sessionsTxManager.InTransaction(ctx, func(ctx context.Context) ([]*sessiondomain.Session, error) {
+		sessions, err := s.sessionRepo.GetActiveByUserID(ctx, userID)
+		if err != nil {
+			slog.Error("SessionService.ListSessions failed to get sessions", "error", err, "userID", userID.ID())
+			return nil, err
+		}
+		slog.Debug("SessionService.ListSessions sessions successfully found", "userID", userID.ID(), "count", len(sessions))
+		return sessions, nil
+	})
+}
+
+func (s *SessionService) RevokeSession(ctx context.Context, userID userobject.UserID, sessionID object.SessionID) error {
+	err := s.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		revoked, err := s.sessionRepo.RevokeUserFamily(ctx, userID, sessionID)
+		if err != nil {
+			slog.Error("SessionService.RevokeSession failed to revoke session", "error", err, "sessionID", sessionID.ID())
+			return err
+		}
+		if !revoked {
+			slog.Error("SessionService.RevokeSession session not found", "sessionID", sessionID.ID(), "userID", userID.ID())
+			return error2.ErrSessionIsNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.revocations.RevokeSession(sessionID.ID(), time.Now().Add(s.accessTTL))
+	slog.Info("SessionService.RevokeSession session revoked", "sessionID", sessionID.ID(), "userID", userID.ID())
+	return nil
+}
+
+func (s *SessionService) RevokeOtherSessions(ctx context.Context, claims userobject.TokenClaims) (int, error) {
+	current, err := object.NewSessionID(claims.SessionID())
+	if err != nil {
+		slog.Error("SessionService.RevokeOtherSessions token has no session", "error", err)
+		return 0, err
+	}
+
+	return s.revokeFamilies(ctx, claims.UserID(), current)
+}
+
+func (s *SessionService) RevokeAllSessions(ctx context.Context, userID userobject.UserID) (int, error) {
+	return s.revokeFamilies(ctx, userID, object.SessionID{})
+}
+
+func (s *SessionService) revokeFamilies(ctx context.Context, userID userobject.UserID, keep object.SessionID) (int, error) {
+	var revoked []object.SessionID
+	err := s.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		revoked, err = s.sessionRepo.RevokeOtherFamilies(ctx, userID, keep)
+		if err != nil {
+			slog.Error("SessionService.revokeFamilies failed to revoke sessions", "error", err, "userID", userID.ID())
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	until := time.Now().Add(s.accessTTL)
+	for _, sessionID := range revoked {
+		s.revocations.RevokeSession(sessionID.ID(), until)
+	}
+	slog.Info("SessionService.revokeFamilies sessions revoked", "userID", userID.ID(), "kept", keep.ID(), "count", len(revoked))
+	return len(revoked), nil
+}
+
+func (s *SessionService) SyncRevocations(ctx context.Context) (int, error) {
+	deleted, err := s.sessionRepo.DeleteExpired(ctx)
+	if err != nil {
+		slog.Error("SessionService.SyncRevocations failed to delete expired sessions", "error", err)
+		return 0, err
+	}
+	if deleted > 0 {
+		slog.Debug("SessionService.SyncRevocations expired sessions deleted", "count", deleted)
+	}
+
+	revocations, err := s.sessionRepo.GetRevocations(ctx, s.accessTTL)
+	if err != nil {
+		slog.Error("SessionService.SyncRevocations failed to load revocations", "error", err)
+		return 0, err
+	}
+	return s.revocations.Sync(revocations), nil
+}
+
+func (s *SessionService) FlushActivity(ctx context.Context) (int, error) {
+	seen := s.activity.Drain()
+	err := s.sessionRepo.TouchFamilies(ctx, seen)
+	if err != nil {
+		slog.Error("SessionService.FlushActivity failed to update last seen", "error", err, "count", len(seen))
+		return 0, err
+	}
+	return len(seen), nil
+}
+
+func (s *SessionService) issue(ctx context.Context, user *userdomain.User, familyID object.SessionID, startedAt time.Time, client object.ClientInfo) (*userobject.AuthResponse, error) {
+	refreshToken, err := object.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.sessionRepo.Save(ctx, sessiondomain.NewSession(familyID, user.ID(), refreshToken, s.refreshTTL, client, startedAt))
+	if err != nil {
+		return nil, err
+	}
+	accessToken, err := s.tokenService.GenerateToken(ctx, user, familyID.ID())
+	if err != nil {
+		return nil, err
+	}
+	return &userobject.AuthResponse{Username: user.Username(), Email: user.Email(), Role: user.Role(), Token: accessToken.Token, ExpiresAt: accessToken.ExpiresAt,
+		RefreshToken: refreshToken.Token()}, nil
+}
@@ -0,0 +1,9 @@
+package sessionconfig
+
+import "time"
+
+type SessionConfig struct {
+	AccessTokenTTL  time.Duration `yaml:"access_token_ttl"`
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl"`
+	SyncInterval    time.Duration `yaml:"sync_interval"`
+}
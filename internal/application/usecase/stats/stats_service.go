@@ -0,0 +1,30 @@
+package stats
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	statsdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/stats"
+)
+
+type StatsService struct {
+	statsRepo       statsdomain.Repository
+	reportTxManager transactionmanager.TransactionManager[*statsdomain.ReconcileReport]
+}
+
+func NewStatsService(statsRepo statsdomain.Repository, reportTxManager transactionmanager.TransactionManager[*statsdomain.ReconcileReport]) *StatsService {
+	return &StatsService{statsRepo: statsRepo, reportTxManager: reportTxManager}
+}
+
+func (s *StatsService) Reconcile(ctx context.Context) (*statsdomain.ReconcileReport, error) {
+	return s.reportTxManager.InTransaction(ctx, func(ctx context.Context) (*statsdomain.ReconcileReport, error) {
+		report, err := s.statsRepo.Reconcile(ctx)
+		if err != nil {
+			slog.Error("StatsService.Reconcile failed to rebuild stats", "error", err)
+			return nil, err
+		}
+		slog.Info("StatsService.Reconcile stats rebuilt", "movies", report.Movies, "reviews", report.Reviews)
+		return report, nil
+	})
+}
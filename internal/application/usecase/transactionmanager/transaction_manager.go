@@ -3,6 +3,7 @@ package transactionmanager
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 )
 
@@ -121,3 +122,26 @@ func GetTxFromCtx(ctx context.Context) (*sql.Tx, bool) {
 
 	return tx.(*sql.Tx), true
 }
+
+func WithSavepoint(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	tx, ok := GetTxFromCtx(ctx)
+	if !ok {
+		return fn(ctx)
+	}
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		slog.Error("Error creating savepoint: ", "error", err)
+		return err
+	}
+	if fnErr := fn(ctx); fnErr != nil {
+		if _, rollErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rollErr != nil {
+			slog.Error("Error rolling back to savepoint: ", "error", rollErr)
+			return errors.Join(fnErr, rollErr)
+		}
+		return fnErr
+	}
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		slog.Error("Error releasing savepoint: ", "error", err)
+		return err
+	}
+	return nil
+}
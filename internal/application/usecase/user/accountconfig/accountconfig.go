@@ -0,0 +1,14 @@
+package accountconfig
+
+import "time"
+
+type AccountConfig struct {
+	PasswordResetTTL           time.Duration `yaml:"password_reset_ttl"`
+	PasswordResetURL           string        `yaml:"password_reset_url"`
+	VerificationSecret         string        `yaml:"verification_secret"`
+	VerificationTTL            time.Duration `yaml:"verification_ttl"`
+	VerificationURL            string        `yaml:"verification_url"`
+	VerificationResendInterval time.Duration `yaml:"verification_resend_interval"`
+	RequireVerifiedToReview    bool          `yaml:"require_verified_to_review"`
+	RequireVerifiedToLike      bool          `yaml:"require_verified_to_like"`
+}
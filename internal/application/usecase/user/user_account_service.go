@@ -0,0 +1,255 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/user/hasher"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/user/validation"
+	maildomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+func (u *UserService) UpdateProfile(ctx context.Context, userID object.UserID, data object.ProfileUpdateData) (*userdomain.User, error) {
+	if data.IsEmpty() {
+		slog.Error("UserService.UpdateProfile nothing to update", "userID", userID.ID())
+		return nil, usererror.ErrProfileUpdateIsEmpty
+	}
+	return u.userTxManager.InTransaction(ctx, func(ctx context.Context) (*userdomain.User, error) {
+		user, err := u.userRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			slog.Error("UserService.UpdateProfile failed to find user", "error", err, "userID", userID.ID())
+			return nil, err
+		}
+
+		if data.Username() != "" {
+			if err = uservalidation.ValidateUsername(data.Username()); err != nil {
+				slog.Error("UserService.UpdateProfile username validation failed", "error", err)
+				return nil, err
+			}
+			user.SetUsername(data.Username())
+		}
+
+		previousEmail := user.Email()
+		if data.Email() != "" && data.Email() != previousEmail {
+			if err = uservalidation.ValidateEmail(data.Email()); err != nil {
+				slog.Error("UserService.UpdateProfile email validation failed", "error", err)
+				return nil, err
+			}
+			if !hasher.VerifyPassword(data.CurrentPassword(), user.Password()) {
+				slog.Error("UserService.UpdateProfile current password is invalid", "userID", userID.ID())
+				return nil, usererror.ErrInvalidPassword
+			}
+			exists, err := u.userRepo.ExistsByEmail(ctx, data.Email())
+			if err != nil {
+				slog.Error("UserService.UpdateProfile failed to check email", "error", err)
+				return nil, err
+			}
+			if exists {
+				slog.Error("UserService.UpdateProfile email already exists")
+				return nil, usererror.ErrUserEmailAlreadyExists
+			}
+			user.SetEmail(data.Email())
+		}
+
+		user, err = u.userRepo.Save(ctx, user)
+		if err != nil {
+			slog.Error("UserService.UpdateProfile failed to save user", "error", err)
+			return nil, err
+		}
+		if previousEmail != user.Email() {
+			err = u.notify(ctx, previousEmail, "Your email address was changed",
+				"The email address of your account was changed to "+user.Email()+".\nIf you did not do this, reset your password immediately.")
+			if err != nil {
+				return nil, err
+			}
+			err = u.sendVerification(ctx, user, 0)
+			if err != nil {
+				return nil, err
+			}
+		}
+		slog.Info("UserService.UpdateProfile profile updated", "userID", userID.ID())
+		return user, nil
+	})
+}
+
+func (u *UserService) ChangePassword(ctx context.Context, claims object.TokenClaims, data object.PasswordChangeData) error {
+	err := uservalidation.ValidatePassword(data.NewPassword())
+	if err != nil {
+		slog.Error("UserService.ChangePassword validation failed", "error", err)
+		return err
+	}
+
+	err = u.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		user, err := u.userRepo.GetByUserID(ctx, claims.UserID())
+		if err != nil {
+			slog.Error("UserService.ChangePassword failed to find user", "error", err, "userID", claims.UserID().ID())
+			return err
+		}
+		if !hasher.VerifyPassword(data.CurrentPassword(), user.Password()) {
+			slog.Error("UserService.ChangePassword current password is invalid", "userID", claims.UserID().ID())
+			return usererror.ErrInvalidPassword
+		}
+		err = u.setPassword(ctx, user, data.NewPassword())
+		if err != nil {
+			return err
+		}
+
+		if claims.SessionID() != "" {
+			_, err = u.sessionService.RevokeOtherSessions(ctx, claims)
+		} else {
+			_, err = u.sessionService.RevokeAllSessions(ctx, claims.UserID())
+		}
+		if err != nil {
+			slog.Error("UserService.ChangePassword failed to revoke sessions", "error", err, "userID", claims.UserID().ID())
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	slog.Info("UserService.ChangePassword password changed", "userID", claims.UserID().ID())
+	return nil
+}
+
+func (u *UserService) RequestPasswordReset(ctx context.Context, email string) error {
+	err := uservalidation.ValidateEmail(email)
+	if err != nil {
+		slog.Error("UserService.RequestPasswordReset validation failed", "error", err)
+		return err
+	}
+
+	return u.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		user, err := u.userRepo.GetByEmail(ctx, email)
+		if err != nil {
+			if errors.Is(err, usererror.ErrUserIsNotFound) {
+				slog.Debug("UserService.RequestPasswordReset unknown email")
+				return nil
+			}
+			slog.Error("UserService.RequestPasswordReset failed to find user", "error", err)
+			return err
+		}
+
+		token, err := object.GeneratePasswordResetToken()
+		if err != nil {
+			slog.Error("UserService.RequestPasswordReset failed to generate token", "error", err)
+			return err
+		}
+		_, err = u.userRepo.SavePasswordReset(ctx, userdomain.NewPasswordReset(user.ID(), token, u.passwordResetTTL))
+		if err != nil {
+			slog.Error("UserService.RequestPasswordReset failed to save token", "error", err)
+			return err
+		}
+
+		body := "To choose a new password, open the link below. It expires in " + u.passwordResetTTL.String() + ".\n\n" +
+			buildLink(u.passwordResetURL, token.Token()) + "\n\nIf you did not request a password reset, ignore this email."
+		err = u.notify(ctx, user.Email(), "Reset your password", body)
+		if err != nil {
+			return err
+		}
+		slog.Info("UserService.RequestPasswordReset reset requested", "userID", user.ID().ID())
+		return nil
+	})
+}
+
+func (u *UserService) ResetPassword(ctx context.Context, data object.PasswordResetData) error {
+	err := uservalidation.ValidatePassword(data.NewPassword())
+	if err != nil {
+		slog.Error("UserService.ResetPassword validation failed", "error", err)
+		return err
+	}
+
+	var userID object.UserID
+	err = u.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		reset, err := u.userRepo.GetPasswordResetByHash(ctx, data.Token().Hash())
+		if err != nil {
+			slog.Error("UserService.ResetPassword failed to find token", "error", err)
+			return err
+		}
+		if !reset.IsUsable(time.Now()) {
+			slog.Error("UserService.ResetPassword token is used or expired", "userID", reset.UserID.ID())
+			return usererror.ErrPasswordResetTokenIsNotValid
+		}
+
+		consumed, err := u.userRepo.ConsumePasswordResets(ctx, reset.UserID)
+		if err != nil {
+			slog.Error("UserService.ResetPassword failed to consume tokens", "error", err)
+			return err
+		}
+		if !slices.Contains(consumed, reset.ID) {
+			slog.Error("UserService.ResetPassword token was used concurrently", "userID", reset.UserID.ID())
+			return usererror.ErrPasswordResetTokenIsNotValid
+		}
+
+		user, err := u.userRepo.GetByUserID(ctx, reset.UserID)
+		if err != nil {
+			slog.Error("UserService.ResetPassword failed to find user", "error", err)
+			return err
+		}
+		userID = user.ID()
+		err = u.setPassword(ctx, user, data.NewPassword())
+		if err != nil {
+			return err
+		}
+
+		_, err = u.sessionService.RevokeAllSessions(ctx, userID)
+		if err != nil {
+			slog.Error("UserService.ResetPassword failed to revoke sessions", "error", err, "userID", userID.ID())
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	slog.Info("UserService.ResetPassword password reset", "userID", userID.ID())
+	return nil
+}
+
+func (u *UserService) setPassword(ctx context.Context, user *userdomain.User, password string) error {
+	hashPassword, err := hasher.HashPassword(password)
+	if err != nil {
+		slog.Error("UserService.setPassword failed to hash password", "error", err)
+		return err
+	}
+	user.SetPassword(hashPassword)
+	_, err = u.userRepo.Save(ctx, user)
+	if err != nil {
+		slog.Error("UserService.setPassword failed to save user", "error", err)
+		return err
+	}
+	return u.notify(ctx, user.Email(), "Your password was changed",
+		"The password of your account was changed.\nIf you did not do this, reset your password immediately.")
+}
+
+func (u *UserService) notify(ctx context.Context, to string, subject string, body string) error {
+	message, err := maildomain.NewMessage(to, subject, body)
+	if err != nil {
+		slog.Error("UserService.notify invalid message", "error", err)
+		return nil
+	}
+	err = u.outboxRepo.Enqueue(ctx, message)
+	if err != nil {
+		slog.Error("UserService.notify failed to enqueue message", "error", err)
+		return err
+	}
+	return nil
+}
+
+func buildLink(base string, token string) string {
+	link, err := url.Parse(base)
+	if err != nil {
+		return base + "?token=" + url.QueryEscape(token)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
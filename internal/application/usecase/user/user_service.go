@@ -4,24 +4,52 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/user/accountconfig"
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/user/hasher"
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/user/validation"
+	maildomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail"
+	sessiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/session"
+	sessionobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/session/object"
 	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
 	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type UserService struct {
-	tokenService   userdomain.TokenService
-	userRepo       userdomain.Repository
-	userTxManager  transactionmanager.TransactionManager[*userdomain.User]
-	tokenTxManager transactionmanager.TransactionManager[*object.AuthResponse]
+	sessionService   sessiondomain.Service
+	userRepo         userdomain.Repository
+	outboxRepo       maildomain.Repository
+	userTxManager    transactionmanager.TransactionManager[*userdomain.User]
+	tokenTxManager   transactionmanager.TransactionManager[*object.AuthResponse]
+	txUser           transactionmanager.TransactionUser
+	passwordResetTTL time.Duration
+	passwordResetURL string
+	signer           userdomain.VerificationSigner
+	verificationTTL  time.Duration
+	verificationURL  string
+	resendInterval   time.Duration
 }
 
-func NewUserService(tokenService userdomain.TokenService, userRepo userdomain.Repository, manager transactionmanager.TransactionManager[*userdomain.User], tokenTxManager transactionmanager.TransactionManager[*object.AuthResponse]) *UserService {
-	return &UserService{tokenService: tokenService, userRepo: userRepo, userTxManager: manager, tokenTxManager: tokenTxManager}
+func NewUserService(sessionService sessiondomain.Service, userRepo userdomain.Repository, outboxRepo maildomain.Repository, manager transactionmanager.TransactionManager[*userdomain.User],
+	tokenTxManager transactionmanager.TransactionManager[*object.AuthResponse], txUser transactionmanager.TransactionUser, signer userdomain.VerificationSigner, config accountconfig.AccountConfig) *UserService {
+	passwordResetTTL := config.PasswordResetTTL
+	if passwordResetTTL <= 0 {
+		passwordResetTTL = userdomain.DefaultPasswordResetTTL
+	}
+	verificationTTL := config.VerificationTTL
+	if verificationTTL <= 0 {
+		verificationTTL = userdomain.DefaultVerificationTTL
+	}
+	resendInterval := config.VerificationResendInterval
+	if resendInterval <= 0 {
+		resendInterval = userdomain.DefaultVerificationResendInterval
+	}
+	return &UserService{sessionService: sessionService, userRepo: userRepo, outboxRepo: outboxRepo, userTxManager: manager, tokenTxManager: tokenTxManager,
+		txUser: txUser, passwordResetTTL: passwordResetTTL, passwordResetURL: config.PasswordResetURL, signer: signer, verificationTTL: verificationTTL,
+		verificationURL: config.VerificationURL, resendInterval: resendInterval}
 }
 
 func (u *UserService) GetUserByID(ctx context.Context, id object.UserID) (*userdomain.User, error) {
@@ -67,12 +95,18 @@ func (u *UserService) Register(ctx context.Context, data object.UserRegistration
 			slog.Error("Failed to create user", "error", err)
 			return nil, usererror.ErrFailedToRegisterUser
 		}
+
+		err = u.sendVerification(ctx, user, 0)
+		if err != nil {
+			slog.Error("Failed to send verification email", "error", err)
+			return nil, err
+		}
 		slog.Debug("User created with id", "userID", user.ID().ID())
 		return user, nil
 	})
 }
 
-func (u *UserService) Authenticate(ctx context.Context, data object.AuthenticationData) (*object.AuthResponse, error) {
+func (u *UserService) Authenticate(ctx context.Context, data object.AuthenticationData, client sessionobject.ClientInfo) (*object.AuthResponse, error) {
 	return u.tokenTxManager.InTransaction(ctx, func(ctx context.Context) (*object.AuthResponse, error) {
 		err := uservalidation.ValidateAuthenticationData(data)
 		if err != nil {
@@ -92,13 +126,43 @@ func (u *UserService) Authenticate(ctx context.Context, data object.Authenticati
 			return &object.AuthResponse{}, usererror.ErrInvalidPassword
 		}
 
-		token, err := u.tokenService.GenerateToken(ctx, user)
+		authResp, err := u.sessionService.Start(ctx, user, client)
 		if err != nil {
-			slog.Error("failed to generate token error", "error", err)
+			slog.Error("failed to start session error", "error", err)
 			return &object.AuthResponse{}, err
 		}
 		slog.Debug("user is authenticated", "ID", user.ID().ID())
-		return &object.AuthResponse{Username: user.Username(), Token: token, Email: user.Email()}, nil
+		return authResp, nil
 	})
 
 }
+
+func (u *UserService) ChangeRole(ctx context.Context, actorID object.UserID, targetID object.UserID, role object.Role) error {
+	if actorID == targetID {
+		slog.Error("UserService.ChangeRole user tried to change own role", "userID", actorID.ID())
+		return usererror.ErrCannotChangeOwnRole
+	}
+	_, err := u.userTxManager.InTransaction(ctx, func(ctx context.Context) (*userdomain.User, error) {
+		err := userdomain.CheckPermission(ctx, u.userRepo, actorID, object.PermissionManageUsers)
+		if err != nil {
+			slog.Error("UserService.ChangeRole permission check failed", "error", err, "actorID", actorID.ID())
+			return nil, err
+		}
+
+		user, err := u.userRepo.GetByUserID(ctx, targetID)
+		if err != nil {
+			slog.Error("UserService.ChangeRole failed to find user", "error", err, "userID", targetID.ID())
+			return nil, err
+		}
+
+		user.SetRole(role)
+		user, err = u.userRepo.Save(ctx, user)
+		if err != nil {
+			slog.Error("UserService.ChangeRole failed to save user", "error", err)
+			return nil, err
+		}
+		slog.Info("UserService.ChangeRole role changed", "userID", targetID.ID(), "role", role, "actorID", actorID.ID())
+		return user, nil
+	})
+	return err
+}
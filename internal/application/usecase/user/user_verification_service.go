@@ -0,0 +1,86 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+func (u *UserService) VerifyEmail(ctx context.Context, token string) error {
+	claims, err := u.signer.Verify(token, time.Now())
+	if err != nil {
+		slog.Error("UserService.VerifyEmail token is not valid", "error", err)
+		return err
+	}
+
+	return u.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		user, err := u.userRepo.GetByUserID(ctx, claims.UserID())
+		if err != nil {
+			slog.Error("UserService.VerifyEmail failed to find user", "error", err, "userID", claims.UserID().ID())
+			if errors.Is(err, usererror.ErrUserIsNotFound) {
+				return usererror.ErrVerificationTokenIsNotValid
+			}
+			return err
+		}
+		if user.Email() != claims.Email() {
+			slog.Error("UserService.VerifyEmail token was issued for another email", "userID", claims.UserID().ID())
+			return usererror.ErrVerificationTokenIsNotValid
+		}
+		if user.EmailVerified() {
+			slog.Debug("UserService.VerifyEmail email is already verified", "userID", claims.UserID().ID())
+			return nil
+		}
+
+		user.SetEmailVerified(true)
+		_, err = u.userRepo.Save(ctx, user)
+		if err != nil {
+			slog.Error("UserService.VerifyEmail failed to save user", "error", err)
+			return err
+		}
+		slog.Info("UserService.VerifyEmail email verified", "userID", claims.UserID().ID())
+		return nil
+	})
+}
+
+func (u *UserService) ResendVerification(ctx context.Context, userID object.UserID) error {
+	return u.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		user, err := u.userRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			slog.Error("UserService.ResendVerification failed to find user", "error", err, "userID", userID.ID())
+			return err
+		}
+		if user.EmailVerified() {
+			slog.Error("UserService.ResendVerification email is already verified", "userID", userID.ID())
+			return usererror.ErrEmailAlreadyVerified
+		}
+
+		err = u.sendVerification(ctx, user, u.resendInterval)
+		if err != nil {
+			return err
+		}
+		slog.Info("UserService.ResendVerification verification resent", "userID", userID.ID())
+		return nil
+	})
+}
+
+func (u *UserService) sendVerification(ctx context.Context, user *userdomain.User, minInterval time.Duration) error {
+	marked, err := u.userRepo.MarkVerificationSent(ctx, user.ID(), minInterval)
+	if err != nil {
+		slog.Error("UserService.sendVerification failed to mark verification sent", "error", err)
+		return err
+	}
+	if !marked {
+		slog.Error("UserService.sendVerification verification was sent too recently", "userID", user.ID().ID())
+		return usererror.ErrVerificationResendThrottled
+	}
+
+	token := u.signer.Sign(object.NewEmailVerificationClaims(user.ID(), user.Email(), time.Now().Add(u.verificationTTL)))
+	body := "To confirm your email address, open the link below. It expires in " + u.verificationTTL.String() + ".\n\n" +
+		buildLink(u.verificationURL, token) + "\n\nIf you did not create an account, ignore this email."
+	return u.notify(ctx, user.Email(), "Confirm your email address", body)
+}
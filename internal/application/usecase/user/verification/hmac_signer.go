@@ -0,0 +1,82 @@
+package verification
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+const (
+	keyPurpose      = "email-verification"
+	minSecretLength = 32
+)
+
+type payload struct {
+	UserID    string `json:"sub"`
+	Email     string `json:"email"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+type HMACSigner struct {
+	key []byte
+}
+
+func NewHMACSigner(secret string, tokenSecret string) (*HMACSigner, error) {
+	if secret == "" {
+		return nil, usererror.ErrVerificationKeyIsNotConfigured
+	}
+	if len(secret) < minSecretLength || secret == tokenSecret {
+		return nil, usererror.ErrVerificationKeyIsNotValid
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(keyPurpose))
+	return &HMACSigner{key: mac.Sum(nil)}, nil
+}
+
+func (s *HMACSigner) Sign(claims object.EmailVerificationClaims) string {
+	data, _ := json.Marshal(payload{UserID: claims.UserID().ID(), Email: claims.Email(), ExpiresAt: claims.ExpiresAt().Unix()})
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(encoded))
+}
+
+func (s *HMACSigner) Verify(token string, now time.Time) (object.EmailVerificationClaims, error) {
+	encoded, signature, found := strings.Cut(strings.TrimSpace(token), ".")
+	if !found {
+		return object.EmailVerificationClaims{}, usererror.ErrVerificationTokenIsNotValid
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.sign(encoded)) {
+		return object.EmailVerificationClaims{}, usererror.ErrVerificationTokenIsNotValid
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return object.EmailVerificationClaims{}, usererror.ErrVerificationTokenIsNotValid
+	}
+	var p payload
+	if err = json.Unmarshal(data, &p); err != nil {
+		return object.EmailVerificationClaims{}, usererror.ErrVerificationTokenIsNotValid
+	}
+	userID, err := object.NewUserID(p.UserID)
+	if err != nil {
+		return object.EmailVerificationClaims{}, usererror.ErrVerificationTokenIsNotValid
+	}
+
+	claims := object.NewEmailVerificationClaims(userID, p.Email, time.Unix(p.ExpiresAt, 0))
+	if claims.IsExpired(now) {
+		return object.EmailVerificationClaims{}, usererror.ErrVerificationTokenIsNotValid
+	}
+	return claims, nil
+}
+
+func (s *HMACSigner) sign(encoded string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
@@ -8,44 +8,63 @@ import (
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
 	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
 	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	seriesdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/series"
+	seriesobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	statsdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/stats"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/title"
+	titleerror "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/error"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 	usermoviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
 	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie/error"
 )
 
 type UserMovieService struct {
-	movieInfosTxManager transactionmanager.TransactionManager[[]*usermoviedomain.MovieUserInfo]
-	movieInfoTxManager  transactionmanager.TransactionManager[*usermoviedomain.MovieUserInfo]
-	txUser              transactionmanager.TransactionUser
-	moviesRepo          moviedomain.Repository
-	userMovieRepo       usermoviedomain.Repository
+	movieInfosTxManager  transactionmanager.TransactionManager[[]*usermoviedomain.MovieUserInfo]
+	movieInfoTxManager   transactionmanager.TransactionManager[*usermoviedomain.MovieUserInfo]
+	seriesInfosTxManager transactionmanager.TransactionManager[[]*usermoviedomain.SeriesUserInfo]
+	seriesInfoTxManager  transactionmanager.TransactionManager[*usermoviedomain.SeriesUserInfo]
+	progressTxManager    transactionmanager.TransactionManager[*usermoviedomain.WatchProgress]
+	txUser               transactionmanager.TransactionUser
+	moviesRepo           moviedomain.Repository
+	seriesRepo           seriesdomain.Repository
+	userMovieRepo        usermoviedomain.Repository
+	statsRepo            statsdomain.Repository
 }
 
-func NewUserMovieService(moviesRepo moviedomain.Repository, userMovieRepo usermoviedomain.Repository, movieInfosTxManager *transactionmanager.TransactionManagerImpl[[]*usermoviedomain.MovieUserInfo], movieInfoTxManager transactionmanager.TransactionManager[*usermoviedomain.MovieUserInfo], txUser transactionmanager.TransactionUser) *UserMovieService {
+func NewUserMovieService(moviesRepo moviedomain.Repository, seriesRepo seriesdomain.Repository, userMovieRepo usermoviedomain.Repository, movieInfosTxManager *transactionmanager.TransactionManagerImpl[[]*usermoviedomain.MovieUserInfo], movieInfoTxManager transactionmanager.TransactionManager[*usermoviedomain.MovieUserInfo],
+	seriesInfosTxManager transactionmanager.TransactionManager[[]*usermoviedomain.SeriesUserInfo], seriesInfoTxManager transactionmanager.TransactionManager[*usermoviedomain.SeriesUserInfo],
+	progressTxManager transactionmanager.TransactionManager[*usermoviedomain.WatchProgress], txUser transactionmanager.TransactionUser, statsRepo statsdomain.Repository) *UserMovieService {
 	return &UserMovieService{
-		moviesRepo:          moviesRepo,
-		userMovieRepo:       userMovieRepo,
-		movieInfoTxManager:  movieInfoTxManager,
-		movieInfosTxManager: movieInfosTxManager,
-		txUser:              txUser,
+		moviesRepo:           moviesRepo,
+		seriesRepo:           seriesRepo,
+		userMovieRepo:        userMovieRepo,
+		movieInfoTxManager:   movieInfoTxManager,
+		movieInfosTxManager:  movieInfosTxManager,
+		seriesInfosTxManager: seriesInfosTxManager,
+		seriesInfoTxManager:  seriesInfoTxManager,
+		progressTxManager:    progressTxManager,
+		txUser:               txUser,
+		statsRepo:            statsRepo,
 	}
 }
 
-func (u *UserMovieService) SaveRating(ctx context.Context, userID object.UserID, info object2.MovieInfo, rating int) error {
+func (u *UserMovieService) SaveRating(ctx context.Context, userID object.UserID, ref titleobject.TargetRef, rating int) error {
 	return u.txUser.UseTransaction(ctx, func(ctx context.Context) error {
-		movie, err := u.moviesRepo.GetByReleaseDateAndTitle(ctx, info.Title, info.Year, info.Month, info.Day)
+		target, err := title.ResolveTarget(ctx, u.moviesRepo, u.seriesRepo, ref)
 		if err != nil {
-			slog.Error("UMSvc.SaveRating GetByReleaseDateAndTitle failed", "error", err)
+			slog.Error("UMSvc.SaveRating ResolveTarget failed", "error", err)
 			return err
 		}
 
-		userMovie, err := u.userMovieRepo.GetByUserAndMovie(ctx, userID, movie.ID())
+		userMovie, err := u.userMovieRepo.GetByUserAndTargetForUpdate(ctx, userID, target)
 		if err != nil && !errors.Is(err, error2.ErrUserMovieIsNotFound) {
-			slog.Error("UMSvc.SaveRating GetByUserAndMovie failed", "error", err)
+			slog.Error("UMSvc.SaveRating GetByUserAndTargetForUpdate failed", "error", err)
 			return err
 		} else if errors.Is(err, error2.ErrUserMovieIsNotFound) {
-			userMovie = usermoviedomain.NewUserMovie(userID, movie.ID())
+			userMovie = usermoviedomain.NewUserMovie(userID, target)
 		}
+		previous := userMovie.StatsContribution()
 		err = userMovie.SetRating(rating)
 		if err != nil {
 			slog.Error("UMSvc.SaveRating SetRating failed", "error", err)
@@ -65,32 +84,39 @@ func (u *UserMovieService) SaveRating(ctx context.Context, userID object.UserID,
 				return err
 			}
 		}
+
+		err = statsdomain.ApplyTargetDelta(ctx, u.statsRepo, target, userMovie.StatsContribution().Sub(previous))
+		if err != nil {
+			slog.Error("UMSvc.SaveRating ApplyTargetDelta failed", "error", err)
+			return err
+		}
 		slog.Debug("UMSvc.SaveRating user movie rating saved")
 		return nil
 	})
 }
 
-func (u *UserMovieService) SaveListType(ctx context.Context, userID object.UserID, info object2.MovieInfo, listType string) error {
+func (u *UserMovieService) SaveListType(ctx context.Context, userID object.UserID, ref titleobject.TargetRef, listType string) error {
 	movieListType, err := usermoviedomain.ValidateAndGetListType(listType)
 	if err != nil {
 		slog.Error("UMSvc.SaveListType Validation failed", "error", err)
 		return err
 	}
 	return u.txUser.UseTransaction(ctx, func(ctx context.Context) error {
-		movie, err := u.moviesRepo.GetByReleaseDateAndTitle(ctx, info.Title, info.Year, info.Month, info.Day)
+		target, err := title.ResolveTarget(ctx, u.moviesRepo, u.seriesRepo, ref)
 		if err != nil {
-			slog.Error("UMSvc.SaveListType GetByReleaseDateAndTitle failed", "error", err)
+			slog.Error("UMSvc.SaveListType ResolveTarget failed", "error", err)
 			return err
 		}
 
-		userMovie, err := u.userMovieRepo.GetByUserAndMovie(ctx, userID, movie.ID())
+		userMovie, err := u.userMovieRepo.GetByUserAndTargetForUpdate(ctx, userID, target)
 		if err != nil && !errors.Is(err, error2.ErrUserMovieIsNotFound) {
-			slog.Error("UMSvc.SaveListType GetByUserAndMovie failed", "error", err)
+			slog.Error("UMSvc.SaveListType GetByUserAndTargetForUpdate failed", "error", err)
 			return err
 		} else if errors.Is(err, error2.ErrUserMovieIsNotFound) {
-			userMovie = usermoviedomain.NewUserMovie(userID, movie.ID())
+			userMovie = usermoviedomain.NewUserMovie(userID, target)
 		}
 
+		previous := userMovie.StatsContribution()
 		userMovie.SetListType(movieListType)
 		if !userMovie.UserMovieID().IsEmpty() && userMovie.IsEmpty() {
 			err = u.userMovieRepo.Delete(ctx, userMovie)
@@ -105,26 +131,32 @@ func (u *UserMovieService) SaveListType(ctx context.Context, userID object.UserI
 				return err
 			}
 		}
+
+		err = statsdomain.ApplyTargetDelta(ctx, u.statsRepo, target, userMovie.StatsContribution().Sub(previous))
+		if err != nil {
+			slog.Error("UMSvc.SaveListType ApplyTargetDelta failed", "error", err)
+			return err
+		}
 		slog.Debug("UMSvc.SaveListType user movie list type saved")
 		return nil
 	})
 }
 
-func (u *UserMovieService) FindMovieByUser(ctx context.Context, userID object.UserID, info object2.MovieInfo, listType string) (*usermoviedomain.MovieUserInfo, error) {
+func (u *UserMovieService) FindMovieByUser(ctx context.Context, userID object.UserID, ref object2.MovieRef, listType string) (*usermoviedomain.MovieUserInfo, error) {
 	movieListType, err := usermoviedomain.ValidateAndGetListType(listType)
 	if err != nil {
 		slog.Error("UMSvc.FindMovieByUser Validation failed", "error", err)
 		return nil, err
 	}
 	return u.movieInfoTxManager.InTransaction(ctx, func(ctx context.Context) (*usermoviedomain.MovieUserInfo, error) {
-		movie, err := u.moviesRepo.GetByReleaseDateAndTitle(ctx, info.Title, info.Year, info.Month, info.Day)
+		movieID, err := moviedomain.FindIDByRef(ctx, u.moviesRepo, ref)
 
 		if err != nil {
-			slog.Error("UMSvc.FindMovieByUser GetByReleaseDateAndTitle failed", "error", err)
+			slog.Error("UMSvc.FindMovieByUser FindIDByRef failed", "error", err)
 			return nil, err
 		}
 
-		movieUserInfo, err := u.userMovieRepo.GetMovieByUserAndListType(ctx, userID, movie.ID(), movieListType)
+		movieUserInfo, err := u.userMovieRepo.GetMovieByUserAndListType(ctx, userID, movieID, movieListType)
 		if err != nil {
 			slog.Error("UMSvc.FindMovieByUser Failed to get MovieInfo by user", "error", err)
 			return nil, err
@@ -150,3 +182,111 @@ func (u *UserMovieService) FindMoviesByUserAndListType(ctx context.Context, user
 		return movieUserInfos, nil
 	})
 }
+
+func (u *UserMovieService) FindSeriesTargetByUser(ctx context.Context, userID object.UserID, ref titleobject.TargetRef, listType string) (*usermoviedomain.SeriesUserInfo, error) {
+	if !ref.IsSeries() {
+		return nil, titleerror.ErrTargetIsNotValid
+	}
+	seriesListType, err := usermoviedomain.ValidateAndGetListType(listType)
+	if err != nil {
+		slog.Error("UMSvc.FindSeriesTargetByUser Validation failed", "error", err)
+		return nil, err
+	}
+	return u.seriesInfoTxManager.InTransaction(ctx, func(ctx context.Context) (*usermoviedomain.SeriesUserInfo, error) {
+		target, err := title.ResolveTarget(ctx, u.moviesRepo, u.seriesRepo, ref)
+		if err != nil {
+			slog.Error("UMSvc.FindSeriesTargetByUser ResolveTarget failed", "error", err)
+			return nil, err
+		}
+
+		seriesUserInfo, err := u.userMovieRepo.GetSeriesTargetByUserAndListType(ctx, userID, target, seriesListType)
+		if err != nil {
+			slog.Error("UMSvc.FindSeriesTargetByUser GetSeriesTargetByUserAndListType failed", "error", err)
+			return nil, err
+		}
+		slog.Debug("UMSvc.FindSeriesTargetByUser successfully found series target by user")
+		return seriesUserInfo, nil
+	})
+}
+
+func (u *UserMovieService) FindSeriesByUserAndListType(ctx context.Context, userID object.UserID, listType string) ([]*usermoviedomain.SeriesUserInfo, error) {
+	seriesListType, err := usermoviedomain.ValidateAndGetListType(listType)
+	if err != nil {
+		slog.Error("UMSvc.FindSeriesByUserAndListType Validation failed", "error", err)
+		return nil, err
+	}
+	return u.seriesInfosTxManager.InTransaction(ctx, func(ctx context.Context) ([]*usermoviedomain.SeriesUserInfo, error) {
+		seriesUserInfos, err := u.userMovieRepo.GetSeriesByUserAndListType(ctx, userID, seriesListType)
+		if err != nil {
+			slog.Error("UMSvc.FindSeriesByUserAndListType GetSeriesByUserAndListType failed", "error", err)
+			return nil, err
+		}
+		slog.Debug("UMSvc.FindSeriesByUserAndListType series successfully found by user")
+		return seriesUserInfos, nil
+	})
+}
+
+func (u *UserMovieService) SaveProgress(ctx context.Context, userID object.UserID, ref seriesobject.SeriesRef, code seriesobject.EpisodeCode) (*usermoviedomain.WatchProgress, error) {
+	return u.progressTxManager.InTransaction(ctx, func(ctx context.Context) (*usermoviedomain.WatchProgress, error) {
+		seriesID, err := seriesdomain.FindIDByRef(ctx, u.seriesRepo, ref)
+		if err != nil {
+			slog.Error("UMSvc.SaveProgress FindIDByRef failed", "error", err)
+			return nil, err
+		}
+
+		episode, err := u.seriesRepo.GetEpisode(ctx, seriesID, code)
+		if err != nil {
+			slog.Error("UMSvc.SaveProgress GetEpisode failed", "error", err)
+			return nil, err
+		}
+
+		err = u.userMovieRepo.SaveProgress(ctx, usermoviedomain.NewWatchProgress(userID, seriesID, episode.ID(), code))
+		if err != nil {
+			slog.Error("UMSvc.SaveProgress SaveProgress failed", "error", err)
+			return nil, err
+		}
+
+		progress, err := u.userMovieRepo.GetProgress(ctx, userID, seriesID)
+		if err != nil {
+			slog.Error("UMSvc.SaveProgress GetProgress failed", "error", err)
+			return nil, err
+		}
+		slog.Debug("UMSvc.SaveProgress watch progress saved", "code", code.String())
+		return progress, nil
+	})
+}
+
+func (u *UserMovieService) FindProgress(ctx context.Context, userID object.UserID, ref seriesobject.SeriesRef) (*usermoviedomain.WatchProgress, error) {
+	return u.progressTxManager.InTransaction(ctx, func(ctx context.Context) (*usermoviedomain.WatchProgress, error) {
+		seriesID, err := seriesdomain.FindIDByRef(ctx, u.seriesRepo, ref)
+		if err != nil {
+			slog.Error("UMSvc.FindProgress FindIDByRef failed", "error", err)
+			return nil, err
+		}
+
+		progress, err := u.userMovieRepo.GetProgress(ctx, userID, seriesID)
+		if err != nil {
+			slog.Error("UMSvc.FindProgress GetProgress failed", "error", err)
+			return nil, err
+		}
+		return progress, nil
+	})
+}
+
+func (u *UserMovieService) DeleteProgress(ctx context.Context, userID object.UserID, ref seriesobject.SeriesRef) error {
+	return u.txUser.UseTransaction(ctx, func(ctx context.Context) error {
+		seriesID, err := seriesdomain.FindIDByRef(ctx, u.seriesRepo, ref)
+		if err != nil {
+			slog.Error("UMSvc.DeleteProgress FindIDByRef failed", "error", err)
+			return err
+		}
+
+		err = u.userMovieRepo.DeleteProgress(ctx, userID, seriesID)
+		if err != nil {
+			slog.Error("UMSvc.DeleteProgress DeleteProgress failed", "error", err)
+			return err
+		}
+		slog.Debug("UMSvc.DeleteProgress watch progress deleted")
+		return nil
+	})
+}
@@ -0,0 +1,97 @@
+package collection
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/collection/object"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+var (
+	maxNameLen        = 255
+	maxSlugLen        = 300
+	maxDescriptionLen = 5000
+	maxItems          = 500
+)
+
+type Collection struct {
+	id          object.CollectionID
+	Slug        string
+	Name        string
+	Description string
+	MovieCount  int
+	Items       []*Item
+}
+
+type Item struct {
+	MovieID     movieobject.MovieID
+	Slug        string
+	Title       string
+	ReleaseDate time.Time
+	Position    int
+	Rating      float64
+}
+
+func NewCollection(name, slug, description string) *Collection {
+	name = strings.TrimSpace(name)
+	slug = strings.TrimSpace(slug)
+	if slug == "" {
+		slug = object.NewCollectionSlug(name)
+	}
+	return &Collection{Name: name, Slug: slug, Description: strings.TrimSpace(description), Items: make([]*Item, 0)}
+}
+
+func ValidateCollection(collection *Collection) error {
+	if len(collection.Name) == 0 || len(collection.Name) > maxNameLen {
+		return error2.ErrCollectionDataValidationFailed
+	}
+	if len(collection.Slug) == 0 || len(collection.Slug) > maxSlugLen || object.NewCollectionSlug(collection.Slug) != collection.Slug {
+		return error2.ErrCollectionDataValidationFailed
+	}
+	if len(collection.Description) > maxDescriptionLen {
+		return error2.ErrCollectionDataValidationFailed
+	}
+	return nil
+}
+
+func ValidateMovies(movieIDs []movieobject.MovieID) error {
+	if len(movieIDs) > maxItems {
+		return error2.ErrCollectionDataValidationFailed
+	}
+	seen := make(map[movieobject.MovieID]bool, len(movieIDs))
+	for _, movieID := range movieIDs {
+		if seen[movieID] {
+			return error2.ErrCollectionDataValidationFailed
+		}
+		seen[movieID] = true
+	}
+	return nil
+}
+
+func (c *Collection) ID() object.CollectionID {
+	return c.id
+}
+
+func (c *Collection) SetID(id object.CollectionID) error {
+	if c.id.IsEmpty() {
+		c.id = id
+		return nil
+	}
+	return error2.ErrCollectionIDAlreadyExists
+}
+
+func (c *Collection) SetItems(items []*Item, order object.CollectionOrder) {
+	c.Items = items
+	c.MovieCount = len(items)
+	if order == object.CollectionOrderRelease {
+		slices.SortStableFunc(c.Items, func(a, b *Item) int {
+			if cmp := a.ReleaseDate.Compare(b.ReleaseDate); cmp != 0 {
+				return cmp
+			}
+			return a.Position - b.Position
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package collection
+
+import (
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	usermoviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
+)
+
+type UserStatus struct {
+	UserRating int
+	ListType   usermoviedomain.ListType
+}
+
+func (u UserStatus) IsRated() bool {
+	return u.UserRating != 0
+}
+
+func (u UserStatus) IsWatched() bool {
+	return u.IsRated() || u.ListType == usermoviedomain.ListTypeFavorite
+}
+
+type ItemProgress struct {
+	Item   *Item
+	Status UserStatus
+}
+
+type Completion struct {
+	Collection *Collection
+	Items      []*ItemProgress
+	Total      int
+	Rated      int
+	Watched    int
+	Next       *Item
+}
+
+func NewCompletion(collection *Collection, statuses map[movieobject.MovieID]UserStatus) *Completion {
+	completion := &Completion{Collection: collection, Items: make([]*ItemProgress, 0, len(collection.Items)), Total: len(collection.Items)}
+	for _, item := range collection.Items {
+		status := statuses[item.MovieID]
+		completion.Items = append(completion.Items, &ItemProgress{Item: item, Status: status})
+		if status.IsRated() {
+			completion.Rated++
+		}
+		if status.IsWatched() {
+			completion.Watched++
+		} else if completion.Next == nil {
+			completion.Next = item
+		}
+	}
+	return completion
+}
+
+func (c *Completion) Percent() float64 {
+	if c.Total == 0 {
+		return 0
+	}
+	return float64(c.Watched) * 100 / float64(c.Total)
+}
@@ -0,0 +1,12 @@
+package error
+
+import "errors"
+
+var (
+	ErrCollectionIDCreatingIsNotValid = errors.New("collection id is not valid")
+	ErrCollectionIsNotFound           = errors.New("collection not found")
+	ErrCollectionDataValidationFailed = errors.New("collection data validation failed")
+	ErrCollectionIDAlreadyExists      = errors.New("collection id already exists")
+	ErrCollectionAlreadyExists        = errors.New("collection with this slug already exists")
+	ErrCollectionOrderIsNotValid      = errors.New("collection order is not valid")
+)
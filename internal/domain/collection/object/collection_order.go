@@ -0,0 +1,24 @@
+package object
+
+import error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection/error"
+
+type CollectionOrder string
+
+const (
+	CollectionOrderPosition CollectionOrder = "position"
+	CollectionOrderRelease  CollectionOrder = "release"
+)
+
+func NewCollectionOrder(s string) (CollectionOrder, error) {
+	switch CollectionOrder(s) {
+	case "":
+		return CollectionOrderPosition, nil
+	case CollectionOrderPosition, CollectionOrderRelease:
+		return CollectionOrder(s), nil
+	}
+	return "", error2.ErrCollectionOrderIsNotValid
+}
+
+func (c CollectionOrder) String() string {
+	return string(c)
+}
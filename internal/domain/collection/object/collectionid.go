@@ -0,0 +1,25 @@
+package object
+
+import (
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection/error"
+	"github.com/google/uuid"
+)
+
+type CollectionID struct {
+	id string
+}
+
+func NewCollectionID(s string) (CollectionID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return CollectionID{}, error2.ErrCollectionIDCreatingIsNotValid
+	}
+	return CollectionID{id: s}, nil
+}
+
+func (c CollectionID) ID() string {
+	return c.id
+}
+
+func (c CollectionID) IsEmpty() bool {
+	return c.id == ""
+}
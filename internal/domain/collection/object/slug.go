@@ -0,0 +1,18 @@
+package object
+
+import "strings"
+
+func NewCollectionSlug(name string) string {
+	var builder strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+			dash = false
+		} else if !dash && builder.Len() > 0 {
+			builder.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.Trim(builder.String(), "-")
+}
@@ -0,0 +1,20 @@
+package collection
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/collection/object"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type Repository interface {
+	GetAll(ctx context.Context) ([]*Collection, error)
+	GetBySlug(ctx context.Context, slug string) (*Collection, error)
+	GetItems(ctx context.Context, collectionID object.CollectionID) ([]*Item, error)
+	GetUserStatuses(ctx context.Context, collectionID object.CollectionID, userID userobject.UserID) (map[movieobject.MovieID]UserStatus, error)
+	Save(ctx context.Context, collection *Collection) error
+	Update(ctx context.Context, collection *Collection) error
+	Delete(ctx context.Context, collectionID object.CollectionID) error
+	ReplaceMovies(ctx context.Context, collectionID object.CollectionID, movieIDs []movieobject.MovieID) error
+}
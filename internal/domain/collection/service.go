@@ -0,0 +1,18 @@
+package collection
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/collection/object"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type Service interface {
+	GetAll(ctx context.Context) ([]*Collection, error)
+	FindBySlug(ctx context.Context, slug string, order object.CollectionOrder) (*Collection, error)
+	FindCompletion(ctx context.Context, userID userobject.UserID, slug string, order object.CollectionOrder) (*Completion, error)
+	CreateCollection(ctx context.Context, actorID userobject.UserID, collection *Collection, movies []movieobject.MovieRef) (*Collection, error)
+	UpdateCollection(ctx context.Context, actorID userobject.UserID, slug string, collection *Collection, movies []movieobject.MovieRef) (*Collection, error)
+	DeleteCollection(ctx context.Context, actorID userobject.UserID, slug string) error
+}
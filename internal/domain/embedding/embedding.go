@@ -0,0 +1,70 @@
+package embedding
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/object"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	reviewdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/review"
+)
+
+const (
+	DefaultBatchSize = 32
+	MaxAttempts      = 5
+)
+
+type Vector []float32
+
+func (v Vector) Norm() float64 {
+	sum := 0.0
+	for _, value := range v {
+		sum += float64(value) * float64(value)
+	}
+	return math.Sqrt(sum)
+}
+
+type Document struct {
+	EntityType object.EntityType
+	EntityID   string
+	Text       string
+	EnqueuedAt time.Time
+}
+
+type Embedder interface {
+	Model() string
+	Embed(ctx context.Context, texts []string) ([]Vector, error)
+}
+
+type Match struct {
+	EntityType object.EntityType
+	EntityID   string
+	MovieID    string
+	Similarity float64
+}
+
+type Hit struct {
+	EntityType object.EntityType
+	Similarity float64
+	Movie      *moviedomain.Movie
+	Review     *reviewdomain.ReviewInfo
+}
+
+type SearchResult struct {
+	Query string
+	Model string
+	Hits  []*Hit
+}
+
+func (s *SearchResult) Movies() []*moviedomain.Movie {
+	seen := make(map[*moviedomain.Movie]bool, len(s.Hits))
+	movies := make([]*moviedomain.Movie, 0, len(s.Hits))
+	for _, hit := range s.Hits {
+		if hit.Movie != nil && !seen[hit.Movie] {
+			seen[hit.Movie] = true
+			movies = append(movies, hit.Movie)
+		}
+	}
+	return movies
+}
@@ -0,0 +1,11 @@
+package error
+
+import "errors"
+
+var (
+	ErrEntityTypeIsNotValid          = errors.New("embedding entity type is not valid")
+	ErrSemanticQueryIsNotValid       = errors.New("semantic search query is not valid")
+	ErrEmbedderIsNotConfigured       = errors.New("embedder is not configured")
+	ErrEmbedderRequestFailed         = errors.New("embedder request failed")
+	ErrEmbeddingDimensionsMismatched = errors.New("embedding dimensions mismatched")
+)
@@ -0,0 +1,30 @@
+package object
+
+import (
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/error"
+)
+
+type EntityType string
+
+const (
+	EntityTypeMovie  EntityType = "movie"
+	EntityTypeReview EntityType = "review"
+)
+
+func NewEntityType(s string) (EntityType, error) {
+	switch entityType := EntityType(strings.ToLower(strings.TrimSpace(s))); entityType {
+	case EntityTypeMovie, EntityTypeReview:
+		return entityType, nil
+	}
+	return "", error2.ErrEntityTypeIsNotValid
+}
+
+func EntityTypes() []EntityType {
+	return []EntityType{EntityTypeMovie, EntityTypeReview}
+}
+
+func (e EntityType) String() string {
+	return string(e)
+}
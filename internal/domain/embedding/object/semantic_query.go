@@ -0,0 +1,45 @@
+package object
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/error"
+)
+
+const (
+	DefaultSemanticLimit  = 10
+	MaxSemanticLimit      = 50
+	MaxSemanticQueryRunes = 500
+)
+
+type SemanticQuery struct {
+	Text  string
+	Types []EntityType
+	Limit int
+}
+
+func GetSemanticQueryFromReq(r *http.Request) (SemanticQuery, error) {
+	query := r.URL.Query()
+	semanticQuery := SemanticQuery{Text: strings.TrimSpace(query.Get("q")), Types: EntityTypes(), Limit: DefaultSemanticLimit}
+	if semanticQuery.Text == "" || len([]rune(semanticQuery.Text)) > MaxSemanticQueryRunes {
+		return SemanticQuery{}, error2.ErrSemanticQueryIsNotValid
+	}
+
+	if s := query.Get("type"); s != "" {
+		entityType, err := NewEntityType(s)
+		if err != nil {
+			return SemanticQuery{}, error2.ErrSemanticQueryIsNotValid
+		}
+		semanticQuery.Types = []EntityType{entityType}
+	}
+	if s := query.Get("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 || limit > MaxSemanticLimit {
+			return SemanticQuery{}, error2.ErrSemanticQueryIsNotValid
+		}
+		semanticQuery.Limit = limit
+	}
+	return semanticQuery, nil
+}
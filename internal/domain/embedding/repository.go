@@ -0,0 +1,15 @@
+package embedding
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/object"
+)
+
+type Repository interface {
+	GetPending(ctx context.Context, limit int, maxAttempts int) ([]*Document, error)
+	Save(ctx context.Context, document *Document, model string, vector Vector) error
+	MarkFailed(ctx context.Context, document *Document) error
+	Requeue(ctx context.Context, model string) (int, error)
+	Search(ctx context.Context, model string, vector Vector, types []object.EntityType, limit int) ([]*Match, error)
+}
@@ -0,0 +1,12 @@
+package embedding
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/object"
+)
+
+type Service interface {
+	Search(ctx context.Context, query object.SemanticQuery) (*SearchResult, error)
+	ProcessPending(ctx context.Context) (int, error)
+}
@@ -0,0 +1,70 @@
+package genre
+
+import (
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/genre/object"
+)
+
+var (
+	maxNameLen = 100
+	maxSlugLen = 100
+)
+
+type Genre struct {
+	id   object.GenreID
+	Slug string
+	Name string
+}
+
+type GenreStats struct {
+	Genre         *Genre
+	MovieCount    int
+	AverageRating float64
+}
+
+type GenrePatch struct {
+	Name *string
+	Slug *string
+}
+
+func NewGenre(name, slug string) *Genre {
+	name = strings.TrimSpace(name)
+	slug = strings.TrimSpace(slug)
+	if slug == "" {
+		slug = object.NewGenreSlug(name)
+	}
+	return &Genre{Name: name, Slug: slug}
+}
+
+func ValidateGenre(genre *Genre) error {
+	if len(genre.Name) == 0 || len(genre.Name) > maxNameLen {
+		return error2.ErrGenreDataValidationFailed
+	}
+	if len(genre.Slug) == 0 || len(genre.Slug) > maxSlugLen || object.NewGenreSlug(genre.Slug) != genre.Slug {
+		return error2.ErrGenreDataValidationFailed
+	}
+	return nil
+}
+
+func (g *Genre) ID() object.GenreID {
+	return g.id
+}
+
+func (g *Genre) SetID(id object.GenreID) error {
+	if g.id.IsEmpty() {
+		g.id = id
+		return nil
+	}
+	return error2.ErrGenreIDAlreadyExists
+}
+
+func (g *Genre) ApplyPatch(patch GenrePatch) {
+	if patch.Name != nil {
+		g.Name = strings.TrimSpace(*patch.Name)
+	}
+	if patch.Slug != nil {
+		g.Slug = strings.TrimSpace(*patch.Slug)
+	}
+}
@@ -0,0 +1,11 @@
+package error
+
+import "errors"
+
+var (
+	ErrGenreIDCreatingIsNotValid = errors.New("genre id is not valid")
+	ErrGenreIsNotFound           = errors.New("genre not found")
+	ErrGenreDataValidationFailed = errors.New("genre data validation failed")
+	ErrGenreIDAlreadyExists      = errors.New("genre id already exists")
+	ErrGenreAlreadyExists        = errors.New("genre with this name or slug already exists")
+)
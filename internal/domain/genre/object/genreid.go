@@ -0,0 +1,25 @@
+package object
+
+import (
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre/error"
+	"github.com/google/uuid"
+)
+
+type GenreID struct {
+	id string
+}
+
+func NewGenreID(s string) (GenreID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return GenreID{}, error2.ErrGenreIDCreatingIsNotValid
+	}
+	return GenreID{id: s}, nil
+}
+
+func (g GenreID) ID() string {
+	return g.id
+}
+
+func (g GenreID) IsEmpty() bool {
+	return g.id == ""
+}
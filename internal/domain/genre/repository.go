@@ -0,0 +1,18 @@
+package genre
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/genre/object"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type Repository interface {
+	GetAllWithStats(ctx context.Context) ([]*GenreStats, error)
+	GetBySlug(ctx context.Context, slug string) (*Genre, error)
+	FindByNames(ctx context.Context, names []string) ([]*Genre, error)
+	Save(ctx context.Context, genre *Genre) error
+	Update(ctx context.Context, genre *Genre) error
+	Delete(ctx context.Context, genreID object.GenreID) error
+	ReplaceMovieGenres(ctx context.Context, movieID movieobject.MovieID, genreIDs []object.GenreID) error
+}
@@ -0,0 +1,46 @@
+package genre
+
+import (
+	"context"
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/genre/object"
+)
+
+func ResolveGenres(ctx context.Context, repo Repository, names []string) ([]*Genre, error) {
+	if len(names) == 0 {
+		return make([]*Genre, 0), nil
+	}
+	genres, err := repo.FindByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool)
+	for _, genre := range genres {
+		known[genre.Slug] = true
+		known[strings.ToLower(genre.Name)] = true
+	}
+	for _, name := range names {
+		if !known[strings.ToLower(strings.TrimSpace(name))] && !known[object.NewGenreSlug(name)] {
+			return nil, error2.ErrGenreIsNotFound
+		}
+	}
+	return genres, nil
+}
+
+func GenreIDs(genres []*Genre) []object.GenreID {
+	ids := make([]object.GenreID, 0, len(genres))
+	for _, genre := range genres {
+		ids = append(ids, genre.ID())
+	}
+	return ids
+}
+
+func GenreNames(genres []*Genre) []string {
+	names := make([]string, 0, len(genres))
+	for _, genre := range genres {
+		names = append(names, genre.Name)
+	}
+	return names
+}
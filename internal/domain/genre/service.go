@@ -0,0 +1,15 @@
+package genre
+
+import (
+	"context"
+
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type Service interface {
+	GetAll(ctx context.Context) ([]*GenreStats, error)
+	FindBySlug(ctx context.Context, slug string) (*Genre, error)
+	CreateGenre(ctx context.Context, actorID userobject.UserID, genre *Genre) (*Genre, error)
+	UpdateGenre(ctx context.Context, actorID userobject.UserID, slug string, patch GenrePatch) (*Genre, error)
+	DeleteGenre(ctx context.Context, actorID userobject.UserID, slug string) error
+}
@@ -0,0 +1,17 @@
+package image
+
+import (
+	"context"
+	"io"
+)
+
+type Blob struct {
+	Body io.ReadCloser
+	Size int64
+}
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, body io.Reader) error
+	Get(ctx context.Context, key string) (*Blob, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,15 @@
+package error
+
+import "errors"
+
+var (
+	ErrImageIDCreatingIsNotValid    = errors.New("image id is not valid")
+	ErrImageIsNotFound              = errors.New("image not found")
+	ErrImageKindIsNotValid          = errors.New("image kind is not valid")
+	ErrImageVariantIsNotValid       = errors.New("image variant is not valid")
+	ErrImageContentTypeIsNotAllowed = errors.New("image content type is not allowed")
+	ErrImageIsTooLarge              = errors.New("image is too large")
+	ErrImageIsNotValid              = errors.New("image data is not valid")
+	ErrBlobIsNotFound               = errors.New("blob not found")
+	ErrBlobKeyIsNotValid            = errors.New("blob key is not valid")
+)
@@ -0,0 +1,82 @@
+package image
+
+import (
+	"fmt"
+	"time"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/image/object"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+const (
+	DefaultMaxUploadBytes = 10 << 20
+	maxDimension          = 10000
+	maxPixels             = 50_000_000
+)
+
+var allowedContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+type Image struct {
+	id          object.ImageID
+	MovieID     movieobject.MovieID
+	Kind        object.Kind
+	ContentType string
+	Width       int
+	Height      int
+	SizeBytes   int64
+	CreatedAt   time.Time
+}
+
+func NewImage(movieID movieobject.MovieID, kind object.Kind, contentType string, width, height int, sizeBytes int64) *Image {
+	return &Image{
+		id:          object.GenerateImageID(),
+		MovieID:     movieID,
+		Kind:        kind,
+		ContentType: contentType,
+		Width:       width,
+		Height:      height,
+		SizeBytes:   sizeBytes,
+		CreatedAt:   time.Now().UTC(),
+	}
+}
+
+func RestoreImage(id object.ImageID, movieID movieobject.MovieID, kind object.Kind, contentType string, width, height int, sizeBytes int64, createdAt time.Time) *Image {
+	image := NewImage(movieID, kind, contentType, width, height, sizeBytes)
+	image.id = id
+	image.CreatedAt = createdAt
+	return image
+}
+
+func (i *Image) ID() object.ImageID {
+	return i.id
+}
+
+func (i *Image) BlobKey(variant object.Variant) string {
+	return fmt.Sprintf("movies/%s/%s/%s%s", i.MovieID.ID(), i.id.ID(), variant, allowedContentTypes[i.ContentType])
+}
+
+func (i *Image) BlobKeys() []string {
+	keys := []string{i.BlobKey(object.VariantOriginal)}
+	for _, variant := range object.ResizedVariants {
+		keys = append(keys, i.BlobKey(variant))
+	}
+	return keys
+}
+
+func ValidateContentType(contentType string) error {
+	if _, ok := allowedContentTypes[contentType]; !ok {
+		return error2.ErrImageContentTypeIsNotAllowed
+	}
+	return nil
+}
+
+func ValidateDimensions(width, height int) error {
+	if width <= 0 || height <= 0 || width > maxDimension || height > maxDimension || width*height > maxPixels {
+		return error2.ErrImageIsNotValid
+	}
+	return nil
+}
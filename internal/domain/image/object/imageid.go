@@ -0,0 +1,29 @@
+package object
+
+import (
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/error"
+	"github.com/google/uuid"
+)
+
+type ImageID struct {
+	id string
+}
+
+func NewImageID(s string) (ImageID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return ImageID{}, error2.ErrImageIDCreatingIsNotValid
+	}
+	return ImageID{id: s}, nil
+}
+
+func GenerateImageID() ImageID {
+	return ImageID{id: uuid.NewString()}
+}
+
+func (i ImageID) ID() string {
+	return i.id
+}
+
+func (i ImageID) IsEmpty() bool {
+	return i.id == ""
+}
@@ -0,0 +1,37 @@
+package object
+
+import (
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/error"
+)
+
+type Kind string
+
+const (
+	KindPoster   Kind = "poster"
+	KindBackdrop Kind = "backdrop"
+)
+
+var variantWidths = map[Kind]map[Variant]int{
+	KindPoster:   {VariantThumbnail: 185, VariantMedium: 500},
+	KindBackdrop: {VariantThumbnail: 300, VariantMedium: 1280},
+}
+
+func NewKind(s string) (Kind, error) {
+	switch Kind(strings.ToLower(s)) {
+	case KindPoster:
+		return KindPoster, nil
+	case KindBackdrop:
+		return KindBackdrop, nil
+	}
+	return "", error2.ErrImageKindIsNotValid
+}
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+func (k Kind) VariantWidth(variant Variant) int {
+	return variantWidths[k][variant]
+}
@@ -0,0 +1,27 @@
+package object
+
+import (
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/error"
+)
+
+type Variant string
+
+const (
+	VariantOriginal  Variant = "original"
+	VariantMedium    Variant = "medium"
+	VariantThumbnail Variant = "thumbnail"
+)
+
+var ResizedVariants = []Variant{VariantMedium, VariantThumbnail}
+
+func NewVariant(s string) (Variant, error) {
+	switch Variant(s) {
+	case VariantOriginal, VariantMedium, VariantThumbnail:
+		return Variant(s), nil
+	}
+	return "", error2.ErrImageVariantIsNotValid
+}
+
+func (v Variant) String() string {
+	return string(v)
+}
@@ -0,0 +1,20 @@
+package image
+
+import "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/object"
+
+type EncodedImage struct {
+	Data   []byte
+	Width  int
+	Height int
+}
+
+type ProcessedImage struct {
+	ContentType string
+	Width       int
+	Height      int
+	Variants    map[object.Variant]*EncodedImage
+}
+
+type Processor interface {
+	Process(data []byte, kind object.Kind) (*ProcessedImage, error)
+}
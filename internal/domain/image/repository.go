@@ -0,0 +1,15 @@
+package image
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/image/object"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type Repository interface {
+	GetByID(ctx context.Context, imageID object.ImageID) (*Image, error)
+	GetByMovieAndKind(ctx context.Context, movieID movieobject.MovieID, kind object.Kind) (*Image, error)
+	Save(ctx context.Context, image *Image) error
+	Delete(ctx context.Context, imageID object.ImageID) error
+}
@@ -0,0 +1,15 @@
+package image
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/image/object"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type Service interface {
+	UploadMovieImage(ctx context.Context, actorID userobject.UserID, ref movieobject.MovieRef, kind object.Kind, contentType string, data []byte) (*Image, error)
+	DeleteMovieImage(ctx context.Context, actorID userobject.UserID, ref movieobject.MovieRef, kind object.Kind) error
+	OpenVariant(ctx context.Context, imageID object.ImageID, variant object.Variant) (*Image, *Blob, error)
+}
@@ -0,0 +1,9 @@
+package error
+
+import "errors"
+
+var (
+	ErrMessageIsNotValid     = errors.New("mail message is not valid")
+	ErrMailerIsNotConfigured = errors.New("mailer is not configured")
+	ErrMailDeliveryFailed    = errors.New("mail delivery failed")
+)
@@ -0,0 +1,50 @@
+package mail
+
+import (
+	"math"
+	netmail "net/mail"
+	"strings"
+	"time"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail/error"
+)
+
+const (
+	MaxAttempts      = 8
+	MaxSubjectLength = 255
+	BaseRetryDelay   = time.Minute
+	MaxRetryDelay    = 6 * time.Hour
+	ClaimLease       = 5 * time.Minute
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+func NewMessage(to string, subject string, body string) (Message, error) {
+	address, err := netmail.ParseAddress(to)
+	if err != nil {
+		return Message{}, error2.ErrMessageIsNotValid
+	}
+	subject = strings.TrimSpace(subject)
+	if subject == "" || len(subject) > MaxSubjectLength || strings.ContainsAny(subject, "\r\n") {
+		return Message{}, error2.ErrMessageIsNotValid
+	}
+	return Message{To: address.Address, Subject: subject, Body: body}, nil
+}
+
+type OutboxMessage struct {
+	ID       int64
+	Attempts int
+	Message
+}
+
+func RetryDelay(attempts int) time.Duration {
+	delay := float64(BaseRetryDelay) * math.Pow(2, float64(max(attempts-1, 0)))
+	if delay > float64(MaxRetryDelay) {
+		return MaxRetryDelay
+	}
+	return time.Duration(delay)
+}
@@ -0,0 +1,13 @@
+package mail
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Enqueue(ctx context.Context, message Message) error
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*OutboxMessage, error)
+	MarkSent(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, reason string, nextAttemptAt time.Time) error
+}
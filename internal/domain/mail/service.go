@@ -0,0 +1,7 @@
+package mail
+
+import "context"
+
+type Service interface {
+	DeliverPending(ctx context.Context) (int, error)
+}
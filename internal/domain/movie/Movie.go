@@ -4,32 +4,145 @@ import (
 	"strings"
 	"time"
 
+	imageobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/object"
 	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	personobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
 )
 
+var (
+	maxTitleLen       = 255
+	maxDescriptionLen = 5000
+	maxActorLen       = 100
+	maxRuntime        = 1000
+	maxCodeLen        = 16
+	maxPosterURLLen   = 2048
+	maxTaglineLen     = 500
+)
+
+func NewReleaseDate(year, month, day int) (time.Time, error) {
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if year <= 0 || date.Year() != year || int(date.Month()) != month || date.Day() != day {
+		return time.Time{}, error2.ErrMovieDataValidationFailed
+	}
+	return date, nil
+}
+
+func ValidateMovie(movie *Movie) error {
+	if len(movie.Title) == 0 || len(movie.Title) > maxTitleLen {
+		return error2.ErrMovieDataValidationFailed
+	}
+	if len(movie.Description) > maxDescriptionLen {
+		return error2.ErrMovieDataValidationFailed
+	}
+	if movie.ReleaseDate.IsZero() {
+		return error2.ErrMovieDataValidationFailed
+	}
+	for _, actor := range movie.Actors {
+		if len(actor) == 0 || len(actor) > maxActorLen {
+			return error2.ErrMovieDataValidationFailed
+		}
+	}
+	for _, genre := range movie.Genres {
+		if len(genre) == 0 {
+			return error2.ErrMovieDataValidationFailed
+		}
+	}
+	if err := persondomain.ValidateCredits(movie.Credits); err != nil {
+		return error2.ErrMovieDataValidationFailed
+	}
+	if movie.RuntimeMinutes < 0 || movie.RuntimeMinutes > maxRuntime {
+		return error2.ErrMovieDataValidationFailed
+	}
+	for _, code := range append(append([]string{}, movie.Countries...), movie.Languages...) {
+		if len(code) == 0 || len(code) > maxCodeLen {
+			return error2.ErrMovieDataValidationFailed
+		}
+	}
+	if len(movie.PosterURL) > maxPosterURLLen {
+		return error2.ErrMovieDataValidationFailed
+	}
+	for key, value := range movie.ExternalIDs {
+		if len(key) == 0 || len(value) == 0 {
+			return error2.ErrMovieDataValidationFailed
+		}
+	}
+	if len(movie.OriginalLanguage) > maxCodeLen || len(movie.Tagline) > maxTaglineLen {
+		return error2.ErrMovieDataValidationFailed
+	}
+	for country, certification := range movie.Certifications {
+		if len(country) == 0 || len(country) > maxCodeLen || len(certification) == 0 || len(certification) > maxCodeLen {
+			return error2.ErrMovieDataValidationFailed
+		}
+	}
+	if movie.Budget < 0 || movie.BoxOffice < 0 {
+		return error2.ErrMovieDataValidationFailed
+	}
+	return nil
+}
+
 type Movie struct {
-	id          object.MovieID
-	Title       string
-	Description string
-	ReleaseDate time.Time
-	Director    string
-	Actors      []string
-	Genres      []string
-	Rating      float64
+	id             object.MovieID
+	slug           string
+	Title          string
+	Description    string
+	ReleaseDate    time.Time
+	Director       string
+	Actors         []string
+	Genres         []string
+	Rating         float64
+	RatingCount    int
+	WeightedRating float64
+	ReviewCount    int
+	FavoriteCount  int
+	WatchlistCount int
+	Credits        []*persondomain.Credit
+	RuntimeMinutes int
+	Countries      []string
+	Languages      []string
+	PosterURL      string
+	ExternalIDs    map[string]string
+	Sources        map[object.MetadataField]string
+	EnrichedAt     time.Time
+	PosterImage    imageobject.ImageID
+	BackdropImage  imageobject.ImageID
+
+	OriginalLanguage string
+	Certifications   map[string]string
+	Budget           int64
+	BoxOffice        int64
+	Tagline          string
+
+	Related []*RelatedMovie
+
+	Language        object.LanguageTag
+	OriginalTitle   string
+	AlternateTitles []*AlternateTitle
 }
 
 func NewMovie(title, description string, releaseDate time.Time, director string, actors, genres []string, rating float64) *Movie {
-	return &Movie{
+	movie := &Movie{
 		id:          object.MovieID{},
 		Title:       strings.TrimSpace(title),
 		Description: strings.TrimSpace(description),
 		ReleaseDate: releaseDate,
 		Director:    strings.TrimSpace(director),
-		Actors:      actors,
-		Genres:      genres,
+		Actors:      trimAll(actors),
+		Genres:      trimAll(genres),
 		Rating:      rating,
+		Countries:   make([]string, 0),
+		Languages:   make([]string, 0),
+		ExternalIDs: make(map[string]string),
+		Sources:     make(map[object.MetadataField]string),
+
+		Certifications: make(map[string]string),
+		Related:        make([]*RelatedMovie, 0),
+
+		AlternateTitles: make([]*AlternateTitle, 0),
 	}
+	movie.Credits = persondomain.CreditsFromNames(movie.Director, movie.Actors)
+	return movie
 }
 
 func (m *Movie) ID() object.MovieID {
@@ -43,3 +156,206 @@ func (m *Movie) SetID(id object.MovieID) error {
 	}
 	return error2.ErrMovieIDAlreadyExists
 }
+
+func (m *Movie) Slug() string {
+	return m.slug
+}
+
+func (m *Movie) SetSlug(slug string) error {
+	if m.slug == "" {
+		m.slug = slug
+		return nil
+	}
+	return error2.ErrMovieSlugAlreadyExists
+}
+
+type MoviePatch struct {
+	Title       *string
+	Description *string
+	ReleaseDate *time.Time
+	Director    *string
+	Actors      *[]string
+	Genres      *[]string
+	Credits     *[]*persondomain.Credit
+
+	RuntimeMinutes *int
+	Countries      *[]string
+	Languages      *[]string
+	PosterURL      *string
+	ExternalIDs    *map[string]string
+
+	OriginalLanguage *string
+	Certifications   *map[string]string
+	Budget           *int64
+	BoxOffice        *int64
+	Tagline          *string
+}
+
+func (p MoviePatch) Fields() []object.MetadataField {
+	fields := make([]object.MetadataField, 0)
+	if p.Description != nil {
+		fields = append(fields, object.MetadataFieldDescription)
+	}
+	if p.Credits != nil || p.Director != nil || p.Actors != nil {
+		fields = append(fields, object.MetadataFieldCredits)
+	}
+	if p.Genres != nil {
+		fields = append(fields, object.MetadataFieldGenres)
+	}
+	if p.RuntimeMinutes != nil {
+		fields = append(fields, object.MetadataFieldRuntime)
+	}
+	if p.Countries != nil {
+		fields = append(fields, object.MetadataFieldCountries)
+	}
+	if p.Languages != nil {
+		fields = append(fields, object.MetadataFieldLanguages)
+	}
+	if p.PosterURL != nil {
+		fields = append(fields, object.MetadataFieldPoster)
+	}
+	if p.ExternalIDs != nil {
+		fields = append(fields, object.MetadataFieldExternalIDs)
+	}
+	if p.OriginalLanguage != nil {
+		fields = append(fields, object.MetadataFieldOriginalLanguage)
+	}
+	if p.Certifications != nil {
+		fields = append(fields, object.MetadataFieldCertifications)
+	}
+	if p.Budget != nil {
+		fields = append(fields, object.MetadataFieldBudget)
+	}
+	if p.BoxOffice != nil {
+		fields = append(fields, object.MetadataFieldBoxOffice)
+	}
+	if p.Tagline != nil {
+		fields = append(fields, object.MetadataFieldTagline)
+	}
+	return fields
+}
+
+func (m *Movie) ApplyPatch(patch MoviePatch) {
+	if patch.Title != nil {
+		m.Title = strings.TrimSpace(*patch.Title)
+	}
+	if patch.Description != nil {
+		m.Description = strings.TrimSpace(*patch.Description)
+	}
+	if patch.ReleaseDate != nil {
+		m.ReleaseDate = *patch.ReleaseDate
+	}
+	if patch.Credits != nil {
+		m.SetCredits(*patch.Credits)
+	}
+	if patch.Director != nil {
+		m.Director = strings.TrimSpace(*patch.Director)
+		m.Credits = persondomain.ReplaceRoleCredits(m.Credits, personobject.CreditRoleDirector, splitNames(m.Director))
+	}
+	if patch.Actors != nil {
+		m.Actors = trimAll(*patch.Actors)
+		m.Credits = persondomain.ReplaceRoleCredits(m.Credits, personobject.CreditRoleActor, m.Actors)
+	}
+	if patch.Genres != nil {
+		m.Genres = trimAll(*patch.Genres)
+	}
+	if patch.RuntimeMinutes != nil {
+		m.RuntimeMinutes = *patch.RuntimeMinutes
+	}
+	if patch.Countries != nil {
+		m.Countries = NormalizeCountries(*patch.Countries)
+	}
+	if patch.Languages != nil {
+		m.Languages = NormalizeLanguages(*patch.Languages)
+	}
+	if patch.PosterURL != nil {
+		m.PosterURL = strings.TrimSpace(*patch.PosterURL)
+	}
+	if patch.ExternalIDs != nil {
+		m.ExternalIDs = make(map[string]string, len(*patch.ExternalIDs))
+		for key, value := range *patch.ExternalIDs {
+			m.ExternalIDs[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	if patch.OriginalLanguage != nil {
+		m.OriginalLanguage = NormalizeLanguage(*patch.OriginalLanguage)
+	}
+	if patch.Certifications != nil {
+		m.Certifications = NormalizeCertifications(*patch.Certifications)
+	}
+	if patch.Budget != nil {
+		m.Budget = *patch.Budget
+	}
+	if patch.BoxOffice != nil {
+		m.BoxOffice = *patch.BoxOffice
+	}
+	if patch.Tagline != nil {
+		m.Tagline = strings.TrimSpace(*patch.Tagline)
+	}
+}
+
+func (m *Movie) SetCredits(credits []*persondomain.Credit) {
+	m.Credits = credits
+	directors := make([]string, 0)
+	actors := make([]string, 0)
+	for _, credit := range credits {
+		switch credit.Role {
+		case personobject.CreditRoleDirector:
+			directors = append(directors, credit.Name)
+		case personobject.CreditRoleActor:
+			actors = append(actors, credit.Name)
+		}
+	}
+	m.Director = strings.Join(directors, ", ")
+	m.Actors = actors
+}
+
+func splitNames(names string) []string {
+	result := make([]string, 0)
+	for _, name := range strings.Split(names, ",") {
+		if strings.TrimSpace(name) != "" {
+			result = append(result, strings.TrimSpace(name))
+		}
+	}
+	return result
+}
+
+func trimAll(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, strings.TrimSpace(value))
+	}
+	return result
+}
+
+func NormalizeCountry(country string) string {
+	return strings.ToUpper(strings.TrimSpace(country))
+}
+
+func NormalizeLanguage(language string) string {
+	return strings.ToLower(strings.TrimSpace(language))
+}
+
+func NormalizeCountries(countries []string) []string {
+	result := make([]string, 0, len(countries))
+	for _, country := range countries {
+		result = append(result, NormalizeCountry(country))
+	}
+	return result
+}
+
+func NormalizeLanguages(languages []string) []string {
+	result := make([]string, 0, len(languages))
+	for _, language := range languages {
+		result = append(result, NormalizeLanguage(language))
+	}
+	return result
+}
+
+func NormalizeCertifications(certifications map[string]string) map[string]string {
+	result := make(map[string]string, len(certifications))
+	for country, certification := range certifications {
+		result[NormalizeCountry(country)] = strings.TrimSpace(certification)
+	}
+	return result
+}
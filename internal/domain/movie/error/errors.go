@@ -3,8 +3,35 @@ package error
 import "errors"
 
 var (
-	ErrMovieIDCreatingIsNotValid = errors.New("movie id is not valid")
-	ErrMovieIsNotFound           = errors.New("movie not found")
-	ErrMovieDataValidationFailed = errors.New("movie data validation failed")
-	ErrMovieIDAlreadyExists      = errors.New("movie id already exists")
+	ErrMovieIDCreatingIsNotValid  = errors.New("movie id is not valid")
+	ErrMovieIsNotFound            = errors.New("movie not found")
+	ErrMovieDataValidationFailed  = errors.New("movie data validation failed")
+	ErrMovieIDAlreadyExists       = errors.New("movie id already exists")
+	ErrMovieAlreadyExists         = errors.New("movie with this title and release date already exists")
+	ErrMovieSlugAlreadyExists     = errors.New("movie slug already exists")
+	ErrMovieListQueryIsNotValid   = errors.New("movie list query is not valid")
+	ErrMovieCursorIsNotValid      = errors.New("movie cursor is not valid")
+	ErrMovieSearchQueryIsNotValid = errors.New("movie search query is not valid")
+	ErrTrendingWindowIsNotValid   = errors.New("trending window is not valid")
+)
+
+var (
+	ErrSimilarityStrategyIsNotValid = errors.New("similarity strategy is not valid")
+)
+
+var (
+	ErrMovieRelationIsNotValid = errors.New("movie relation is not valid")
+	ErrMovieRelationIsNotFound = errors.New("movie relation not found")
+)
+
+var (
+	ErrLanguageTagIsNotValid           = errors.New("language tag is not valid")
+	ErrTranslationIsNotFound           = errors.New("movie translation not found")
+	ErrTranslationDataValidationFailed = errors.New("movie translation data validation failed")
+)
+
+var (
+	ErrMetadataIsNotFound              = errors.New("movie metadata not found")
+	ErrMetadataProviderIsNotConfigured = errors.New("metadata provider is not configured")
+	ErrMetadataProviderRequestFailed   = errors.New("metadata provider request failed")
 )
@@ -0,0 +1,128 @@
+package movie
+
+import (
+	"context"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type Metadata struct {
+	ExternalIDs    map[string]string
+	Description    string
+	RuntimeMinutes int
+	Countries      []string
+	Languages      []string
+	PosterURL      string
+	Genres         []string
+	Credits        []*persondomain.Credit
+
+	OriginalLanguage string
+	Certifications   map[string]string
+	Budget           int64
+	BoxOffice        int64
+	Tagline          string
+}
+
+type MetadataProvider interface {
+	Name() string
+	FetchMetadata(ctx context.Context, movie *Movie) (*Metadata, error)
+}
+
+type EnrichmentResult struct {
+	Enriched int
+	NotFound int
+	Failed   int
+}
+
+type EnrichmentService interface {
+	EnrichMovie(ctx context.Context, actorID userobject.UserID, ref object.MovieRef) (*Movie, error)
+	EnrichStale(ctx context.Context, staleBefore time.Time, limit int) (EnrichmentResult, error)
+}
+
+func (m *Movie) ApplyMetadata(source string, metadata *Metadata) []object.MetadataField {
+	applied := make([]object.MetadataField, 0)
+	apply := func(field object.MetadataField, present bool, set func()) {
+		if !present || !m.canEnrich(field, source) {
+			return
+		}
+		set()
+		m.Sources[field] = source
+		applied = append(applied, field)
+	}
+
+	apply(object.MetadataFieldDescription, metadata.Description != "", func() { m.Description = metadata.Description })
+	apply(object.MetadataFieldRuntime, metadata.RuntimeMinutes > 0, func() { m.RuntimeMinutes = metadata.RuntimeMinutes })
+	apply(object.MetadataFieldCountries, len(metadata.Countries) > 0, func() { m.Countries = NormalizeCountries(metadata.Countries) })
+	apply(object.MetadataFieldLanguages, len(metadata.Languages) > 0, func() { m.Languages = NormalizeLanguages(metadata.Languages) })
+	apply(object.MetadataFieldPoster, metadata.PosterURL != "", func() { m.PosterURL = metadata.PosterURL })
+	apply(object.MetadataFieldExternalIDs, len(metadata.ExternalIDs) > 0, func() {
+		for key, value := range metadata.ExternalIDs {
+			m.ExternalIDs[key] = value
+		}
+	})
+	apply(object.MetadataFieldGenres, len(metadata.Genres) > 0, func() { m.Genres = trimAll(metadata.Genres) })
+	apply(object.MetadataFieldCredits, len(metadata.Credits) > 0, func() { m.SetCredits(metadata.Credits) })
+	apply(object.MetadataFieldOriginalLanguage, metadata.OriginalLanguage != "", func() {
+		m.OriginalLanguage = NormalizeLanguage(metadata.OriginalLanguage)
+	})
+	apply(object.MetadataFieldCertifications, len(metadata.Certifications) > 0, func() {
+		m.Certifications = NormalizeCertifications(metadata.Certifications)
+	})
+	apply(object.MetadataFieldBudget, metadata.Budget > 0, func() { m.Budget = metadata.Budget })
+	apply(object.MetadataFieldBoxOffice, metadata.BoxOffice > 0, func() { m.BoxOffice = metadata.BoxOffice })
+	apply(object.MetadataFieldTagline, metadata.Tagline != "", func() { m.Tagline = metadata.Tagline })
+	return applied
+}
+
+func (m *Movie) MarkSource(source string, fields ...object.MetadataField) {
+	for _, field := range fields {
+		if m.isEmpty(field) {
+			delete(m.Sources, field)
+			continue
+		}
+		m.Sources[field] = source
+	}
+}
+
+func (m *Movie) IsManual(field object.MetadataField) bool {
+	return m.Sources[field] == object.SourceManual
+}
+
+func (m *Movie) canEnrich(field object.MetadataField, source string) bool {
+	return m.isEmpty(field) || m.Sources[field] == source
+}
+
+func (m *Movie) isEmpty(field object.MetadataField) bool {
+	switch field {
+	case object.MetadataFieldDescription:
+		return m.Description == ""
+	case object.MetadataFieldRuntime:
+		return m.RuntimeMinutes == 0
+	case object.MetadataFieldCountries:
+		return len(m.Countries) == 0
+	case object.MetadataFieldLanguages:
+		return len(m.Languages) == 0
+	case object.MetadataFieldPoster:
+		return m.PosterURL == ""
+	case object.MetadataFieldExternalIDs:
+		return len(m.ExternalIDs) == 0
+	case object.MetadataFieldGenres:
+		return len(m.Genres) == 0
+	case object.MetadataFieldCredits:
+		return len(m.Credits) == 0
+	case object.MetadataFieldOriginalLanguage:
+		return m.OriginalLanguage == ""
+	case object.MetadataFieldCertifications:
+		return len(m.Certifications) == 0
+	case object.MetadataFieldBudget:
+		return m.Budget == 0
+	case object.MetadataFieldBoxOffice:
+		return m.BoxOffice == 0
+	case object.MetadataFieldTagline:
+		return m.Tagline == ""
+	}
+	return true
+}
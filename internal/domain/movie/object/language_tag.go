@@ -0,0 +1,65 @@
+package object
+
+import (
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+)
+
+const maxLanguageTagLen = 35
+
+type LanguageTag string
+
+func NewLanguageTag(s string) (LanguageTag, error) {
+	s = strings.TrimSpace(strings.ReplaceAll(s, "_", "-"))
+	if s == "" || len(s) > maxLanguageTagLen {
+		return "", error2.ErrLanguageTagIsNotValid
+	}
+	subtags := strings.Split(s, "-")
+	for i, subtag := range subtags {
+		if len(subtag) == 0 || len(subtag) > 8 || !isAlphanumeric(subtag) {
+			return "", error2.ErrLanguageTagIsNotValid
+		}
+		switch {
+		case i == 0:
+			if len(subtag) < 2 || len(subtag) > 3 || !isAlpha(subtag) {
+				return "", error2.ErrLanguageTagIsNotValid
+			}
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 4 && isAlpha(subtag):
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case len(subtag) == 2 && isAlpha(subtag):
+			subtags[i] = strings.ToUpper(subtag)
+		default:
+			subtags[i] = strings.ToLower(subtag)
+		}
+	}
+	return LanguageTag(strings.Join(subtags, "-")), nil
+}
+
+func (l LanguageTag) Base() LanguageTag {
+	base, _, _ := strings.Cut(string(l), "-")
+	return LanguageTag(base)
+}
+
+func (l LanguageTag) String() string {
+	return string(l)
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+package object
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const maxLocaleTags = 10
+
+type Locale struct {
+	tags []LanguageTag
+}
+
+func NewLocale(tags ...LanguageTag) Locale {
+	return Locale{tags: tags}
+}
+
+func ParseAcceptLanguage(header string) Locale {
+	type weightedTag struct {
+		tag    LanguageTag
+		weight float64
+	}
+	weighted := make([]weightedTag, 0)
+	for _, part := range strings.Split(header, ",") {
+		value, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		weight := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		tag, err := NewLanguageTag(value)
+		if err != nil || weight <= 0 {
+			continue
+		}
+		weighted = append(weighted, weightedTag{tag: tag, weight: weight})
+	}
+	slices.SortStableFunc(weighted, func(a, b weightedTag) int {
+		if a.weight > b.weight {
+			return -1
+		}
+		if a.weight < b.weight {
+			return 1
+		}
+		return 0
+	})
+
+	tags := make([]LanguageTag, 0, len(weighted))
+	for _, w := range weighted {
+		if !slices.Contains(tags, w.tag) && len(tags) < maxLocaleTags {
+			tags = append(tags, w.tag)
+		}
+	}
+	return Locale{tags: tags}
+}
+
+func GetLocaleFromReq(r *http.Request) Locale {
+	return ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+}
+
+func (l Locale) Tags() []LanguageTag {
+	return l.tags
+}
+
+func (l Locale) IsEmpty() bool {
+	return len(l.tags) == 0
+}
+
+func (l Locale) Bases() []string {
+	bases := make([]string, 0, len(l.tags))
+	for _, tag := range l.tags {
+		if !slices.Contains(bases, tag.Base().String()) {
+			bases = append(bases, tag.Base().String())
+		}
+	}
+	return bases
+}
+
+func (l Locale) Match(available []LanguageTag) (LanguageTag, bool) {
+	for _, tag := range l.tags {
+		if slices.Contains(available, tag) {
+			return tag, true
+		}
+		if slices.Contains(available, tag.Base()) {
+			return tag.Base(), true
+		}
+		for _, candidate := range available {
+			if candidate.Base() == tag.Base() {
+				return candidate, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,41 @@
+package object
+
+type MetadataField string
+
+const (
+	MetadataFieldDescription MetadataField = "description"
+	MetadataFieldRuntime     MetadataField = "runtime"
+	MetadataFieldCountries   MetadataField = "countries"
+	MetadataFieldLanguages   MetadataField = "languages"
+	MetadataFieldPoster      MetadataField = "poster"
+	MetadataFieldExternalIDs MetadataField = "external_ids"
+	MetadataFieldGenres      MetadataField = "genres"
+	MetadataFieldCredits     MetadataField = "credits"
+
+	MetadataFieldOriginalLanguage MetadataField = "original_language"
+	MetadataFieldCertifications   MetadataField = "certifications"
+	MetadataFieldBudget           MetadataField = "budget"
+	MetadataFieldBoxOffice        MetadataField = "box_office"
+	MetadataFieldTagline          MetadataField = "tagline"
+)
+
+var MetadataFields = []MetadataField{
+	MetadataFieldDescription,
+	MetadataFieldRuntime,
+	MetadataFieldCountries,
+	MetadataFieldLanguages,
+	MetadataFieldPoster,
+	MetadataFieldExternalIDs,
+	MetadataFieldGenres,
+	MetadataFieldCredits,
+	MetadataFieldOriginalLanguage,
+	MetadataFieldCertifications,
+	MetadataFieldBudget,
+	MetadataFieldBoxOffice,
+	MetadataFieldTagline,
+}
+
+const (
+	SourceManual = "manual"
+	SourceImport = "import"
+)
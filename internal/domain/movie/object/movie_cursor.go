@@ -0,0 +1,69 @@
+package object
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+)
+
+const cursorDateLayout = "2006-01-02"
+
+type MovieCursor struct {
+	Sort  MovieSort `json:"s"`
+	Order SortOrder `json:"o"`
+	Value string    `json:"v"`
+	ID    string    `json:"id"`
+}
+
+func NewMovieCursor(sort MovieSort, order SortOrder, value string, id MovieID) MovieCursor {
+	return MovieCursor{Sort: sort, Order: order, Value: value, ID: id.ID()}
+}
+
+func DecodeMovieCursor(s string) (MovieCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return MovieCursor{}, error2.ErrMovieCursorIsNotValid
+	}
+	var cursor MovieCursor
+	if err = json.Unmarshal(data, &cursor); err != nil {
+		return MovieCursor{}, error2.ErrMovieCursorIsNotValid
+	}
+	if _, err = NewMovieID(cursor.ID); err != nil {
+		return MovieCursor{}, error2.ErrMovieCursorIsNotValid
+	}
+	if _, err = NewMovieSort(string(cursor.Sort)); err != nil {
+		return MovieCursor{}, error2.ErrMovieCursorIsNotValid
+	}
+	if _, err = NewSortOrder(string(cursor.Order)); err != nil {
+		return MovieCursor{}, error2.ErrMovieCursorIsNotValid
+	}
+	if !cursor.valueIsValid() {
+		return MovieCursor{}, error2.ErrMovieCursorIsNotValid
+	}
+	return cursor, nil
+}
+
+func (c MovieCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func (c MovieCursor) valueIsValid() bool {
+	var err error
+	switch c.Sort {
+	case MovieSortReleaseDate:
+		_, err = time.Parse(cursorDateLayout, c.Value)
+	case MovieSortRating:
+		_, err = strconv.ParseFloat(c.Value, 64)
+	case MovieSortRatingCount:
+		_, err = strconv.Atoi(c.Value)
+	}
+	return err == nil
+}
+
+func FormatCursorDate(t time.Time) string {
+	return t.Format(cursorDateLayout)
+}
@@ -0,0 +1,203 @@
+package object
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+)
+
+type MovieSort string
+
+const (
+	MovieSortTitle       MovieSort = "title"
+	MovieSortReleaseDate MovieSort = "release_date"
+	MovieSortRating      MovieSort = "rating"
+	MovieSortRatingCount MovieSort = "rating_count"
+)
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+const (
+	DefaultMovieListLimit = 20
+	MaxMovieListLimit     = 100
+)
+
+type MovieFilter struct {
+	Genre     string
+	Director  string
+	Actor     string
+	YearFrom  int
+	YearTo    int
+	MinRating float64
+
+	RuntimeMin           int
+	RuntimeMax           int
+	Country              string
+	Language             string
+	OriginalLanguage     string
+	CertificationCountry string
+	Certification        string
+	BudgetMin            int64
+	BudgetMax            int64
+	BoxOfficeMin         int64
+	BoxOfficeMax         int64
+	Tagline              string
+}
+
+type MovieListQuery struct {
+	Filter MovieFilter
+	Sort   MovieSort
+	Order  SortOrder
+	Limit  int
+	Cursor *MovieCursor
+}
+
+func NewMovieSort(s string) (MovieSort, error) {
+	switch MovieSort(s) {
+	case MovieSortTitle, MovieSortReleaseDate, MovieSortRating, MovieSortRatingCount:
+		return MovieSort(s), nil
+	}
+	return "", error2.ErrMovieListQueryIsNotValid
+}
+
+func NewSortOrder(s string) (SortOrder, error) {
+	switch SortOrder(strings.ToLower(s)) {
+	case SortOrderAsc:
+		return SortOrderAsc, nil
+	case SortOrderDesc:
+		return SortOrderDesc, nil
+	}
+	return "", error2.ErrMovieListQueryIsNotValid
+}
+
+func GetMovieListQueryFromReq(r *http.Request) (MovieListQuery, error) {
+	query := r.URL.Query()
+	listQuery := MovieListQuery{Sort: MovieSortReleaseDate, Order: SortOrderAsc, Limit: DefaultMovieListLimit}
+
+	var err error
+	if s := query.Get("sort"); s != "" {
+		listQuery.Sort, err = NewMovieSort(s)
+		if err != nil {
+			return MovieListQuery{}, err
+		}
+	}
+	if s := query.Get("order"); s != "" {
+		listQuery.Order, err = NewSortOrder(s)
+		if err != nil {
+			return MovieListQuery{}, err
+		}
+	}
+	if s := query.Get("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 || limit > MaxMovieListLimit {
+			return MovieListQuery{}, error2.ErrMovieListQueryIsNotValid
+		}
+		listQuery.Limit = limit
+	}
+
+	listQuery.Filter.Genre = strings.TrimSpace(query.Get("genre"))
+	listQuery.Filter.Director = strings.TrimSpace(query.Get("director"))
+	listQuery.Filter.Actor = strings.TrimSpace(query.Get("actor"))
+	listQuery.Filter.YearFrom, err = parseOptionalInt(query.Get("year_from"))
+	if err != nil {
+		return MovieListQuery{}, err
+	}
+	listQuery.Filter.YearTo, err = parseOptionalInt(query.Get("year_to"))
+	if err != nil {
+		return MovieListQuery{}, err
+	}
+	if listQuery.Filter.YearFrom != 0 && listQuery.Filter.YearTo != 0 && listQuery.Filter.YearFrom > listQuery.Filter.YearTo {
+		return MovieListQuery{}, error2.ErrMovieListQueryIsNotValid
+	}
+	if s := query.Get("min_rating"); s != "" {
+		minRating, err := strconv.ParseFloat(s, 64)
+		if err != nil || minRating < 0 || minRating > 10 {
+			return MovieListQuery{}, error2.ErrMovieListQueryIsNotValid
+		}
+		listQuery.Filter.MinRating = minRating
+	}
+	if err = parseDetailsFilter(query, &listQuery.Filter); err != nil {
+		return MovieListQuery{}, err
+	}
+
+	if s := query.Get("cursor"); s != "" {
+		cursor, err := DecodeMovieCursor(s)
+		if err != nil {
+			return MovieListQuery{}, err
+		}
+		if cursor.Sort != listQuery.Sort || cursor.Order != listQuery.Order {
+			return MovieListQuery{}, error2.ErrMovieCursorIsNotValid
+		}
+		listQuery.Cursor = &cursor
+	}
+	return listQuery, nil
+}
+
+func parseOptionalInt(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	value, err := strconv.Atoi(s)
+	if err != nil || value <= 0 {
+		return 0, error2.ErrMovieListQueryIsNotValid
+	}
+	return value, nil
+}
+
+func parseDetailsFilter(query url.Values, filter *MovieFilter) error {
+	var err error
+	if filter.RuntimeMin, err = parseOptionalInt(query.Get("runtime_min")); err != nil {
+		return err
+	}
+	if filter.RuntimeMax, err = parseOptionalInt(query.Get("runtime_max")); err != nil {
+		return err
+	}
+	if filter.BudgetMin, err = parseOptionalInt64(query.Get("budget_min")); err != nil {
+		return err
+	}
+	if filter.BudgetMax, err = parseOptionalInt64(query.Get("budget_max")); err != nil {
+		return err
+	}
+	if filter.BoxOfficeMin, err = parseOptionalInt64(query.Get("box_office_min")); err != nil {
+		return err
+	}
+	if filter.BoxOfficeMax, err = parseOptionalInt64(query.Get("box_office_max")); err != nil {
+		return err
+	}
+	if filter.RuntimeMin != 0 && filter.RuntimeMax != 0 && filter.RuntimeMin > filter.RuntimeMax {
+		return error2.ErrMovieListQueryIsNotValid
+	}
+	if filter.BudgetMin != 0 && filter.BudgetMax != 0 && filter.BudgetMin > filter.BudgetMax {
+		return error2.ErrMovieListQueryIsNotValid
+	}
+	if filter.BoxOfficeMin != 0 && filter.BoxOfficeMax != 0 && filter.BoxOfficeMin > filter.BoxOfficeMax {
+		return error2.ErrMovieListQueryIsNotValid
+	}
+
+	filter.Country = strings.ToUpper(strings.TrimSpace(query.Get("country")))
+	filter.Language = strings.ToLower(strings.TrimSpace(query.Get("language")))
+	filter.OriginalLanguage = strings.ToLower(strings.TrimSpace(query.Get("original_language")))
+	filter.CertificationCountry = strings.ToUpper(strings.TrimSpace(query.Get("certification_country")))
+	filter.Certification = strings.TrimSpace(query.Get("certification"))
+	filter.Tagline = strings.TrimSpace(query.Get("tagline"))
+	return nil
+}
+
+func parseOptionalInt64(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || value <= 0 {
+		return 0, error2.ErrMovieListQueryIsNotValid
+	}
+	return value, nil
+}
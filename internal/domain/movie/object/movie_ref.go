@@ -0,0 +1,70 @@
+package object
+
+import (
+	"net/http"
+	"strings"
+)
+
+type MovieRef struct {
+	id   MovieID
+	slug string
+	info MovieInfo
+}
+
+func NewMovieRefByID(id MovieID) MovieRef {
+	return MovieRef{id: id}
+}
+
+func NewMovieRefBySlug(slug string) MovieRef {
+	return MovieRef{slug: strings.TrimSpace(slug)}
+}
+
+func NewMovieRefByInfo(info MovieInfo) MovieRef {
+	return MovieRef{info: info}
+}
+
+func NewMovieRef(id string, slug string, info MovieInfo) (MovieRef, error) {
+	if id != "" {
+		movieID, err := NewMovieID(id)
+		if err != nil {
+			return MovieRef{}, err
+		}
+		return NewMovieRefByID(movieID), nil
+	}
+	if strings.TrimSpace(slug) != "" {
+		return NewMovieRefBySlug(slug), nil
+	}
+	return NewMovieRefByInfo(info), nil
+}
+
+func GetMovieRefFromReq(r *http.Request) (MovieRef, error) {
+	query := r.URL.Query()
+	if query.Get("id") != "" || query.Get("slug") != "" {
+		return NewMovieRef(query.Get("id"), query.Get("slug"), MovieInfo{})
+	}
+	info, err := GetMovieInfoFromReq(r)
+	if err != nil {
+		return MovieRef{}, err
+	}
+	return NewMovieRefByInfo(info), nil
+}
+
+func (r MovieRef) ID() MovieID {
+	return r.id
+}
+
+func (r MovieRef) Slug() string {
+	return r.slug
+}
+
+func (r MovieRef) Info() MovieInfo {
+	return r.info
+}
+
+func (r MovieRef) HasID() bool {
+	return !r.id.IsEmpty()
+}
+
+func (r MovieRef) HasSlug() bool {
+	return r.slug != ""
+}
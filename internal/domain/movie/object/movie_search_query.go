@@ -0,0 +1,40 @@
+package object
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+)
+
+const maxSearchTextLen = 200
+
+type MovieSearchQuery struct {
+	Text  string
+	Limit int
+}
+
+func NewMovieSearchQuery(text string, limit int) (MovieSearchQuery, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > maxSearchTextLen {
+		return MovieSearchQuery{}, error2.ErrMovieSearchQueryIsNotValid
+	}
+	if limit <= 0 || limit > MaxMovieListLimit {
+		return MovieSearchQuery{}, error2.ErrMovieSearchQueryIsNotValid
+	}
+	return MovieSearchQuery{Text: text, Limit: limit}, nil
+}
+
+func GetMovieSearchQueryFromReq(r *http.Request) (MovieSearchQuery, error) {
+	limit := DefaultMovieListLimit
+	if s := r.URL.Query().Get("limit"); s != "" {
+		var err error
+		limit, err = strconv.Atoi(s)
+		if err != nil {
+			return MovieSearchQuery{}, error2.ErrMovieSearchQueryIsNotValid
+		}
+	}
+	return NewMovieSearchQuery(r.URL.Query().Get("q"), limit)
+}
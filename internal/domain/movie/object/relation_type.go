@@ -0,0 +1,38 @@
+package object
+
+import error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+
+type RelationType string
+
+const (
+	RelationTypeSequel   RelationType = "sequel"
+	RelationTypePrequel  RelationType = "prequel"
+	RelationTypeRemake   RelationType = "remake"
+	RelationTypeOriginal RelationType = "original"
+	RelationTypeSpinOff  RelationType = "spin_off"
+	RelationTypeParent   RelationType = "parent"
+)
+
+var inverseRelationTypes = map[RelationType]RelationType{
+	RelationTypeSequel:   RelationTypePrequel,
+	RelationTypePrequel:  RelationTypeSequel,
+	RelationTypeRemake:   RelationTypeOriginal,
+	RelationTypeOriginal: RelationTypeRemake,
+	RelationTypeSpinOff:  RelationTypeParent,
+	RelationTypeParent:   RelationTypeSpinOff,
+}
+
+func NewRelationType(s string) (RelationType, error) {
+	if _, ok := inverseRelationTypes[RelationType(s)]; ok {
+		return RelationType(s), nil
+	}
+	return "", error2.ErrMovieRelationIsNotValid
+}
+
+func (r RelationType) Inverse() RelationType {
+	return inverseRelationTypes[r]
+}
+
+func (r RelationType) String() string {
+	return string(r)
+}
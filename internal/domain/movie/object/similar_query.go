@@ -0,0 +1,29 @@
+package object
+
+import (
+	"net/http"
+	"strconv"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+)
+
+const (
+	DefaultSimilarLimit = 10
+	MaxSimilarLimit     = 50
+)
+
+type SimilarQuery struct {
+	Limit int
+}
+
+func GetSimilarQueryFromReq(r *http.Request) (SimilarQuery, error) {
+	query := SimilarQuery{Limit: DefaultSimilarLimit}
+	if s := r.URL.Query().Get("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 || limit > MaxSimilarLimit {
+			return SimilarQuery{}, error2.ErrMovieListQueryIsNotValid
+		}
+		query.Limit = limit
+	}
+	return query, nil
+}
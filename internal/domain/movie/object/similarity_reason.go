@@ -0,0 +1,14 @@
+package object
+
+type SimilarityReason string
+
+const (
+	SimilarityReasonDirector SimilarityReason = "director"
+	SimilarityReasonCast     SimilarityReason = "cast"
+	SimilarityReasonGenre    SimilarityReason = "genre"
+	SimilarityReasonCoRating SimilarityReason = "co_rating"
+)
+
+func (s SimilarityReason) String() string {
+	return string(s)
+}
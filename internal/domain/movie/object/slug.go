@@ -0,0 +1,44 @@
+package object
+
+import (
+	"strconv"
+	"strings"
+)
+
+const maxSlugBaseLen = 280
+
+func NewSlug(title string, year int) string {
+	var builder strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+			dash = false
+		} else if !dash && builder.Len() > 0 {
+			builder.WriteByte('-')
+			dash = true
+		}
+	}
+	base := strings.Trim(builder.String(), "-")
+	if len(base) > maxSlugBaseLen {
+		base = strings.Trim(base[:maxSlugBaseLen], "-")
+	}
+	if year > 0 {
+		if base == "" {
+			base = strconv.Itoa(year)
+		} else {
+			base = base + "-" + strconv.Itoa(year)
+		}
+	}
+	if base == "" {
+		return "movie"
+	}
+	return base
+}
+
+func SlugWithSuffix(slug string, n int) string {
+	if n <= 1 {
+		return slug
+	}
+	return slug + "-" + strconv.Itoa(n)
+}
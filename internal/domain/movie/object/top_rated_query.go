@@ -0,0 +1,49 @@
+package object
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+)
+
+const (
+	DefaultTopRatedLimit = 50
+	MaxTopRatedLimit     = 250
+)
+
+type TopRatedQuery struct {
+	Filter   MovieFilter
+	MinVotes int
+	Limit    int
+}
+
+func GetTopRatedQueryFromReq(r *http.Request) (TopRatedQuery, error) {
+	query := r.URL.Query()
+	topQuery := TopRatedQuery{Limit: DefaultTopRatedLimit}
+
+	var err error
+	if s := query.Get("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 || limit > MaxTopRatedLimit {
+			return TopRatedQuery{}, error2.ErrMovieListQueryIsNotValid
+		}
+		topQuery.Limit = limit
+	}
+	if topQuery.MinVotes, err = parseOptionalInt(query.Get("min_votes")); err != nil {
+		return TopRatedQuery{}, err
+	}
+
+	topQuery.Filter.Genre = strings.TrimSpace(query.Get("genre"))
+	if topQuery.Filter.YearFrom, err = parseOptionalInt(query.Get("year_from")); err != nil {
+		return TopRatedQuery{}, err
+	}
+	if topQuery.Filter.YearTo, err = parseOptionalInt(query.Get("year_to")); err != nil {
+		return TopRatedQuery{}, err
+	}
+	if topQuery.Filter.YearFrom != 0 && topQuery.Filter.YearTo != 0 && topQuery.Filter.YearFrom > topQuery.Filter.YearTo {
+		return TopRatedQuery{}, error2.ErrMovieListQueryIsNotValid
+	}
+	return topQuery, nil
+}
@@ -0,0 +1,37 @@
+package object
+
+import (
+	"net/http"
+	"strconv"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+)
+
+const (
+	DefaultTrendingLimit = 20
+	MaxTrendingLimit     = 100
+)
+
+type TrendingQuery struct {
+	Window TrendingWindow
+	Limit  int
+}
+
+func GetTrendingQueryFromReq(r *http.Request) (TrendingQuery, error) {
+	query := r.URL.Query()
+	trendingQuery := TrendingQuery{Limit: DefaultTrendingLimit}
+
+	var err error
+	trendingQuery.Window, err = NewTrendingWindow(query.Get("window"))
+	if err != nil {
+		return TrendingQuery{}, err
+	}
+	if s := query.Get("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 || limit > MaxTrendingLimit {
+			return TrendingQuery{}, error2.ErrMovieListQueryIsNotValid
+		}
+		trendingQuery.Limit = limit
+	}
+	return trendingQuery, nil
+}
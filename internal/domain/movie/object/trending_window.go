@@ -0,0 +1,47 @@
+package object
+
+import (
+	"time"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+)
+
+type TrendingWindow string
+
+const (
+	TrendingWindowDay   TrendingWindow = "day"
+	TrendingWindowWeek  TrendingWindow = "week"
+	TrendingWindowMonth TrendingWindow = "month"
+)
+
+var trendingWindowDurations = map[TrendingWindow]time.Duration{
+	TrendingWindowDay:   24 * time.Hour,
+	TrendingWindowWeek:  7 * 24 * time.Hour,
+	TrendingWindowMonth: 30 * 24 * time.Hour,
+}
+
+func NewTrendingWindow(s string) (TrendingWindow, error) {
+	if s == "" {
+		return TrendingWindowWeek, nil
+	}
+	if _, ok := trendingWindowDurations[TrendingWindow(s)]; ok {
+		return TrendingWindow(s), nil
+	}
+	return "", error2.ErrTrendingWindowIsNotValid
+}
+
+func TrendingWindows() []TrendingWindow {
+	return []TrendingWindow{TrendingWindowDay, TrendingWindowWeek, TrendingWindowMonth}
+}
+
+func (w TrendingWindow) Duration() time.Duration {
+	return trendingWindowDurations[w]
+}
+
+func (w TrendingWindow) HalfLife() time.Duration {
+	return w.Duration() / 4
+}
+
+func (w TrendingWindow) String() string {
+	return string(w)
+}
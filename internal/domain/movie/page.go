@@ -0,0 +1,28 @@
+package movie
+
+import (
+	"strconv"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type MoviePage struct {
+	Movies     []*Movie
+	Total      int
+	NextCursor string
+}
+
+func CursorForMovie(movie *Movie, query object.MovieListQuery) object.MovieCursor {
+	var value string
+	switch query.Sort {
+	case object.MovieSortTitle:
+		value = movie.Title
+	case object.MovieSortReleaseDate:
+		value = object.FormatCursorDate(movie.ReleaseDate)
+	case object.MovieSortRating:
+		value = strconv.FormatFloat(movie.Rating, 'g', -1, 64)
+	case object.MovieSortRatingCount:
+		value = strconv.Itoa(movie.RatingCount)
+	}
+	return object.NewMovieCursor(query.Sort, query.Order, value, movie.ID())
+}
@@ -0,0 +1,82 @@
+package movie
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+const (
+	MinRatingValue  = 1
+	MaxRatingValue  = 10
+	DefaultMinVotes = 10
+)
+
+type RatingStats struct {
+	Mean     float64
+	MinVotes int
+}
+
+func NewRatingStats(mean float64, minVotes int) RatingStats {
+	if minVotes <= 0 {
+		minVotes = DefaultMinVotes
+	}
+	return RatingStats{Mean: mean, MinVotes: minVotes}
+}
+
+func LoadRatingStats(ctx context.Context, repo Repository, minVotes int) (RatingStats, error) {
+	mean, err := repo.GetRatingMean(ctx)
+	if err != nil {
+		return RatingStats{}, err
+	}
+	return NewRatingStats(mean, minVotes), nil
+}
+
+func (s RatingStats) Weighted(rating float64, count int) float64 {
+	if count <= 0 {
+		return 0
+	}
+	votes := float64(count)
+	minVotes := float64(s.MinVotes)
+	return votes/(votes+minVotes)*rating + minVotes/(votes+minVotes)*s.Mean
+}
+
+func (s RatingStats) Apply(movies ...*Movie) {
+	for _, movie := range movies {
+		movie.WeightedRating = s.Weighted(movie.Rating, movie.RatingCount)
+	}
+}
+
+type RatingBucket struct {
+	Rating int
+	Count  int
+}
+
+type RatingSummary struct {
+	MovieID        object.MovieID
+	Rating         float64
+	RatingCount    int
+	WeightedRating float64
+	Stats          RatingStats
+	Histogram      []RatingBucket
+}
+
+func NewRatingSummary(movie *Movie, stats RatingStats, counts map[int]int) *RatingSummary {
+	histogram := make([]RatingBucket, 0, MaxRatingValue-MinRatingValue+1)
+	for rating := MinRatingValue; rating <= MaxRatingValue; rating++ {
+		histogram = append(histogram, RatingBucket{Rating: rating, Count: counts[rating]})
+	}
+	return &RatingSummary{
+		MovieID:        movie.ID(),
+		Rating:         movie.Rating,
+		RatingCount:    movie.RatingCount,
+		WeightedRating: stats.Weighted(movie.Rating, movie.RatingCount),
+		Stats:          stats,
+		Histogram:      histogram,
+	}
+}
+
+type RatingService interface {
+	GetRatings(ctx context.Context, ref object.MovieRef) (*RatingSummary, error)
+	TopRated(ctx context.Context, query object.TopRatedQuery) ([]*Movie, error)
+}
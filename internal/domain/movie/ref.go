@@ -0,0 +1,59 @@
+package movie
+
+import (
+	"context"
+	"errors"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+func FindByRef(ctx context.Context, repo Repository, ref object.MovieRef) (*Movie, error) {
+	if ref.HasID() {
+		return repo.GetByID(ctx, ref.ID())
+	}
+	if ref.HasSlug() {
+		return repo.GetBySlug(ctx, ref.Slug())
+	}
+	info := ref.Info()
+	return repo.GetByReleaseDateAndTitle(ctx, info.Title, info.Year, info.Month, info.Day)
+}
+
+func FindIDByRef(ctx context.Context, repo Repository, ref object.MovieRef) (object.MovieID, error) {
+	if ref.HasID() {
+		exists, err := repo.ExistsByID(ctx, ref.ID())
+		if err != nil {
+			return object.MovieID{}, err
+		}
+		if !exists {
+			return object.MovieID{}, error2.ErrMovieIsNotFound
+		}
+		return ref.ID(), nil
+	}
+	if ref.HasSlug() {
+		return repo.GetIDBySlug(ctx, ref.Slug())
+	}
+	info := ref.Info()
+	return repo.GetIDByReleaseDateAndTitle(ctx, info.Title, info.Year, info.Month, info.Day)
+}
+
+func SaveWithUniqueSlug(ctx context.Context, repo Repository, movie *Movie) error {
+	base := object.NewSlug(movie.Title, movie.ReleaseDate.Year())
+	for n := 1; ; n++ {
+		candidate := object.SlugWithSuffix(base, n)
+		exists, err := repo.ExistsBySlug(ctx, candidate)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		movie.slug = candidate
+		err = repo.Save(ctx, movie)
+		if !errors.Is(err, error2.ErrMovieSlugAlreadyExists) {
+			return err
+		}
+		movie.slug = ""
+	}
+}
@@ -0,0 +1,15 @@
+package movie
+
+import (
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+type RelatedMovie struct {
+	Type        object.RelationType
+	MovieID     object.MovieID
+	Slug        string
+	Title       string
+	ReleaseDate time.Time
+}
@@ -0,0 +1,31 @@
+package movie
+
+import (
+	"context"
+
+	genredomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+)
+
+func SaveRelations(ctx context.Context, personRepo persondomain.Repository, genreRepo genredomain.Repository, movie *Movie) error {
+	credits, err := persondomain.ResolveCredits(ctx, personRepo, movie.ID(), movie.Credits)
+	if err != nil {
+		return err
+	}
+	err = personRepo.ReplaceMovieCredits(ctx, movie.ID(), credits)
+	if err != nil {
+		return err
+	}
+	movie.SetCredits(credits)
+
+	genres, err := genredomain.ResolveGenres(ctx, genreRepo, movie.Genres)
+	if err != nil {
+		return err
+	}
+	err = genreRepo.ReplaceMovieGenres(ctx, movie.ID(), genredomain.GenreIDs(genres))
+	if err != nil {
+		return err
+	}
+	movie.Genres = genredomain.GenreNames(genres)
+	return nil
+}
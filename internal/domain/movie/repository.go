@@ -2,12 +2,41 @@ package movie
 
 import (
 	"context"
+	"time"
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
 )
 
 type Repository interface {
-	GetAll(ctx context.Context) ([]*Movie, error)
+	List(ctx context.Context, query object.MovieListQuery) ([]*Movie, error)
+	Count(ctx context.Context, filter object.MovieFilter) (int, error)
+	Search(ctx context.Context, query object.MovieSearchQuery) ([]*SearchHit, error)
+	SearchSimilar(ctx context.Context, query object.MovieSearchQuery) ([]*SearchHit, error)
 	GetByReleaseDateAndTitle(ctx context.Context, title string, year int, month int, day int) (*Movie, error)
 	GetIDByReleaseDateAndTitle(ctx context.Context, title string, year int, month int, day int) (object.MovieID, error)
+	GetByID(ctx context.Context, movieID object.MovieID) (*Movie, error)
+	GetByIDs(ctx context.Context, movieIDs []object.MovieID) ([]*Movie, error)
+	GetBySlug(ctx context.Context, slug string) (*Movie, error)
+	GetIDBySlug(ctx context.Context, slug string) (object.MovieID, error)
+	ExistsByID(ctx context.Context, movieID object.MovieID) (bool, error)
+	ExistsBySlug(ctx context.Context, slug string) (bool, error)
+	Save(ctx context.Context, movie *Movie) error
+	Update(ctx context.Context, movie *Movie) error
+	Delete(ctx context.Context, movieID object.MovieID) error
+	ListForEnrichment(ctx context.Context, staleBefore time.Time, limit int) ([]*Movie, error)
+	GetRelatedMovies(ctx context.Context, movieID object.MovieID) ([]*RelatedMovie, error)
+	SaveRelatedMovie(ctx context.Context, movieID object.MovieID, relatedID object.MovieID, relationType object.RelationType) error
+	DeleteRelatedMovie(ctx context.Context, movieID object.MovieID, relatedID object.MovieID) error
+	GetTranslations(ctx context.Context, movieIDs []object.MovieID, bases []string) (map[object.MovieID][]*Translation, error)
+	SaveTranslation(ctx context.Context, movieID object.MovieID, translation *Translation) error
+	DeleteTranslation(ctx context.Context, movieID object.MovieID, language object.LanguageTag) error
+	GetAlternateTitles(ctx context.Context, movieID object.MovieID) ([]*AlternateTitle, error)
+	ReplaceAlternateTitles(ctx context.Context, movieID object.MovieID, titles []*AlternateTitle) error
+	GetRatingMean(ctx context.Context) (float64, error)
+	GetRatingHistogram(ctx context.Context, movieID object.MovieID) (map[int]int, error)
+	TopRated(ctx context.Context, query object.TopRatedQuery, stats RatingStats) ([]*Movie, error)
+	RefreshTrending(ctx context.Context, window object.TrendingWindow, now time.Time, weights TrendingWeights, size int) (int, error)
+	GetTrending(ctx context.Context, window object.TrendingWindow, limit int) ([]*TrendingMovie, error)
+	GetContentOverlaps(ctx context.Context, movieID object.MovieID, limit int) ([]*ContentOverlap, error)
+	GetCoRatingNeighbors(ctx context.Context, movieID object.MovieID, limit int) ([]*CoRatingNeighbor, error)
 }
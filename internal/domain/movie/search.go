@@ -0,0 +1,20 @@
+package movie
+
+type SearchHit struct {
+	Movie   *Movie
+	Rank    float64
+	Snippet string
+}
+
+type SearchResult struct {
+	Hits  []*SearchHit
+	Fuzzy bool
+}
+
+func (s *SearchResult) Movies() []*Movie {
+	movies := make([]*Movie, 0, len(s.Hits))
+	for _, hit := range s.Hits {
+		movies = append(movies, hit.Movie)
+	}
+	return movies
+}
@@ -4,9 +4,18 @@ import (
 	"context"
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type Service interface {
 	FindByReleaseDateAndTitle(ctx context.Context, info object.MovieInfo) (*Movie, error)
-	GetAll(ctx context.Context) ([]*Movie, error)
+	FindByRef(ctx context.Context, ref object.MovieRef) (*Movie, error)
+	List(ctx context.Context, query object.MovieListQuery) (*MoviePage, error)
+	Search(ctx context.Context, query object.MovieSearchQuery) (*SearchResult, error)
+	CreateMovie(ctx context.Context, actorID userobject.UserID, movie *Movie) (*Movie, error)
+	UpdateMovie(ctx context.Context, actorID userobject.UserID, ref object.MovieRef, movie *Movie) (*Movie, error)
+	PatchMovie(ctx context.Context, actorID userobject.UserID, ref object.MovieRef, patch MoviePatch) (*Movie, error)
+	DeleteMovie(ctx context.Context, actorID userobject.UserID, ref object.MovieRef) error
+	SaveRelatedMovie(ctx context.Context, actorID userobject.UserID, ref object.MovieRef, relatedRef object.MovieRef, relationType object.RelationType) (*Movie, error)
+	DeleteRelatedMovie(ctx context.Context, actorID userobject.UserID, ref object.MovieRef, relatedRef object.MovieRef) (*Movie, error)
 }
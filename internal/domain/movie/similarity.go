@@ -0,0 +1,198 @@
+package movie
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+const (
+	SimilarityPoolFactor = 5
+	MaxSharedCast        = 3
+)
+
+type SimilarityReason struct {
+	Kind     object.SimilarityReason
+	Names    []string
+	CoRaters int
+}
+
+type SimilarityMatch struct {
+	MovieID object.MovieID
+	Score   float64
+	Reasons []SimilarityReason
+}
+
+type SimilarMovie struct {
+	Movie   *Movie
+	Score   float64
+	Reasons []SimilarityReason
+}
+
+type SimilarityStrategy interface {
+	Name() string
+	Similar(ctx context.Context, movieID object.MovieID, limit int) ([]*SimilarityMatch, error)
+}
+
+type SimilarityService interface {
+	Similar(ctx context.Context, ref object.MovieRef, query object.SimilarQuery) ([]*SimilarMovie, error)
+}
+
+type ContentOverlap struct {
+	MovieID    object.MovieID
+	Directors  []string
+	Actors     []string
+	Genres     []string
+	GenreUnion int
+}
+
+type CoRatingNeighbor struct {
+	MovieID  object.MovieID
+	Score    float64
+	CoRaters int
+}
+
+type ContentWeights struct {
+	Director float64
+	Cast     float64
+	Genre    float64
+}
+
+var DefaultContentWeights = ContentWeights{Director: 0.3, Cast: 0.4, Genre: 0.3}
+
+type ContentSimilarity struct {
+	repo    Repository
+	weights ContentWeights
+}
+
+func NewContentSimilarity(repo Repository, weights ContentWeights) *ContentSimilarity {
+	return &ContentSimilarity{repo: repo, weights: weights}
+}
+
+func (c *ContentSimilarity) Name() string {
+	return "content"
+}
+
+func (c *ContentSimilarity) Similar(ctx context.Context, movieID object.MovieID, limit int) ([]*SimilarityMatch, error) {
+	overlaps, err := c.repo.GetContentOverlaps(ctx, movieID, limit*SimilarityPoolFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*SimilarityMatch, 0, len(overlaps))
+	for _, overlap := range overlaps {
+		match := &SimilarityMatch{MovieID: overlap.MovieID}
+		if len(overlap.Directors) > 0 {
+			match.Score += c.weights.Director
+			match.Reasons = append(match.Reasons, SimilarityReason{Kind: object.SimilarityReasonDirector, Names: overlap.Directors})
+		}
+		if len(overlap.Actors) > 0 {
+			match.Score += c.weights.Cast * float64(min(len(overlap.Actors), MaxSharedCast)) / MaxSharedCast
+			match.Reasons = append(match.Reasons, SimilarityReason{Kind: object.SimilarityReasonCast, Names: overlap.Actors})
+		}
+		if len(overlap.Genres) > 0 && overlap.GenreUnion > 0 {
+			match.Score += c.weights.Genre * float64(len(overlap.Genres)) / float64(overlap.GenreUnion)
+			match.Reasons = append(match.Reasons, SimilarityReason{Kind: object.SimilarityReasonGenre, Names: overlap.Genres})
+		}
+		if match.Score > 0 {
+			matches = append(matches, match)
+		}
+	}
+	return topMatches(matches, limit), nil
+}
+
+type CoRatingSimilarity struct {
+	repo Repository
+}
+
+func NewCoRatingSimilarity(repo Repository) *CoRatingSimilarity {
+	return &CoRatingSimilarity{repo: repo}
+}
+
+func (c *CoRatingSimilarity) Name() string {
+	return "co_rating"
+}
+
+func (c *CoRatingSimilarity) Similar(ctx context.Context, movieID object.MovieID, limit int) ([]*SimilarityMatch, error) {
+	neighbors, err := c.repo.GetCoRatingNeighbors(ctx, movieID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*SimilarityMatch, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		matches = append(matches, &SimilarityMatch{
+			MovieID: neighbor.MovieID,
+			Score:   neighbor.Score,
+			Reasons: []SimilarityReason{{Kind: object.SimilarityReasonCoRating, CoRaters: neighbor.CoRaters}},
+		})
+	}
+	return topMatches(matches, limit), nil
+}
+
+type WeightedStrategy struct {
+	Strategy SimilarityStrategy
+	Weight   float64
+}
+
+type HybridSimilarity struct {
+	strategies []WeightedStrategy
+}
+
+func NewHybridSimilarity(strategies ...WeightedStrategy) *HybridSimilarity {
+	return &HybridSimilarity{strategies: strategies}
+}
+
+func (h *HybridSimilarity) Name() string {
+	return "hybrid"
+}
+
+func (h *HybridSimilarity) Similar(ctx context.Context, movieID object.MovieID, limit int) ([]*SimilarityMatch, error) {
+	total := 0.0
+	for _, weighted := range h.strategies {
+		total += weighted.Weight
+	}
+	if total <= 0 {
+		return []*SimilarityMatch{}, nil
+	}
+
+	combined := make(map[object.MovieID]*SimilarityMatch)
+	for _, weighted := range h.strategies {
+		if weighted.Weight <= 0 {
+			continue
+		}
+		matches, err := weighted.Strategy.Similar(ctx, movieID, limit*SimilarityPoolFactor)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			current, ok := combined[match.MovieID]
+			if !ok {
+				current = &SimilarityMatch{MovieID: match.MovieID}
+				combined[match.MovieID] = current
+			}
+			current.Score += match.Score * weighted.Weight / total
+			current.Reasons = append(current.Reasons, match.Reasons...)
+		}
+	}
+
+	matches := make([]*SimilarityMatch, 0, len(combined))
+	for _, match := range combined {
+		matches = append(matches, match)
+	}
+	return topMatches(matches, limit), nil
+}
+
+func topMatches(matches []*SimilarityMatch, limit int) []*SimilarityMatch {
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].MovieID.ID() < matches[j].MovieID.ID()
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
@@ -0,0 +1,122 @@
+package movie
+
+import (
+	"context"
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type Translation struct {
+	Language    object.LanguageTag
+	Title       string
+	Description string
+	Tagline     string
+}
+
+type AlternateTitle struct {
+	Title    string
+	Language object.LanguageTag
+}
+
+type TranslationService interface {
+	GetTranslations(ctx context.Context, ref object.MovieRef) ([]*Translation, error)
+	SaveTranslation(ctx context.Context, actorID userobject.UserID, ref object.MovieRef, translation *Translation) (*Translation, error)
+	DeleteTranslation(ctx context.Context, actorID userobject.UserID, ref object.MovieRef, language object.LanguageTag) error
+	SaveAlternateTitles(ctx context.Context, actorID userobject.UserID, ref object.MovieRef, titles []*AlternateTitle) ([]*AlternateTitle, error)
+	Localize(ctx context.Context, locale object.Locale, items ...Localizable) error
+}
+
+type Localizable interface {
+	LocalizationID() object.MovieID
+	ApplyTranslation(translation *Translation)
+}
+
+func NewTranslation(language object.LanguageTag, title, description, tagline string) *Translation {
+	return &Translation{
+		Language:    language,
+		Title:       strings.TrimSpace(title),
+		Description: strings.TrimSpace(description),
+		Tagline:     strings.TrimSpace(tagline),
+	}
+}
+
+func ValidateTranslation(translation *Translation) error {
+	if translation.Language == "" {
+		return error2.ErrTranslationDataValidationFailed
+	}
+	if translation.Title == "" && translation.Description == "" && translation.Tagline == "" {
+		return error2.ErrTranslationDataValidationFailed
+	}
+	if len(translation.Title) > maxTitleLen || len(translation.Description) > maxDescriptionLen || len(translation.Tagline) > maxTaglineLen {
+		return error2.ErrTranslationDataValidationFailed
+	}
+	return nil
+}
+
+func NewAlternateTitle(title string, language object.LanguageTag) *AlternateTitle {
+	return &AlternateTitle{Title: strings.TrimSpace(title), Language: language}
+}
+
+func ValidateAlternateTitles(titles []*AlternateTitle) error {
+	seen := make(map[string]bool, len(titles))
+	for _, title := range titles {
+		if len(title.Title) == 0 || len(title.Title) > maxTitleLen || seen[title.Title] {
+			return error2.ErrMovieDataValidationFailed
+		}
+		seen[title.Title] = true
+	}
+	return nil
+}
+
+func Localize(items []Localizable, translations map[object.MovieID][]*Translation, locale object.Locale) {
+	for _, item := range items {
+		available := translations[item.LocalizationID()]
+		languages := make([]object.LanguageTag, 0, len(available))
+		for _, translation := range available {
+			languages = append(languages, translation.Language)
+		}
+		language, ok := locale.Match(languages)
+		if !ok {
+			continue
+		}
+		for _, translation := range available {
+			if translation.Language == language {
+				item.ApplyTranslation(translation)
+				break
+			}
+		}
+	}
+}
+
+func (m *Movie) LocalizationID() object.MovieID {
+	return m.id
+}
+
+func (m *Movie) ApplyTranslation(translation *Translation) {
+	m.Language = translation.Language
+	if translation.Title != "" {
+		m.OriginalTitle = m.Title
+		m.Title = translation.Title
+	}
+	if translation.Description != "" {
+		m.Description = translation.Description
+	}
+	if translation.Tagline != "" {
+		m.Tagline = translation.Tagline
+	}
+}
+
+func LocalizableMovies(movies []*Movie) []Localizable {
+	items := make([]Localizable, 0, len(movies))
+	for _, movie := range movies {
+		items = append(items, movie)
+	}
+	return items
+}
+
+func (s *SearchResult) Localizable() []Localizable {
+	return LocalizableMovies(s.Movies())
+}
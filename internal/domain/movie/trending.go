@@ -0,0 +1,29 @@
+package movie
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+const DefaultTrendingSize = 100
+
+type TrendingWeights struct {
+	Rating       float64
+	ListAddition float64
+	Review       float64
+	ReviewLike   float64
+}
+
+var DefaultTrendingWeights = TrendingWeights{Rating: 3, ListAddition: 2, Review: 5, ReviewLike: 1}
+
+type TrendingMovie struct {
+	Movie *Movie
+	Rank  int
+	Score float64
+}
+
+type TrendingService interface {
+	Trending(ctx context.Context, query object.TrendingQuery) ([]*TrendingMovie, error)
+	RefreshTrending(ctx context.Context) (int, error)
+}
@@ -0,0 +1,12 @@
+package error
+
+import "errors"
+
+var (
+	ErrImportFormatIsNotValid    = errors.New("import format is not valid")
+	ErrImportSourceIsMissing     = errors.New("import source is missing")
+	ErrImportRecordIsNotValid    = errors.New("import record is not valid")
+	ErrImportHeaderIsNotValid    = errors.New("import header is not valid")
+	ErrImportOptionsAreInvalid   = errors.New("import options are not valid")
+	ErrImportSourceIsNotSeekable = errors.New("import source must be seekable to resolve names")
+)
@@ -0,0 +1,27 @@
+package object
+
+import (
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/error"
+)
+
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+	FormatIMDb  Format = "imdb"
+)
+
+func NewFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(s)) {
+	case FormatCSV:
+		return FormatCSV, nil
+	case FormatJSONL:
+		return FormatJSONL, nil
+	case FormatIMDb:
+		return FormatIMDb, nil
+	}
+	return "", error2.ErrImportFormatIsNotValid
+}
@@ -0,0 +1,29 @@
+package movieimport
+
+import (
+	"io"
+
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/object"
+)
+
+type Record struct {
+	Line  int
+	Movie *moviedomain.Movie
+	Err   error
+	Skip  bool
+}
+
+type Sources struct {
+	Main       io.Reader
+	Principals io.Reader
+	Names      io.Reader
+}
+
+type Parser interface {
+	Next() (*Record, error)
+}
+
+type ParserFactory interface {
+	NewParser(format object.Format, sources Sources) (Parser, error)
+}
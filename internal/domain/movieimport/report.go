@@ -0,0 +1,31 @@
+package movieimport
+
+const maxReportedErrors = 1000
+
+type LineError struct {
+	Line    int
+	Message string
+}
+
+type Report struct {
+	Created         int
+	Updated         int
+	Skipped         int
+	Failed          int
+	Errors          []LineError
+	ErrorsTruncated bool
+	DryRun          bool
+}
+
+func NewReport(dryRun bool) *Report {
+	return &Report{Errors: make([]LineError, 0), DryRun: dryRun}
+}
+
+func (r *Report) AddError(line int, err error) {
+	r.Failed++
+	if len(r.Errors) >= maxReportedErrors {
+		r.ErrorsTruncated = true
+		return
+	}
+	r.Errors = append(r.Errors, LineError{Line: line, Message: err.Error()})
+}
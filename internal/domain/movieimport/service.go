@@ -0,0 +1,26 @@
+package movieimport
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+const (
+	DefaultBatchSize = 500
+	MaxBatchSize     = 5000
+
+	DefaultMaxUploadBytes = 512 << 20
+)
+
+type Options struct {
+	DryRun       bool
+	BatchSize    int
+	CreateGenres bool
+}
+
+type Service interface {
+	Import(ctx context.Context, format object.Format, sources Sources, options Options) (*Report, error)
+	ImportAs(ctx context.Context, actorID userobject.UserID, format object.Format, sources Sources, options Options) (*Report, error)
+}
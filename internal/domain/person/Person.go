@@ -0,0 +1,38 @@
+package person
+
+import (
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+)
+
+var maxNameLen = 255
+
+type Person struct {
+	id   object.PersonID
+	Name string
+}
+
+func NewPerson(name string) *Person {
+	return &Person{Name: strings.TrimSpace(name)}
+}
+
+func ValidatePerson(person *Person) error {
+	if len(person.Name) == 0 || len(person.Name) > maxNameLen {
+		return error2.ErrPersonDataValidationFailed
+	}
+	return nil
+}
+
+func (p *Person) ID() object.PersonID {
+	return p.id
+}
+
+func (p *Person) SetID(id object.PersonID) error {
+	if p.id.IsEmpty() {
+		p.id = id
+		return nil
+	}
+	return error2.ErrPersonIDAlreadyExists
+}
@@ -0,0 +1,161 @@
+package person
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+)
+
+var maxCharacterLen = 255
+
+type Credit struct {
+	PersonID  object.PersonID
+	Name      string
+	Role      object.CreditRole
+	Character string
+	Position  int
+}
+
+type FilmographyEntry struct {
+	MovieID     movieobject.MovieID
+	Slug        string
+	Title       string
+	ReleaseDate time.Time
+	Role        object.CreditRole
+	Character   string
+}
+
+type Profile struct {
+	Person      *Person
+	Filmography []*FilmographyEntry
+}
+
+func NewCredit(personID object.PersonID, name string, role object.CreditRole, character string) *Credit {
+	return &Credit{PersonID: personID, Name: strings.TrimSpace(name), Role: role, Character: strings.TrimSpace(character)}
+}
+
+func CreditsFromNames(director string, actors []string) []*Credit {
+	credits := make([]*Credit, 0, len(actors)+1)
+	for _, name := range strings.Split(director, ",") {
+		if strings.TrimSpace(name) != "" {
+			credits = append(credits, NewCredit(object.PersonID{}, name, object.CreditRoleDirector, ""))
+		}
+	}
+	for _, name := range actors {
+		credits = append(credits, NewCredit(object.PersonID{}, name, object.CreditRoleActor, ""))
+	}
+	return credits
+}
+
+func ReplaceRoleCredits(credits []*Credit, role object.CreditRole, names []string) []*Credit {
+	existing := make(map[string]*Credit)
+	result := make([]*Credit, 0, len(credits)+len(names))
+	for _, credit := range credits {
+		if credit.Role == role {
+			existing[strings.ToLower(credit.Name)] = credit
+			continue
+		}
+		result = append(result, credit)
+	}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if credit, ok := existing[strings.ToLower(name)]; ok {
+			result = append(result, credit)
+			continue
+		}
+		result = append(result, NewCredit(object.PersonID{}, name, role, ""))
+	}
+	return result
+}
+
+func ValidateCredits(credits []*Credit) error {
+	for _, credit := range credits {
+		if credit.PersonID.IsEmpty() && (len(credit.Name) == 0 || len(credit.Name) > maxNameLen) {
+			return error2.ErrPersonDataValidationFailed
+		}
+		if _, err := object.NewCreditRole(credit.Role.String()); err != nil {
+			return err
+		}
+		if len(credit.Character) > maxCharacterLen {
+			return error2.ErrPersonDataValidationFailed
+		}
+	}
+	return nil
+}
+
+func ResolveCredits(ctx context.Context, repo Repository, movieID movieobject.MovieID, credits []*Credit) ([]*Credit, error) {
+	credited := make(map[string]*Credit)
+	if !movieID.IsEmpty() {
+		existing, err := repo.GetMovieCredits(ctx, movieID)
+		if err != nil {
+			return nil, err
+		}
+		for _, credit := range existing {
+			key := creditNameKey(credit.Role, credit.Name)
+			if _, ok := credited[key]; !ok {
+				credited[key] = credit
+			}
+		}
+	}
+
+	for _, credit := range credits {
+		if !credit.PersonID.IsEmpty() {
+			person, err := repo.GetByID(ctx, credit.PersonID)
+			if err != nil {
+				return nil, err
+			}
+			credit.Name = person.Name
+			continue
+		}
+
+		if previous, ok := credited[creditNameKey(credit.Role, credit.Name)]; ok {
+			credit.PersonID = previous.PersonID
+			credit.Name = previous.Name
+			continue
+		}
+
+		people, err := repo.FindByName(ctx, credit.Name)
+		if err != nil {
+			return nil, err
+		}
+		switch len(people) {
+		case 0:
+			person := NewPerson(credit.Name)
+			if err = repo.Save(ctx, person); err != nil {
+				return nil, err
+			}
+			credit.PersonID = person.ID()
+		case 1:
+			credit.PersonID = people[0].ID()
+			credit.Name = people[0].Name
+		default:
+			return nil, error2.ErrPersonNameIsAmbiguous
+		}
+	}
+	return DeduplicateCredits(credits), nil
+}
+
+func DeduplicateCredits(credits []*Credit) []*Credit {
+	seen := make(map[string]bool, len(credits))
+	positions := make(map[object.CreditRole]int)
+	result := make([]*Credit, 0, len(credits))
+	for _, credit := range credits {
+		key := credit.PersonID.ID() + "\x00" + credit.Role.String() + "\x00" + credit.Character
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		credit.Position = positions[credit.Role]
+		positions[credit.Role]++
+		result = append(result, credit)
+	}
+	return result
+}
+
+func creditNameKey(role object.CreditRole, name string) string {
+	return role.String() + "\x00" + strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,12 @@
+package error
+
+import "errors"
+
+var (
+	ErrPersonIDCreatingIsNotValid = errors.New("person id is not valid")
+	ErrPersonIsNotFound           = errors.New("person not found")
+	ErrPersonDataValidationFailed = errors.New("person data validation failed")
+	ErrPersonIDAlreadyExists      = errors.New("person id already exists")
+	ErrPersonNameIsAmbiguous      = errors.New("several people have this name, person id is required")
+	ErrCreditRoleIsNotValid       = errors.New("credit role is not valid")
+)
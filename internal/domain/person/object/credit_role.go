@@ -0,0 +1,24 @@
+package object
+
+import error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/error"
+
+type CreditRole string
+
+const (
+	CreditRoleDirector CreditRole = "director"
+	CreditRoleActor    CreditRole = "actor"
+	CreditRoleWriter   CreditRole = "writer"
+	CreditRoleComposer CreditRole = "composer"
+)
+
+func NewCreditRole(s string) (CreditRole, error) {
+	switch CreditRole(s) {
+	case CreditRoleDirector, CreditRoleActor, CreditRoleWriter, CreditRoleComposer:
+		return CreditRole(s), nil
+	}
+	return "", error2.ErrCreditRoleIsNotValid
+}
+
+func (c CreditRole) String() string {
+	return string(c)
+}
@@ -0,0 +1,25 @@
+package object
+
+import (
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/error"
+	"github.com/google/uuid"
+)
+
+type PersonID struct {
+	id string
+}
+
+func NewPersonID(s string) (PersonID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return PersonID{}, error2.ErrPersonIDCreatingIsNotValid
+	}
+	return PersonID{id: s}, nil
+}
+
+func (p PersonID) ID() string {
+	return p.id
+}
+
+func (p PersonID) IsEmpty() bool {
+	return p.id == ""
+}
@@ -0,0 +1,18 @@
+package person
+
+import (
+	"context"
+
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+)
+
+type Repository interface {
+	GetByID(ctx context.Context, personID object.PersonID) (*Person, error)
+	FindByName(ctx context.Context, name string) ([]*Person, error)
+	SearchByName(ctx context.Context, name string, limit int) ([]*Person, error)
+	Save(ctx context.Context, person *Person) error
+	GetFilmography(ctx context.Context, personID object.PersonID) ([]*FilmographyEntry, error)
+	GetMovieCredits(ctx context.Context, movieID movieobject.MovieID) ([]*Credit, error)
+	ReplaceMovieCredits(ctx context.Context, movieID movieobject.MovieID, credits []*Credit) error
+}
@@ -0,0 +1,12 @@
+package person
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+)
+
+type Service interface {
+	FindByID(ctx context.Context, personID object.PersonID) (*Profile, error)
+	SearchByName(ctx context.Context, name string) ([]*Person, error)
+}
@@ -0,0 +1,84 @@
+package recommendation
+
+import (
+	"sort"
+
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation/object"
+)
+
+type Neighbor struct {
+	MovieID     movieobject.MovieID
+	SourceID    movieobject.MovieID
+	SourceTitle string
+	UserRating  int
+	Similarity  float64
+}
+
+type candidateScore struct {
+	candidate *Candidate
+	best      float64
+}
+
+func RankCollaborative(neighbors []*Neighbor) []*Candidate {
+	baseline := userBaseline(neighbors)
+	scores := make(map[string]*candidateScore)
+	for _, neighbor := range neighbors {
+		contribution := neighbor.Similarity * (float64(neighbor.UserRating) - baseline)
+		score, ok := scores[neighbor.MovieID.ID()]
+		if !ok {
+			score = &candidateScore{candidate: &Candidate{MovieID: neighbor.MovieID, Source: object.SourceCollaborative}}
+			scores[neighbor.MovieID.ID()] = score
+		}
+		score.candidate.Score += contribution
+		if contribution > score.best {
+			score.best = contribution
+			score.candidate.Reason = Reason{
+				Kind:       object.ReasonKindRated,
+				MovieID:    neighbor.SourceID,
+				MovieTitle: neighbor.SourceTitle,
+				Rating:     neighbor.UserRating,
+			}
+		}
+	}
+
+	candidates := make([]*Candidate, 0, len(scores))
+	for _, score := range scores {
+		if score.candidate.Score > 0 && score.best > 0 {
+			candidates = append(candidates, score.candidate)
+		}
+	}
+	sortCandidates(candidates)
+	return candidates
+}
+
+func userBaseline(neighbors []*Neighbor) float64 {
+	ratings := make(map[string]int)
+	for _, neighbor := range neighbors {
+		ratings[neighbor.SourceID.ID()] = neighbor.UserRating
+	}
+	if len(ratings) == 0 {
+		return 0
+	}
+
+	sum, low, high := 0, moviedomain.MaxRatingValue, moviedomain.MinRatingValue
+	for _, rating := range ratings {
+		sum += rating
+		low = min(low, rating)
+		high = max(high, rating)
+	}
+	if low == high {
+		return float64(moviedomain.MinRatingValue+moviedomain.MaxRatingValue) / 2
+	}
+	return float64(sum) / float64(len(ratings))
+}
+
+func sortCandidates(candidates []*Candidate) {
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].MovieID.ID() < candidates[j].MovieID.ID()
+	})
+}
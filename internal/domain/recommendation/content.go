@@ -0,0 +1,93 @@
+package recommendation
+
+import (
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation/object"
+)
+
+const (
+	DirectorWeight = 3.0
+	CastWeight     = 1.5
+	GenreWeight    = 1.0
+	MaxSharedCast  = 3
+	FavoriteWeight = 0.8
+)
+
+type Seed struct {
+	MovieID  movieobject.MovieID
+	Title    string
+	Rating   int
+	Favorite bool
+}
+
+func (s *Seed) Weight() float64 {
+	if s.Rating > 0 {
+		return float64(s.Rating) / 10
+	}
+	return FavoriteWeight
+}
+
+type ContentMatch struct {
+	MovieID      movieobject.MovieID
+	SeedID       movieobject.MovieID
+	SharedGenres int
+	Directors    []string
+	Actors       []string
+}
+
+func (c *ContentMatch) score() float64 {
+	score := float64(c.SharedGenres) * GenreWeight
+	if len(c.Directors) > 0 {
+		score += DirectorWeight
+	}
+	score += float64(min(len(c.Actors), MaxSharedCast)) * CastWeight
+	return score
+}
+
+func (c *ContentMatch) reason(seed *Seed) Reason {
+	reason := Reason{Kind: object.ReasonKindGenre, MovieID: seed.MovieID, MovieTitle: seed.Title, Rating: seed.Rating}
+	switch {
+	case len(c.Directors) > 0:
+		reason.Kind = object.ReasonKindDirector
+		reason.Detail = c.Directors[0]
+	case len(c.Actors) > 0:
+		reason.Kind = object.ReasonKindCast
+		reason.Detail = c.Actors[0]
+	}
+	return reason
+}
+
+func RankContent(seeds []*Seed, matches []*ContentMatch) []*Candidate {
+	seedsByID := make(map[string]*Seed, len(seeds))
+	for _, seed := range seeds {
+		seedsByID[seed.MovieID.ID()] = seed
+	}
+
+	scores := make(map[string]*candidateScore)
+	for _, match := range matches {
+		seed, ok := seedsByID[match.SeedID.ID()]
+		if !ok {
+			continue
+		}
+		contribution := match.score() * seed.Weight()
+		score, ok := scores[match.MovieID.ID()]
+		if !ok {
+			score = &candidateScore{candidate: &Candidate{MovieID: match.MovieID, Source: object.SourceContent}}
+			scores[match.MovieID.ID()] = score
+		}
+		score.candidate.Score += contribution
+		if contribution > score.best {
+			score.best = contribution
+			score.candidate.Reason = match.reason(seed)
+		}
+	}
+
+	candidates := make([]*Candidate, 0, len(scores))
+	for _, score := range scores {
+		if score.best > 0 {
+			candidates = append(candidates, score.candidate)
+		}
+	}
+	sortCandidates(candidates)
+	return candidates
+}
@@ -0,0 +1,7 @@
+package error
+
+import "errors"
+
+var (
+	ErrRecommendationQueryIsNotValid = errors.New("recommendation query is not valid")
+)
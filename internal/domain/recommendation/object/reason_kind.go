@@ -0,0 +1,15 @@
+package object
+
+type ReasonKind string
+
+const (
+	ReasonKindRated    ReasonKind = "rated"
+	ReasonKindDirector ReasonKind = "director"
+	ReasonKindCast     ReasonKind = "cast"
+	ReasonKindGenre    ReasonKind = "genre"
+	ReasonKindPopular  ReasonKind = "popular"
+)
+
+func (r ReasonKind) String() string {
+	return string(r)
+}
@@ -0,0 +1,29 @@
+package object
+
+import (
+	"net/http"
+	"strconv"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation/error"
+)
+
+const (
+	DefaultRecommendationLimit = 20
+	MaxRecommendationLimit     = 100
+)
+
+type RecommendationQuery struct {
+	Limit int
+}
+
+func GetRecommendationQueryFromReq(r *http.Request) (RecommendationQuery, error) {
+	query := RecommendationQuery{Limit: DefaultRecommendationLimit}
+	if s := r.URL.Query().Get("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 || limit > MaxRecommendationLimit {
+			return RecommendationQuery{}, error2.ErrRecommendationQueryIsNotValid
+		}
+		query.Limit = limit
+	}
+	return query, nil
+}
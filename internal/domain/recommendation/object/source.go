@@ -0,0 +1,13 @@
+package object
+
+type Source string
+
+const (
+	SourceCollaborative Source = "collaborative"
+	SourceContent       Source = "content"
+	SourcePopular       Source = "popular"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
@@ -0,0 +1,112 @@
+package recommendation
+
+import (
+	"fmt"
+
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation/object"
+)
+
+const (
+	DefaultNeighbors   = 50
+	DefaultMinCoRaters = 2
+	DefaultShrinkage   = 10
+	LikedRating        = 7
+	MaxSeeds           = 20
+)
+
+type ModelParams struct {
+	Neighbors   int
+	MinCoRaters int
+	Shrinkage   float64
+}
+
+func NewModelParams(neighbors int, minCoRaters int, shrinkage float64) ModelParams {
+	if neighbors <= 0 {
+		neighbors = DefaultNeighbors
+	}
+	if minCoRaters <= 0 {
+		minCoRaters = DefaultMinCoRaters
+	}
+	if shrinkage <= 0 {
+		shrinkage = DefaultShrinkage
+	}
+	return ModelParams{Neighbors: neighbors, MinCoRaters: minCoRaters, Shrinkage: shrinkage}
+}
+
+type Reason struct {
+	Kind       object.ReasonKind
+	MovieID    movieobject.MovieID
+	MovieTitle string
+	Rating     int
+	Detail     string
+}
+
+func (r Reason) Explanation() string {
+	switch r.Kind {
+	case object.ReasonKindRated:
+		return fmt.Sprintf("because you rated %s %d/10", r.MovieTitle, r.Rating)
+	case object.ReasonKindDirector:
+		return fmt.Sprintf("because you liked %s, also directed by %s", r.MovieTitle, r.Detail)
+	case object.ReasonKindCast:
+		return fmt.Sprintf("because you liked %s, also starring %s", r.MovieTitle, r.Detail)
+	case object.ReasonKindGenre:
+		return fmt.Sprintf("because you liked %s, a movie of the same genre", r.MovieTitle)
+	default:
+		return "popular with other viewers"
+	}
+}
+
+type Candidate struct {
+	MovieID movieobject.MovieID
+	Score   float64
+	Source  object.Source
+	Reason  Reason
+}
+
+type Recommendation struct {
+	Movie  *moviedomain.Movie
+	Rank   int
+	Score  float64
+	Source object.Source
+	Reason Reason
+}
+
+func Merge(limit int, groups ...[]*Candidate) []*Candidate {
+	seen := make(map[string]struct{})
+	merged := make([]*Candidate, 0, limit)
+	for _, group := range groups {
+		for _, candidate := range group {
+			if len(merged) == limit {
+				return merged
+			}
+			if _, ok := seen[candidate.MovieID.ID()]; ok {
+				continue
+			}
+			seen[candidate.MovieID.ID()] = struct{}{}
+			merged = append(merged, candidate)
+		}
+	}
+	return merged
+}
+
+func PopularCandidates(movies []*moviedomain.Movie, exclude []movieobject.MovieID) []*Candidate {
+	excluded := make(map[string]struct{}, len(exclude))
+	for _, id := range exclude {
+		excluded[id.ID()] = struct{}{}
+	}
+	candidates := make([]*Candidate, 0, len(movies))
+	for _, movie := range movies {
+		if _, ok := excluded[movie.ID().ID()]; ok {
+			continue
+		}
+		candidates = append(candidates, &Candidate{
+			MovieID: movie.ID(),
+			Score:   movie.WeightedRating,
+			Source:  object.SourcePopular,
+			Reason:  Reason{Kind: object.ReasonKindPopular},
+		})
+	}
+	return candidates
+}
@@ -0,0 +1,16 @@
+package recommendation
+
+import (
+	"context"
+
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type Repository interface {
+	RebuildSimilarities(ctx context.Context, params ModelParams) (int, error)
+	GetNeighbors(ctx context.Context, userID userobject.UserID) ([]*Neighbor, error)
+	GetSeeds(ctx context.Context, userID userobject.UserID, minRating int, limit int) ([]*Seed, error)
+	GetContentMatches(ctx context.Context, userID userobject.UserID, seedIDs []movieobject.MovieID) ([]*ContentMatch, error)
+	GetRatedMovieIDs(ctx context.Context, userID userobject.UserID) ([]movieobject.MovieID, error)
+}
@@ -0,0 +1,13 @@
+package recommendation
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type Service interface {
+	Recommend(ctx context.Context, userID userobject.UserID, query object.RecommendationQuery) ([]*Recommendation, error)
+	RebuildModel(ctx context.Context) (int, error)
+}
@@ -7,5 +7,5 @@ import (
 )
 
 type Provider interface {
-	ProvideMovieReviews(ctx context.Context, movieInfo object.MovieInfo) (string, error)
+	ProvideMovieReviews(ctx context.Context, ref object.MovieRef) (string, error)
 }
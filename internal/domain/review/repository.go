@@ -3,16 +3,17 @@ package review
 import (
 	"context"
 
-	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
 	object3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type Repository interface {
 	Save(ctx context.Context, review *Review) error
 	Delete(ctx context.Context, review *Review) error
-	GetReviewByUserAndMovie(ctx context.Context, userID object.UserID, movieID object2.MovieID) (*Review, error)
-	GetReviewsByMovie(ctx context.Context, movieID object2.MovieID) ([]*ReviewInfo, error)
-	GetReviewByMovieForUser(ctx context.Context, movieID object2.MovieID, userID object.UserID) ([]*ReviewInfo, error)
+	GetReviewByUserAndTarget(ctx context.Context, userID object.UserID, target titleobject.Target) (*Review, error)
+	GetReviewsByTarget(ctx context.Context, target titleobject.Target) ([]*ReviewInfo, error)
+	GetReviewsByTargetForUser(ctx context.Context, target titleobject.Target, userID object.UserID) ([]*ReviewInfo, error)
 	GetReviewByID(ctx context.Context, reviewID object3.ReviewID) (*Review, error)
+	GetReviewsByIDs(ctx context.Context, reviewIDs []object3.ReviewID) ([]*ReviewInfo, error)
 }
@@ -3,9 +3,9 @@ package review
 import (
 	"time"
 
-	object3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
 	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/error"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
 )
@@ -25,14 +25,14 @@ func ValidateReviewText(text string) error {
 type Review struct {
 	id          object.ReviewID
 	userID      object2.UserID
-	movieID     object3.MovieID
+	target      titleobject.Target
 	text        string
 	writingDate time.Time
 	userRating  int
 }
 
-func NewReview(userID object2.UserID, movieID object3.MovieID) *Review {
-	return &Review{userID: userID, movieID: movieID}
+func NewReview(userID object2.UserID, target titleobject.Target) *Review {
+	return &Review{userID: userID, target: target}
 }
 
 func (r *Review) ID() object.ReviewID {
@@ -51,8 +51,8 @@ func (r *Review) UserID() object2.UserID {
 	return r.userID
 }
 
-func (r *Review) MovieID() object3.MovieID {
-	return r.movieID
+func (r *Review) Target() titleobject.Target {
+	return r.target
 }
 
 func (r *Review) Text() string {
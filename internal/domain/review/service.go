@@ -4,14 +4,16 @@ import (
 	"context"
 	"time"
 
-	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	object3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type Service interface {
-	SaveReview(ctx context.Context, userID object.UserID, movieInfo object2.MovieInfo, text string, writingDate time.Time) error
-	DeleteReview(ctx context.Context, userID object.UserID, info object2.MovieInfo) error
-	GetUserReview(ctx context.Context, userID object.UserID, info object2.MovieInfo) (*Review, error)
-	GetReviewsByMovie(ctx context.Context, info object2.MovieInfo) ([]*ReviewInfo, error)
-	GetReviewsByMovieForUser(ctx context.Context, info object2.MovieInfo, userID object.UserID) ([]*ReviewInfo, error)
+	SaveReview(ctx context.Context, userID object.UserID, ref titleobject.TargetRef, text string, writingDate time.Time) error
+	DeleteReview(ctx context.Context, userID object.UserID, ref titleobject.TargetRef) error
+	GetUserReview(ctx context.Context, userID object.UserID, ref titleobject.TargetRef) (*Review, error)
+	GetReviews(ctx context.Context, ref titleobject.TargetRef) ([]*ReviewInfo, error)
+	GetReviewsForUser(ctx context.Context, ref titleobject.TargetRef, userID object.UserID) ([]*ReviewInfo, error)
+	DeleteReviewByID(ctx context.Context, actorID object.UserID, reviewID object3.ReviewID) error
 }
@@ -0,0 +1,22 @@
+package error
+
+import "errors"
+
+var (
+	ErrSeriesIDCreatingIsNotValid  = errors.New("series id creating is not valid")
+	ErrSeasonIDCreatingIsNotValid  = errors.New("season id creating is not valid")
+	ErrEpisodeIDCreatingIsNotValid = errors.New("episode id creating is not valid")
+	ErrSeriesIsNotFound            = errors.New("series is not found")
+	ErrSeriesAlreadyExists         = errors.New("series already exists")
+	ErrSeriesIDAlreadyExists       = errors.New("series id already exists")
+	ErrSeriesSlugAlreadyExists     = errors.New("series slug already exists")
+	ErrSeriesDataValidationFailed  = errors.New("series data validation failed")
+	ErrSeriesRefIsNotValid         = errors.New("series reference is not valid")
+	ErrSeasonIsNotFound            = errors.New("season is not found")
+	ErrSeasonAlreadyExists         = errors.New("season already exists")
+	ErrSeasonIDAlreadyExists       = errors.New("season id already exists")
+	ErrEpisodeIsNotFound           = errors.New("episode is not found")
+	ErrEpisodeAlreadyExists        = errors.New("episode already exists")
+	ErrEpisodeIDAlreadyExists      = errors.New("episode id already exists")
+	ErrEpisodeCodeIsNotValid       = errors.New("episode code is not valid")
+)
@@ -0,0 +1,52 @@
+package object
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/error"
+)
+
+type EpisodeCode struct {
+	Season  int
+	Episode int
+}
+
+func NewEpisodeCode(season, episode int) (EpisodeCode, error) {
+	if season < 0 || episode <= 0 {
+		return EpisodeCode{}, error2.ErrEpisodeCodeIsNotValid
+	}
+	return EpisodeCode{Season: season, Episode: episode}, nil
+}
+
+func ParseEpisodeCode(s string) (EpisodeCode, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if !strings.HasPrefix(s, "S") {
+		return EpisodeCode{}, error2.ErrEpisodeCodeIsNotValid
+	}
+	seasonPart, episodePart, found := strings.Cut(s[1:], "E")
+	if !found {
+		return EpisodeCode{}, error2.ErrEpisodeCodeIsNotValid
+	}
+	season, err := strconv.Atoi(seasonPart)
+	if err != nil {
+		return EpisodeCode{}, error2.ErrEpisodeCodeIsNotValid
+	}
+	episode, err := strconv.Atoi(episodePart)
+	if err != nil {
+		return EpisodeCode{}, error2.ErrEpisodeCodeIsNotValid
+	}
+	return NewEpisodeCode(season, episode)
+}
+
+func (c EpisodeCode) String() string {
+	return fmt.Sprintf("S%02dE%02d", c.Season, c.Episode)
+}
+
+func (c EpisodeCode) Before(other EpisodeCode) bool {
+	if c.Season != other.Season {
+		return c.Season < other.Season
+	}
+	return c.Episode < other.Episode
+}
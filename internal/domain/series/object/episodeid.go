@@ -0,0 +1,25 @@
+package object
+
+import (
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/error"
+	"github.com/google/uuid"
+)
+
+type EpisodeID struct {
+	id string
+}
+
+func NewEpisodeID(s string) (EpisodeID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return EpisodeID{}, error2.ErrEpisodeIDCreatingIsNotValid
+	}
+	return EpisodeID{id: s}, nil
+}
+
+func (s EpisodeID) ID() string {
+	return s.id
+}
+
+func (s EpisodeID) IsEmpty() bool {
+	return s.id == ""
+}
@@ -0,0 +1,25 @@
+package object
+
+import (
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/error"
+	"github.com/google/uuid"
+)
+
+type SeasonID struct {
+	id string
+}
+
+func NewSeasonID(s string) (SeasonID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return SeasonID{}, error2.ErrSeasonIDCreatingIsNotValid
+	}
+	return SeasonID{id: s}, nil
+}
+
+func (s SeasonID) ID() string {
+	return s.id
+}
+
+func (s SeasonID) IsEmpty() bool {
+	return s.id == ""
+}
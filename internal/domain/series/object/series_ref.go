@@ -0,0 +1,62 @@
+package object
+
+import (
+	"net/http"
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/error"
+)
+
+type SeriesRef struct {
+	id   SeriesID
+	slug string
+}
+
+func NewSeriesRefByID(id SeriesID) SeriesRef {
+	return SeriesRef{id: id}
+}
+
+func NewSeriesRefBySlug(slug string) SeriesRef {
+	return SeriesRef{slug: strings.TrimSpace(slug)}
+}
+
+func NewSeriesRef(id string, slug string) (SeriesRef, error) {
+	if id != "" {
+		seriesID, err := NewSeriesID(id)
+		if err != nil {
+			return SeriesRef{}, err
+		}
+		return NewSeriesRefByID(seriesID), nil
+	}
+	if strings.TrimSpace(slug) != "" {
+		return NewSeriesRefBySlug(slug), nil
+	}
+	return SeriesRef{}, error2.ErrSeriesRefIsNotValid
+}
+
+func GetSeriesRefFromReq(r *http.Request) (SeriesRef, error) {
+	if id := r.PathValue("id"); id != "" {
+		return NewSeriesRef(id, "")
+	}
+	if slug := r.PathValue("slug"); slug != "" {
+		return NewSeriesRef("", slug)
+	}
+	query := r.URL.Query()
+	return NewSeriesRef(query.Get("series_id"), query.Get("series_slug"))
+}
+
+func (r SeriesRef) ID() SeriesID {
+	return r.id
+}
+
+func (r SeriesRef) Slug() string {
+	return r.slug
+}
+
+func (r SeriesRef) HasID() bool {
+	return !r.id.IsEmpty()
+}
+
+func (r SeriesRef) IsEmpty() bool {
+	return r.id.IsEmpty() && r.slug == ""
+}
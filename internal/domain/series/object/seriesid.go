@@ -0,0 +1,25 @@
+package object
+
+import (
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/error"
+	"github.com/google/uuid"
+)
+
+type SeriesID struct {
+	id string
+}
+
+func NewSeriesID(s string) (SeriesID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return SeriesID{}, error2.ErrSeriesIDCreatingIsNotValid
+	}
+	return SeriesID{id: s}, nil
+}
+
+func (s SeriesID) ID() string {
+	return s.id
+}
+
+func (s SeriesID) IsEmpty() bool {
+	return s.id == ""
+}
@@ -0,0 +1,44 @@
+package series
+
+import (
+	"context"
+
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+)
+
+func FindByRef(ctx context.Context, repo Repository, ref object.SeriesRef) (*Series, error) {
+	if ref.HasID() {
+		return repo.GetByID(ctx, ref.ID())
+	}
+	return repo.GetBySlug(ctx, ref.Slug())
+}
+
+func FindIDByRef(ctx context.Context, repo Repository, ref object.SeriesRef) (object.SeriesID, error) {
+	if ref.HasID() {
+		exists, err := repo.ExistsByID(ctx, ref.ID())
+		if err != nil {
+			return object.SeriesID{}, err
+		}
+		if !exists {
+			return object.SeriesID{}, error2.ErrSeriesIsNotFound
+		}
+		return ref.ID(), nil
+	}
+	return repo.GetIDBySlug(ctx, ref.Slug())
+}
+
+func GenerateUniqueSlug(ctx context.Context, repo Repository, series *Series) (string, error) {
+	base := movieobject.NewSlug(series.Title, series.StartYear)
+	for n := 1; ; n++ {
+		candidate := movieobject.SlugWithSuffix(base, n)
+		exists, err := repo.ExistsBySlug(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+}
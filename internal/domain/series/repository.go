@@ -0,0 +1,28 @@
+package series
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+)
+
+type Repository interface {
+	List(ctx context.Context, limit int, offset int) ([]*Series, error)
+	Count(ctx context.Context) (int, error)
+	GetByID(ctx context.Context, seriesID object.SeriesID) (*Series, error)
+	GetBySlug(ctx context.Context, slug string) (*Series, error)
+	GetIDBySlug(ctx context.Context, slug string) (object.SeriesID, error)
+	ExistsByID(ctx context.Context, seriesID object.SeriesID) (bool, error)
+	ExistsBySlug(ctx context.Context, slug string) (bool, error)
+	Save(ctx context.Context, series *Series) error
+	Update(ctx context.Context, series *Series) error
+	Delete(ctx context.Context, seriesID object.SeriesID) error
+	GetSeasons(ctx context.Context, seriesID object.SeriesID) ([]*Season, error)
+	GetSeason(ctx context.Context, seriesID object.SeriesID, number int) (*Season, error)
+	SaveSeason(ctx context.Context, season *Season) error
+	DeleteSeason(ctx context.Context, seriesID object.SeriesID, number int) error
+	GetEpisodes(ctx context.Context, seasonID object.SeasonID) ([]*Episode, error)
+	GetEpisode(ctx context.Context, seriesID object.SeriesID, code object.EpisodeCode) (*Episode, error)
+	SaveEpisode(ctx context.Context, episode *Episode) error
+	DeleteEpisode(ctx context.Context, seriesID object.SeriesID, code object.EpisodeCode) error
+}
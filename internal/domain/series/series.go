@@ -0,0 +1,169 @@
+package series
+
+import (
+	"strings"
+	"time"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+)
+
+var (
+	maxTitleLen       = 255
+	maxDescriptionLen = 5000
+	maxRuntime        = 1000
+	minYear           = 1900
+	maxYear           = 3000
+)
+
+func ValidateSeries(series *Series) error {
+	if len(series.Title) == 0 || len(series.Title) > maxTitleLen {
+		return error2.ErrSeriesDataValidationFailed
+	}
+	if len(series.Description) > maxDescriptionLen {
+		return error2.ErrSeriesDataValidationFailed
+	}
+	if series.StartYear < minYear || series.StartYear > maxYear {
+		return error2.ErrSeriesDataValidationFailed
+	}
+	if series.EndYear != 0 && (series.EndYear < series.StartYear || series.EndYear > maxYear) {
+		return error2.ErrSeriesDataValidationFailed
+	}
+	return nil
+}
+
+func ValidateSeason(season *Season) error {
+	if season.Number < 0 || len(season.Title) > maxTitleLen {
+		return error2.ErrSeriesDataValidationFailed
+	}
+	return nil
+}
+
+func ValidateEpisode(episode *Episode) error {
+	if episode.Number <= 0 || len(episode.Title) > maxTitleLen || len(episode.Description) > maxDescriptionLen {
+		return error2.ErrSeriesDataValidationFailed
+	}
+	if episode.RuntimeMinutes < 0 || episode.RuntimeMinutes > maxRuntime {
+		return error2.ErrSeriesDataValidationFailed
+	}
+	return nil
+}
+
+type Series struct {
+	id           object.SeriesID
+	slug         string
+	Title        string
+	Description  string
+	StartYear    int
+	EndYear      int
+	Rating       float64
+	RatingCount  int
+	SeasonCount  int
+	EpisodeCount int
+}
+
+func NewSeries(title, description string, startYear, endYear int) *Series {
+	return &Series{
+		Title:       strings.TrimSpace(title),
+		Description: strings.TrimSpace(description),
+		StartYear:   startYear,
+		EndYear:     endYear,
+	}
+}
+
+func (s *Series) ID() object.SeriesID {
+	return s.id
+}
+
+func (s *Series) SetID(id object.SeriesID) error {
+	if s.id.IsEmpty() {
+		s.id = id
+		return nil
+	}
+	return error2.ErrSeriesIDAlreadyExists
+}
+
+func (s *Series) Slug() string {
+	return s.slug
+}
+
+func (s *Series) SetSlug(slug string) error {
+	if s.slug == "" {
+		s.slug = slug
+		return nil
+	}
+	return error2.ErrSeriesSlugAlreadyExists
+}
+
+type Season struct {
+	id          object.SeasonID
+	SeriesID    object.SeriesID
+	Number      int
+	Title       string
+	AirDate     time.Time
+	Rating      float64
+	RatingCount int
+	Episodes    []*Episode
+}
+
+func NewSeason(number int, title string, airDate time.Time) *Season {
+	return &Season{Number: number, Title: strings.TrimSpace(title), AirDate: airDate, Episodes: make([]*Episode, 0)}
+}
+
+func (s *Season) ID() object.SeasonID {
+	return s.id
+}
+
+func (s *Season) SetID(id object.SeasonID) error {
+	if s.id.IsEmpty() {
+		s.id = id
+		return nil
+	}
+	return error2.ErrSeasonIDAlreadyExists
+}
+
+type Episode struct {
+	id             object.EpisodeID
+	SeasonID       object.SeasonID
+	SeriesID       object.SeriesID
+	SeasonNumber   int
+	Number         int
+	Title          string
+	Description    string
+	AirDate        time.Time
+	RuntimeMinutes int
+	Rating         float64
+	RatingCount    int
+}
+
+func NewEpisode(number int, title, description string, airDate time.Time, runtimeMinutes int) *Episode {
+	return &Episode{
+		Number:         number,
+		Title:          strings.TrimSpace(title),
+		Description:    strings.TrimSpace(description),
+		AirDate:        airDate,
+		RuntimeMinutes: runtimeMinutes,
+	}
+}
+
+func (e *Episode) ID() object.EpisodeID {
+	return e.id
+}
+
+func (e *Episode) SetID(id object.EpisodeID) error {
+	if e.id.IsEmpty() {
+		e.id = id
+		return nil
+	}
+	return error2.ErrEpisodeIDAlreadyExists
+}
+
+func (e *Episode) AttachTo(season *Season) {
+	e.SeasonID = season.ID()
+	e.SeriesID = season.SeriesID
+	e.SeasonNumber = season.Number
+}
+
+func (e *Episode) Code() object.EpisodeCode {
+	return object.EpisodeCode{Season: e.SeasonNumber, Episode: e.Number}
+}
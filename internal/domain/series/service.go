@@ -0,0 +1,28 @@
+package series
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type SeriesPage struct {
+	Series []*Series
+	Total  int
+}
+
+type Service interface {
+	List(ctx context.Context, limit int, offset int) (*SeriesPage, error)
+	FindByRef(ctx context.Context, ref object.SeriesRef) (*Series, error)
+	FindSeasons(ctx context.Context, ref object.SeriesRef) ([]*Season, error)
+	FindSeason(ctx context.Context, ref object.SeriesRef, number int) (*Season, error)
+	FindEpisode(ctx context.Context, ref object.SeriesRef, code object.EpisodeCode) (*Episode, error)
+	CreateSeries(ctx context.Context, actorID userobject.UserID, series *Series) (*Series, error)
+	UpdateSeries(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef, series *Series) (*Series, error)
+	DeleteSeries(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef) error
+	SaveSeason(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef, season *Season) (*Season, error)
+	DeleteSeason(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef, number int) error
+	SaveEpisode(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef, seasonNumber int, episode *Episode) (*Episode, error)
+	DeleteEpisode(ctx context.Context, actorID userobject.UserID, ref object.SeriesRef, code object.EpisodeCode) error
+}
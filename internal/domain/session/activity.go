@@ -0,0 +1,8 @@
+package session
+
+import "time"
+
+type ActivityTracker interface {
+	Touch(sessionID string, at time.Time)
+	Drain() map[string]time.Time
+}
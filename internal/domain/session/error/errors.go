@@ -0,0 +1,12 @@
+package error
+
+import "errors"
+
+var (
+	ErrSessionIDIsNotValid     = errors.New("session id is not valid")
+	ErrSessionIsNotFound       = errors.New("session not found")
+	ErrRefreshTokenIsNotValid  = errors.New("refresh token is not valid")
+	ErrRefreshTokenReused      = errors.New("refresh token reuse detected")
+	ErrFailedToGenerateSession = errors.New("failed to generate session")
+	ErrTrustedProxyIsNotValid  = errors.New("trusted proxy is not a valid ip or cidr")
+)
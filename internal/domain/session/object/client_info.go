@@ -0,0 +1,33 @@
+package object
+
+import "strings"
+
+const (
+	MaxUserAgentLength = 512
+	MaxIPLength        = 45
+)
+
+type ClientInfo struct {
+	userAgent string
+	ip        string
+}
+
+func NewClientInfo(userAgent string, ip string) ClientInfo {
+	return ClientInfo{userAgent: truncate(strings.TrimSpace(userAgent), MaxUserAgentLength), ip: truncate(strings.TrimSpace(ip), MaxIPLength)}
+}
+
+func (c ClientInfo) UserAgent() string {
+	return c.userAgent
+}
+
+func (c ClientInfo) IP() string {
+	return c.ip
+}
+
+func truncate(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) > limit {
+		return string(runes[:limit])
+	}
+	return s
+}
@@ -0,0 +1,43 @@
+package object
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/session/error"
+)
+
+const refreshTokenBytes = 32
+
+type RefreshToken struct {
+	token string
+}
+
+func GenerateRefreshToken() (RefreshToken, error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return RefreshToken{}, error2.ErrFailedToGenerateSession
+	}
+	return RefreshToken{token: base64.RawURLEncoding.EncodeToString(buf)}, nil
+}
+
+func NewRefreshToken(s string) (RefreshToken, error) {
+	s = strings.TrimSpace(s)
+	decoded, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(decoded) != refreshTokenBytes {
+		return RefreshToken{}, error2.ErrRefreshTokenIsNotValid
+	}
+	return RefreshToken{token: s}, nil
+}
+
+func (r RefreshToken) Token() string {
+	return r.token
+}
+
+func (r RefreshToken) Hash() string {
+	sum := sha256.Sum256([]byte(r.token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,29 @@
+package object
+
+import (
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/session/error"
+	"github.com/google/uuid"
+)
+
+type SessionID struct {
+	id string
+}
+
+func NewSessionID(s string) (SessionID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return SessionID{}, error2.ErrSessionIDIsNotValid
+	}
+	return SessionID{id: s}, nil
+}
+
+func GenerateSessionID() SessionID {
+	return SessionID{id: uuid.NewString()}
+}
+
+func (s SessionID) ID() string {
+	return s.id
+}
+
+func (s SessionID) IsEmpty() bool {
+	return s.id == ""
+}
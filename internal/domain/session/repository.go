@@ -0,0 +1,24 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/session/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type Repository interface {
+	Save(ctx context.Context, session *Session) (*Session, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*Session, error)
+	MarkRotated(ctx context.Context, id string) (bool, error)
+	RevokeFamily(ctx context.Context, familyID object.SessionID) error
+	RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error
+	GetActiveByUserID(ctx context.Context, userID userobject.UserID) ([]*Session, error)
+	RevokeUserFamily(ctx context.Context, userID userobject.UserID, familyID object.SessionID) (bool, error)
+	RevokeOtherFamilies(ctx context.Context, userID userobject.UserID, keep object.SessionID) ([]object.SessionID, error)
+	TouchFamilies(ctx context.Context, seen map[string]time.Time) error
+	GetRevocations(ctx context.Context, window time.Duration) (*Revocations, error)
+	IsRevoked(ctx context.Context, tokenID string, familyID string) (bool, error)
+	DeleteExpired(ctx context.Context) (int64, error)
+}
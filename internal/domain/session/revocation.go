@@ -0,0 +1,22 @@
+package session
+
+import (
+	"context"
+	"time"
+)
+
+type Revocations struct {
+	Tokens   map[string]time.Time
+	Sessions map[string]time.Time
+}
+
+func NewRevocations() *Revocations {
+	return &Revocations{Tokens: make(map[string]time.Time), Sessions: make(map[string]time.Time)}
+}
+
+type RevocationList interface {
+	IsRevoked(ctx context.Context, tokenID string, sessionID string) (bool, error)
+	RevokeToken(tokenID string, until time.Time)
+	RevokeSession(sessionID string, until time.Time)
+	Sync(revocations *Revocations) int
+}
@@ -0,0 +1,21 @@
+package session
+
+import (
+	"context"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/session/object"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type Service interface {
+	Start(ctx context.Context, user *userdomain.User, client object.ClientInfo) (*userobject.AuthResponse, error)
+	Refresh(ctx context.Context, token object.RefreshToken, client object.ClientInfo) (*userobject.AuthResponse, error)
+	Logout(ctx context.Context, claims userobject.TokenClaims) error
+	ListSessions(ctx context.Context, userID userobject.UserID) ([]*Session, error)
+	RevokeSession(ctx context.Context, userID userobject.UserID, sessionID object.SessionID) error
+	RevokeOtherSessions(ctx context.Context, claims userobject.TokenClaims) (int, error)
+	RevokeAllSessions(ctx context.Context, userID userobject.UserID) (int, error)
+	SyncRevocations(ctx context.Context) (int, error)
+	FlushActivity(ctx context.Context) (int, error)
+}
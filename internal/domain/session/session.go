@@ -0,0 +1,49 @@
+package session
+
+import (
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/session/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+const (
+	DefaultAccessTokenTTL  = 15 * time.Minute
+	DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+type Session struct {
+	ID         string
+	FamilyID   object.SessionID
+	UserID     userobject.UserID
+	TokenHash  string
+	UserAgent  string
+	IP         string
+	StartedAt  time.Time
+	LastSeenAt time.Time
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	RotatedAt  *time.Time
+	RevokedAt  *time.Time
+}
+
+func NewSession(familyID object.SessionID, userID userobject.UserID, token object.RefreshToken, ttl time.Duration, client object.ClientInfo, startedAt time.Time) *Session {
+	now := time.Now()
+	if startedAt.IsZero() {
+		startedAt = now
+	}
+	return &Session{FamilyID: familyID, UserID: userID, TokenHash: token.Hash(), UserAgent: client.UserAgent(), IP: client.IP(), StartedAt: startedAt,
+		LastSeenAt: now, ExpiresAt: now.Add(ttl)}
+}
+
+func (s *Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
+
+func (s *Session) IsRotated() bool {
+	return s.RotatedAt != nil
+}
+
+func (s *Session) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
@@ -0,0 +1,57 @@
+package stats
+
+import (
+	"context"
+
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	reviewobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
+)
+
+type MovieStatsDelta struct {
+	RatingSum      int
+	RatingCount    int
+	ReviewCount    int
+	FavoriteCount  int
+	WatchlistCount int
+}
+
+func (d MovieStatsDelta) Sub(other MovieStatsDelta) MovieStatsDelta {
+	return MovieStatsDelta{
+		RatingSum:      d.RatingSum - other.RatingSum,
+		RatingCount:    d.RatingCount - other.RatingCount,
+		ReviewCount:    d.ReviewCount - other.ReviewCount,
+		FavoriteCount:  d.FavoriteCount - other.FavoriteCount,
+		WatchlistCount: d.WatchlistCount - other.WatchlistCount,
+	}
+}
+
+func (d MovieStatsDelta) IsZero() bool {
+	return d == MovieStatsDelta{}
+}
+
+type ReconcileReport struct {
+	Movies  int
+	Reviews int
+}
+
+type Repository interface {
+	ApplyMovieDelta(ctx context.Context, movieID movieobject.MovieID, delta MovieStatsDelta) error
+	ApplyReviewDelta(ctx context.Context, reviewID reviewobject.ReviewID, likes int) error
+	Reconcile(ctx context.Context) (*ReconcileReport, error)
+}
+
+type Service interface {
+	Reconcile(ctx context.Context) (*ReconcileReport, error)
+}
+
+func ApplyTargetDelta(ctx context.Context, repo Repository, target titleobject.Target, delta MovieStatsDelta) error {
+	if !target.IsMovie() || delta.IsZero() {
+		return nil
+	}
+	movieID, err := movieobject.NewMovieID(target.ID())
+	if err != nil {
+		return err
+	}
+	return repo.ApplyMovieDelta(ctx, movieID, delta)
+}
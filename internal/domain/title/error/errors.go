@@ -0,0 +1,8 @@
+package error
+
+import "errors"
+
+var (
+	ErrTargetTypeIsNotValid = errors.New("target type is not valid")
+	ErrTargetIsNotValid     = errors.New("target is not valid")
+)
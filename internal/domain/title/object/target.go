@@ -0,0 +1,73 @@
+package object
+
+import (
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	seriesobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/error"
+	"github.com/google/uuid"
+)
+
+type TargetType string
+
+const (
+	TargetTypeMovie   TargetType = "movie"
+	TargetTypeSeries  TargetType = "series"
+	TargetTypeSeason  TargetType = "season"
+	TargetTypeEpisode TargetType = "episode"
+)
+
+func NewTargetType(s string) (TargetType, error) {
+	switch TargetType(s) {
+	case TargetTypeMovie, TargetTypeSeries, TargetTypeSeason, TargetTypeEpisode:
+		return TargetType(s), nil
+	}
+	return "", error2.ErrTargetTypeIsNotValid
+}
+
+type Target struct {
+	targetType TargetType
+	id         string
+}
+
+func NewTarget(targetType string, id string) (Target, error) {
+	validType, err := NewTargetType(targetType)
+	if err != nil {
+		return Target{}, err
+	}
+	if _, err = uuid.Parse(id); err != nil {
+		return Target{}, error2.ErrTargetIsNotValid
+	}
+	return Target{targetType: validType, id: id}, nil
+}
+
+func NewMovieTarget(movieID movieobject.MovieID) Target {
+	return Target{targetType: TargetTypeMovie, id: movieID.ID()}
+}
+
+func NewSeriesTarget(seriesID seriesobject.SeriesID) Target {
+	return Target{targetType: TargetTypeSeries, id: seriesID.ID()}
+}
+
+func NewSeasonTarget(seasonID seriesobject.SeasonID) Target {
+	return Target{targetType: TargetTypeSeason, id: seasonID.ID()}
+}
+
+func NewEpisodeTarget(episodeID seriesobject.EpisodeID) Target {
+	return Target{targetType: TargetTypeEpisode, id: episodeID.ID()}
+}
+
+func (t Target) Type() TargetType {
+	return t.targetType
+}
+
+func (t Target) ID() string {
+	return t.id
+}
+
+func (t Target) IsMovie() bool {
+	return t.targetType == TargetTypeMovie
+}
+
+func (t Target) IsEmpty() bool {
+	return t.id == ""
+}
@@ -0,0 +1,115 @@
+package object
+
+import (
+	"net/http"
+	"strconv"
+
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	seriesobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/error"
+)
+
+type SeriesTargetInfo struct {
+	SeriesID   string `json:"series_id"`
+	SeriesSlug string `json:"series_slug"`
+	Season     *int   `json:"season"`
+	Episode    *int   `json:"episode"`
+}
+
+func (i SeriesTargetInfo) IsEmpty() bool {
+	return i.SeriesID == "" && i.SeriesSlug == ""
+}
+
+type TargetRef struct {
+	movie   movieobject.MovieRef
+	series  seriesobject.SeriesRef
+	season  *int
+	episode *int
+}
+
+func NewMovieTargetRef(ref movieobject.MovieRef) TargetRef {
+	return TargetRef{movie: ref}
+}
+
+func NewSeriesTargetRef(ref seriesobject.SeriesRef, season *int, episode *int) (TargetRef, error) {
+	if ref.IsEmpty() || (episode != nil && season == nil) {
+		return TargetRef{}, error2.ErrTargetIsNotValid
+	}
+	if (season != nil && *season < 0) || (episode != nil && *episode <= 0) {
+		return TargetRef{}, error2.ErrTargetIsNotValid
+	}
+	return TargetRef{series: ref, season: season, episode: episode}, nil
+}
+
+func NewTargetRef(movieID string, movieSlug string, movieInfo movieobject.MovieInfo, seriesInfo SeriesTargetInfo) (TargetRef, error) {
+	if seriesInfo.IsEmpty() {
+		movieRef, err := movieobject.NewMovieRef(movieID, movieSlug, movieInfo)
+		if err != nil {
+			return TargetRef{}, err
+		}
+		return NewMovieTargetRef(movieRef), nil
+	}
+	seriesRef, err := seriesobject.NewSeriesRef(seriesInfo.SeriesID, seriesInfo.SeriesSlug)
+	if err != nil {
+		return TargetRef{}, err
+	}
+	return NewSeriesTargetRef(seriesRef, seriesInfo.Season, seriesInfo.Episode)
+}
+
+func GetTargetRefFromReq(r *http.Request) (TargetRef, error) {
+	query := r.URL.Query()
+	if query.Get("series_id") == "" && query.Get("series_slug") == "" {
+		movieRef, err := movieobject.GetMovieRefFromReq(r)
+		if err != nil {
+			return TargetRef{}, err
+		}
+		return NewMovieTargetRef(movieRef), nil
+	}
+
+	seriesInfo := SeriesTargetInfo{SeriesID: query.Get("series_id"), SeriesSlug: query.Get("series_slug")}
+	var err error
+	if seriesInfo.Season, err = parseOptionalNumber(query.Get("season")); err != nil {
+		return TargetRef{}, err
+	}
+	if seriesInfo.Episode, err = parseOptionalNumber(query.Get("episode")); err != nil {
+		return TargetRef{}, err
+	}
+	return NewTargetRef("", "", movieobject.MovieInfo{}, seriesInfo)
+}
+
+func (r TargetRef) IsSeries() bool {
+	return !r.series.IsEmpty()
+}
+
+func (r TargetRef) Movie() movieobject.MovieRef {
+	return r.movie
+}
+
+func (r TargetRef) Series() seriesobject.SeriesRef {
+	return r.series
+}
+
+func (r TargetRef) Season() (int, bool) {
+	if r.season == nil {
+		return 0, false
+	}
+	return *r.season, true
+}
+
+func (r TargetRef) Episode() (int, bool) {
+	if r.episode == nil {
+		return 0, false
+	}
+	return *r.episode, true
+}
+
+func parseOptionalNumber(s string) (*int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	value, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, error2.ErrTargetIsNotValid
+	}
+	return &value, nil
+}
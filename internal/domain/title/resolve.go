@@ -0,0 +1,42 @@
+package title
+
+import (
+	"context"
+
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	seriesdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/series"
+	seriesobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
+)
+
+func ResolveTarget(ctx context.Context, movieRepo moviedomain.Repository, seriesRepo seriesdomain.Repository, ref object.TargetRef) (object.Target, error) {
+	if !ref.IsSeries() {
+		movieID, err := moviedomain.FindIDByRef(ctx, movieRepo, ref.Movie())
+		if err != nil {
+			return object.Target{}, err
+		}
+		return object.NewMovieTarget(movieID), nil
+	}
+
+	seriesID, err := seriesdomain.FindIDByRef(ctx, seriesRepo, ref.Series())
+	if err != nil {
+		return object.Target{}, err
+	}
+	season, hasSeason := ref.Season()
+	if !hasSeason {
+		return object.NewSeriesTarget(seriesID), nil
+	}
+	episode, hasEpisode := ref.Episode()
+	if !hasEpisode {
+		found, err := seriesRepo.GetSeason(ctx, seriesID, season)
+		if err != nil {
+			return object.Target{}, err
+		}
+		return object.NewSeasonTarget(found.ID()), nil
+	}
+	found, err := seriesRepo.GetEpisode(ctx, seriesID, seriesobject.EpisodeCode{Season: season, Episode: episode})
+	if err != nil {
+		return object.Target{}, err
+	}
+	return object.NewEpisodeTarget(found.ID()), nil
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+const (
+	DefaultVerificationTTL            = 48 * time.Hour
+	DefaultVerificationResendInterval = time.Minute
+)
+
+type VerificationSigner interface {
+	Sign(claims object.EmailVerificationClaims) string
+	Verify(token string, now time.Time) (object.EmailVerificationClaims, error)
+}
+
+func CheckEmailVerified(ctx context.Context, repo Repository, userID object.UserID) error {
+	user, err := repo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.EmailVerified() {
+		return usererror.ErrEmailIsNotVerified
+	}
+	return nil
+}
@@ -3,14 +3,28 @@ package error
 import "errors"
 
 var (
-	ErrUserIDCreatingIsNotValid     = errors.New("userID is not valid")
-	ErrUserEmailAlreadyExists       = errors.New("user email already exists")
-	ErrUserIsNotFound               = errors.New("user not found")
-	ErrInvalidPassword              = errors.New("invalid password")
-	ErrFailedToRegisterUser         = errors.New("failed to register user")
-	ErrFailedToAuthorizeUser        = errors.New("failed to authorize user")
-	ErrUserIDAlreadyExists          = errors.New("user id already exists")
-	ErrUserNameValidationFailed     = errors.New("user name validation failed")
-	ErrUserEmailValidationFailed    = errors.New("user email validation failed")
-	ErrUserPasswordValidationFailed = errors.New("user password validation failed")
+	ErrUserIDCreatingIsNotValid       = errors.New("userID is not valid")
+	ErrUserEmailAlreadyExists         = errors.New("user email already exists")
+	ErrUserIsNotFound                 = errors.New("user not found")
+	ErrInvalidPassword                = errors.New("invalid password")
+	ErrFailedToRegisterUser           = errors.New("failed to register user")
+	ErrFailedToAuthorizeUser          = errors.New("failed to authorize user")
+	ErrUserIDAlreadyExists            = errors.New("user id already exists")
+	ErrUserNameValidationFailed       = errors.New("user name validation failed")
+	ErrUserEmailValidationFailed      = errors.New("user email validation failed")
+	ErrUserPasswordValidationFailed   = errors.New("user password validation failed")
+	ErrUserRoleIsNotValid             = errors.New("user role is not valid")
+	ErrPermissionDenied               = errors.New("permission denied")
+	ErrCannotChangeOwnRole            = errors.New("user cannot change own role")
+	ErrSigningAlgorithmIsNotValid     = errors.New("token signing algorithm is not supported")
+	ErrSigningKeyIsNotValid           = errors.New("token signing key is not valid")
+	ErrSigningKeyIsNotConfigured      = errors.New("token signing key is not configured")
+	ErrProfileUpdateIsEmpty           = errors.New("profile update is empty")
+	ErrPasswordResetTokenIsNotValid   = errors.New("password reset token is not valid")
+	ErrVerificationTokenIsNotValid    = errors.New("email verification token is not valid")
+	ErrVerificationKeyIsNotConfigured = errors.New("email verification key is not configured")
+	ErrVerificationKeyIsNotValid      = errors.New("email verification key must be at least 32 bytes and differ from secret_key")
+	ErrEmailIsNotVerified             = errors.New("user email is not verified")
+	ErrEmailAlreadyVerified           = errors.New("user email is already verified")
+	ErrVerificationResendThrottled    = errors.New("email verification was sent too recently")
 )
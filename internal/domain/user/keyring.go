@@ -0,0 +1,63 @@
+package user
+
+import (
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type KeyRing struct {
+	signing object.SigningKey
+	keys    map[string]object.SigningKey
+	order   []string
+}
+
+func NewKeyRing(signingKeyID string, keys ...object.SigningKey) (*KeyRing, error) {
+	ring := &KeyRing{keys: make(map[string]object.SigningKey, len(keys)), order: make([]string, 0, len(keys))}
+	for _, key := range keys {
+		if err := object.ValidateAlgorithm(key.Algorithm); err != nil {
+			return nil, err
+		}
+		if key.Public == nil {
+			return nil, usererror.ErrSigningKeyIsNotValid
+		}
+		if _, ok := ring.keys[key.ID]; ok {
+			return nil, usererror.ErrSigningKeyIsNotValid
+		}
+		if len(keys) > 1 && key.ID == "" {
+			return nil, usererror.ErrSigningKeyIsNotValid
+		}
+		ring.keys[key.ID] = key
+		ring.order = append(ring.order, key.ID)
+	}
+
+	for _, id := range ring.order {
+		key := ring.keys[id]
+		if key.CanSign() && (signingKeyID == "" || signingKeyID == id) {
+			ring.signing = key
+			return ring, nil
+		}
+	}
+	return nil, usererror.ErrSigningKeyIsNotConfigured
+}
+
+func (k *KeyRing) Signing() object.SigningKey {
+	return k.signing
+}
+
+func (k *KeyRing) Lookup(id string) (object.SigningKey, bool) {
+	if id == "" {
+		return k.signing, true
+	}
+	key, ok := k.keys[id]
+	return key, ok
+}
+
+func (k *KeyRing) PublicKeys() []object.SigningKey {
+	keys := make([]object.SigningKey, 0, len(k.order))
+	for _, id := range k.order {
+		if key := k.keys[id]; key.IsAsymmetric() {
+			keys = append(keys, key.PublicOnly())
+		}
+	}
+	return keys
+}
@@ -0,0 +1,9 @@
+package object
+
+import "time"
+
+type AccessToken struct {
+	Token     string
+	ID        string
+	ExpiresAt time.Time
+}
@@ -1,7 +1,12 @@
 package object
 
+import "time"
+
 type AuthResponse struct {
-	Username string
-	Email    string
-	Token    string
+	Username     string
+	Email        string
+	Role         Role
+	Token        string
+	ExpiresAt    time.Time
+	RefreshToken string
 }
@@ -0,0 +1,29 @@
+package object
+
+import "time"
+
+type EmailVerificationClaims struct {
+	userID    UserID
+	email     string
+	expiresAt time.Time
+}
+
+func NewEmailVerificationClaims(userID UserID, email string, expiresAt time.Time) EmailVerificationClaims {
+	return EmailVerificationClaims{userID: userID, email: email, expiresAt: expiresAt}
+}
+
+func (c EmailVerificationClaims) UserID() UserID {
+	return c.userID
+}
+
+func (c EmailVerificationClaims) Email() string {
+	return c.email
+}
+
+func (c EmailVerificationClaims) ExpiresAt() time.Time {
+	return c.expiresAt
+}
+
+func (c EmailVerificationClaims) IsExpired(now time.Time) bool {
+	return !now.Before(c.expiresAt)
+}
@@ -0,0 +1,18 @@
+package object
+
+type PasswordChangeData struct {
+	currentPassword string
+	newPassword     string
+}
+
+func NewPasswordChangeData(currentPassword string, newPassword string) PasswordChangeData {
+	return PasswordChangeData{currentPassword: currentPassword, newPassword: newPassword}
+}
+
+func (p PasswordChangeData) CurrentPassword() string {
+	return p.currentPassword
+}
+
+func (p PasswordChangeData) NewPassword() string {
+	return p.newPassword
+}
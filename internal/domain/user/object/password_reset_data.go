@@ -0,0 +1,18 @@
+package object
+
+type PasswordResetData struct {
+	token       PasswordResetToken
+	newPassword string
+}
+
+func NewPasswordResetData(token PasswordResetToken, newPassword string) PasswordResetData {
+	return PasswordResetData{token: token, newPassword: newPassword}
+}
+
+func (p PasswordResetData) Token() PasswordResetToken {
+	return p.token
+}
+
+func (p PasswordResetData) NewPassword() string {
+	return p.newPassword
+}
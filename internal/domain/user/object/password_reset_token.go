@@ -0,0 +1,43 @@
+package object
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+)
+
+const passwordResetTokenBytes = 32
+
+type PasswordResetToken struct {
+	token string
+}
+
+func GeneratePasswordResetToken() (PasswordResetToken, error) {
+	buf := make([]byte, passwordResetTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return PasswordResetToken{}, err
+	}
+	return PasswordResetToken{token: base64.RawURLEncoding.EncodeToString(buf)}, nil
+}
+
+func NewPasswordResetToken(s string) (PasswordResetToken, error) {
+	s = strings.TrimSpace(s)
+	decoded, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(decoded) != passwordResetTokenBytes {
+		return PasswordResetToken{}, usererror.ErrPasswordResetTokenIsNotValid
+	}
+	return PasswordResetToken{token: s}, nil
+}
+
+func (p PasswordResetToken) Token() string {
+	return p.token
+}
+
+func (p PasswordResetToken) Hash() string {
+	sum := sha256.Sum256([]byte(p.token))
+	return hex.EncodeToString(sum[:])
+}
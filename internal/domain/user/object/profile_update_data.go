@@ -0,0 +1,29 @@
+package object
+
+import "strings"
+
+type ProfileUpdateData struct {
+	username        string
+	email           string
+	currentPassword string
+}
+
+func NewProfileUpdateData(username string, email string, currentPassword string) ProfileUpdateData {
+	return ProfileUpdateData{username: strings.TrimSpace(username), email: strings.TrimSpace(email), currentPassword: currentPassword}
+}
+
+func (p ProfileUpdateData) Username() string {
+	return p.username
+}
+
+func (p ProfileUpdateData) Email() string {
+	return p.email
+}
+
+func (p ProfileUpdateData) CurrentPassword() string {
+	return p.currentPassword
+}
+
+func (p ProfileUpdateData) IsEmpty() bool {
+	return p.username == "" && p.email == ""
+}
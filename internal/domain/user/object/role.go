@@ -0,0 +1,47 @@
+package object
+
+import usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+type Permission string
+
+const (
+	PermissionManageReviews Permission = "manage_reviews"
+	PermissionManageCatalog Permission = "manage_catalog"
+	PermissionManageUsers   Permission = "manage_users"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleUser:      {},
+	RoleModerator: {PermissionManageReviews},
+	RoleAdmin:     {PermissionManageReviews, PermissionManageCatalog, PermissionManageUsers},
+}
+
+func NewRole(role string) (Role, error) {
+	switch Role(role) {
+	case RoleUser, RoleModerator, RoleAdmin:
+		return Role(role), nil
+	default:
+		return RoleUser, usererror.ErrUserRoleIsNotValid
+	}
+}
+
+func (r Role) HasPermission(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Role) String() string {
+	return string(r)
+}
@@ -0,0 +1,38 @@
+package object
+
+import (
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	Private   any
+	Public    any
+}
+
+func ValidateAlgorithm(algorithm string) error {
+	switch algorithm {
+	case AlgorithmHS256, AlgorithmRS256, AlgorithmEdDSA:
+		return nil
+	}
+	return usererror.ErrSigningAlgorithmIsNotValid
+}
+
+func (s SigningKey) CanSign() bool {
+	return s.Private != nil
+}
+
+func (s SigningKey) IsAsymmetric() bool {
+	return s.Algorithm != AlgorithmHS256
+}
+
+func (s SigningKey) PublicOnly() SigningKey {
+	return SigningKey{ID: s.ID, Algorithm: s.Algorithm, Public: s.Public}
+}
@@ -0,0 +1,35 @@
+package object
+
+import "time"
+
+type TokenClaims struct {
+	userID    UserID
+	role      Role
+	tokenID   string
+	sessionID string
+	expiresAt time.Time
+}
+
+func NewTokenClaims(userID UserID, role Role, tokenID string, sessionID string, expiresAt time.Time) TokenClaims {
+	return TokenClaims{userID: userID, role: role, tokenID: tokenID, sessionID: sessionID, expiresAt: expiresAt}
+}
+
+func (t TokenClaims) UserID() UserID {
+	return t.userID
+}
+
+func (t TokenClaims) Role() Role {
+	return t.role
+}
+
+func (t TokenClaims) TokenID() string {
+	return t.tokenID
+}
+
+func (t TokenClaims) SessionID() string {
+	return t.sessionID
+}
+
+func (t TokenClaims) ExpiresAt() time.Time {
+	return t.expiresAt
+}
@@ -0,0 +1,26 @@
+package user
+
+import (
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+const DefaultPasswordResetTTL = time.Hour
+
+type PasswordReset struct {
+	ID        string
+	UserID    object.UserID
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+func NewPasswordReset(userID object.UserID, token object.PasswordResetToken, ttl time.Duration) *PasswordReset {
+	return &PasswordReset{UserID: userID, TokenHash: token.Hash(), ExpiresAt: time.Now().Add(ttl)}
+}
+
+func (p *PasswordReset) IsUsable(now time.Time) bool {
+	return p.UsedAt == nil && now.Before(p.ExpiresAt)
+}
@@ -0,0 +1,19 @@
+package user
+
+import (
+	"context"
+
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+func CheckPermission(ctx context.Context, repo Repository, userID object.UserID, permission object.Permission) error {
+	user, err := repo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.HasPermission(permission) {
+		return usererror.ErrPermissionDenied
+	}
+	return nil
+}
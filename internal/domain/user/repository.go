@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
@@ -11,4 +12,8 @@ type Repository interface {
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	Save(ctx context.Context, user *User) (*User, error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	SavePasswordReset(ctx context.Context, reset *PasswordReset) (*PasswordReset, error)
+	GetPasswordResetByHash(ctx context.Context, tokenHash string) (*PasswordReset, error)
+	ConsumePasswordResets(ctx context.Context, userID object.UserID) ([]string, error)
+	MarkVerificationSent(ctx context.Context, userID object.UserID, minInterval time.Duration) (bool, error)
 }
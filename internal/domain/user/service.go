@@ -3,11 +3,19 @@ package user
 import (
 	"context"
 
+	sessionobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/session/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type Service interface {
 	GetUserByID(ctx context.Context, id object.UserID) (*User, error)
 	Register(ctx context.Context, data object.UserRegistrationData) (*User, error)
-	Authenticate(ctx context.Context, data object.AuthenticationData) (*object.AuthResponse, error)
+	Authenticate(ctx context.Context, data object.AuthenticationData, client sessionobject.ClientInfo) (*object.AuthResponse, error)
+	ChangeRole(ctx context.Context, actorID object.UserID, targetID object.UserID, role object.Role) error
+	UpdateProfile(ctx context.Context, userID object.UserID, data object.ProfileUpdateData) (*User, error)
+	ChangePassword(ctx context.Context, claims object.TokenClaims, data object.PasswordChangeData) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, data object.PasswordResetData) error
+	VerifyEmail(ctx context.Context, token string) error
+	ResendVerification(ctx context.Context, userID object.UserID) error
 }
@@ -7,6 +7,7 @@ import (
 )
 
 type TokenService interface {
-	GenerateToken(ctx context.Context, user *User) (string, error)
-	ValidateToken(ctx context.Context, token string) (object.UserID, error)
+	GenerateToken(ctx context.Context, user *User, sessionID string) (object.AccessToken, error)
+	ValidateToken(ctx context.Context, token string) (object.TokenClaims, error)
+	PublicKeys(ctx context.Context) []object.SigningKey
 }
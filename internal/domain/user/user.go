@@ -6,14 +6,16 @@ import (
 )
 
 type User struct {
-	username string
-	password string
-	email    string
-	id       object.UserID
+	username      string
+	password      string
+	email         string
+	id            object.UserID
+	role          object.Role
+	emailVerified bool
 }
 
 func NewUser(username string, password string, email string) *User {
-	return &User{username, password, email, object.UserID{}}
+	return &User{username, password, email, object.UserID{}, object.RoleUser, false}
 }
 
 func (u *User) Username() string {
@@ -28,6 +30,29 @@ func (u *User) Email() string {
 	return u.email
 }
 
+func (u *User) SetUsername(username string) {
+	u.username = username
+}
+
+func (u *User) SetEmail(email string) {
+	if u.email != email {
+		u.emailVerified = false
+	}
+	u.email = email
+}
+
+func (u *User) EmailVerified() bool {
+	return u.emailVerified
+}
+
+func (u *User) SetEmailVerified(verified bool) {
+	u.emailVerified = verified
+}
+
+func (u *User) SetPassword(password string) {
+	u.password = password
+}
+
 func (u *User) ID() object.UserID {
 	return u.id
 }
@@ -39,3 +64,15 @@ func (u *User) SetID(id object.UserID) error {
 	}
 	return usererror.ErrUserIDAlreadyExists
 }
+
+func (u *User) Role() object.Role {
+	return u.role
+}
+
+func (u *User) SetRole(role object.Role) {
+	u.role = role
+}
+
+func (u *User) HasPermission(permission object.Permission) bool {
+	return u.role.HasPermission(permission)
+}
@@ -8,4 +8,5 @@ var (
 	ErrUserMovieIsNotFound      = errors.New("user movie is not found")
 	ErrListTypeIsIncorrect      = errors.New("list type is incorrect")
 	ErrUserMovieIDAlreadyExists = errors.New("user movie ID already exists")
+	ErrWatchProgressIsNotFound  = errors.New("watch progress is not found")
 )
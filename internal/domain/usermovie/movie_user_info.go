@@ -2,9 +2,14 @@ package usermovie
 
 import (
 	"time"
+
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
 )
 
 type MovieUserInfo struct {
+	ID          string    `json:"id"`
+	Slug        string    `json:"slug"`
 	Title       string    `json:"title"`
 	Description string    `json:"description"`
 	ReleaseDate time.Time `json:"release_date"`
@@ -15,4 +20,23 @@ type MovieUserInfo struct {
 
 	ListType   ListType `json:"list_type"`
 	UserRating int      `json:"user_rating"`
+
+	Language      string `json:"language,omitempty"`
+	OriginalTitle string `json:"original_title,omitempty"`
+}
+
+func (m *MovieUserInfo) LocalizationID() movieobject.MovieID {
+	movieID, _ := movieobject.NewMovieID(m.ID)
+	return movieID
+}
+
+func (m *MovieUserInfo) ApplyTranslation(translation *moviedomain.Translation) {
+	m.Language = translation.Language.String()
+	if translation.Title != "" {
+		m.OriginalTitle = m.Title
+		m.Title = translation.Title
+	}
+	if translation.Description != "" {
+		m.Description = translation.Description
+	}
 }
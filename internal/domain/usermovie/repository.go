@@ -4,13 +4,21 @@ import (
 	"context"
 
 	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	seriesobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type Repository interface {
 	Save(ctx context.Context, userMovie *UserMovie) error
 	Delete(ctx context.Context, userMovie *UserMovie) error
-	GetByUserAndMovie(ctx context.Context, userID object.UserID, movieID object2.MovieID) (*UserMovie, error)
+	GetByUserAndTarget(ctx context.Context, userID object.UserID, target titleobject.Target) (*UserMovie, error)
+	GetByUserAndTargetForUpdate(ctx context.Context, userID object.UserID, target titleobject.Target) (*UserMovie, error)
 	GetMoviesByUserAndListType(ctx context.Context, userID object.UserID, listType ListType) ([]*MovieUserInfo, error)
 	GetMovieByUserAndListType(ctx context.Context, userID object.UserID, movieID object2.MovieID, listType ListType) (*MovieUserInfo, error)
+	GetSeriesByUserAndListType(ctx context.Context, userID object.UserID, listType ListType) ([]*SeriesUserInfo, error)
+	GetSeriesTargetByUserAndListType(ctx context.Context, userID object.UserID, target titleobject.Target, listType ListType) (*SeriesUserInfo, error)
+	SaveProgress(ctx context.Context, progress *WatchProgress) error
+	GetProgress(ctx context.Context, userID object.UserID, seriesID seriesobject.SeriesID) (*WatchProgress, error)
+	DeleteProgress(ctx context.Context, userID object.UserID, seriesID seriesobject.SeriesID) error
 }
@@ -0,0 +1,16 @@
+package usermovie
+
+type SeriesUserInfo struct {
+	TargetType  string  `json:"target_type"`
+	TargetID    string  `json:"target_id"`
+	SeriesID    string  `json:"series_id"`
+	SeriesSlug  string  `json:"series_slug"`
+	SeriesTitle string  `json:"series_title"`
+	Season      *int    `json:"season,omitempty"`
+	Episode     *int    `json:"episode,omitempty"`
+	Title       string  `json:"title"`
+	Rating      float64 `json:"rating"`
+
+	ListType   ListType `json:"list_type"`
+	UserRating int      `json:"user_rating"`
+}
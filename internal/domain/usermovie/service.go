@@ -4,12 +4,19 @@ import (
 	"context"
 
 	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	seriesobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type Service interface {
-	SaveRating(ctx context.Context, userID object.UserID, info object2.MovieInfo, rating int) error
-	SaveListType(ctx context.Context, userID object.UserID, info object2.MovieInfo, listType string) error
-	FindMovieByUser(ctx context.Context, userID object.UserID, info object2.MovieInfo, listType string) (*MovieUserInfo, error)
+	SaveRating(ctx context.Context, userID object.UserID, ref titleobject.TargetRef, rating int) error
+	SaveListType(ctx context.Context, userID object.UserID, ref titleobject.TargetRef, listType string) error
+	FindMovieByUser(ctx context.Context, userID object.UserID, ref object2.MovieRef, listType string) (*MovieUserInfo, error)
 	FindMoviesByUserAndListType(ctx context.Context, userID object.UserID, listType string) ([]*MovieUserInfo, error)
+	FindSeriesTargetByUser(ctx context.Context, userID object.UserID, ref titleobject.TargetRef, listType string) (*SeriesUserInfo, error)
+	FindSeriesByUserAndListType(ctx context.Context, userID object.UserID, listType string) ([]*SeriesUserInfo, error)
+	SaveProgress(ctx context.Context, userID object.UserID, ref seriesobject.SeriesRef, code seriesobject.EpisodeCode) (*WatchProgress, error)
+	FindProgress(ctx context.Context, userID object.UserID, ref seriesobject.SeriesRef) (*WatchProgress, error)
+	DeleteProgress(ctx context.Context, userID object.UserID, ref seriesobject.SeriesRef) error
 }
@@ -1,7 +1,8 @@
 package usermovie
 
 import (
-	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/stats"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie/error"
 	object3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie/object"
@@ -43,15 +44,15 @@ func ValidateUserRating(rating int) error {
 type UserMovie struct {
 	id         object3.UserMovieID
 	userID     object.UserID
-	movieID    object2.MovieID
+	target     titleobject.Target
 	listType   ListType
 	userRating int
 }
 
-func NewUserMovie(userID object.UserID, movieID object2.MovieID) *UserMovie {
+func NewUserMovie(userID object.UserID, target titleobject.Target) *UserMovie {
 	return &UserMovie{
 		userID:     userID,
-		movieID:    movieID,
+		target:     target,
 		listType:   ListTypeNone,
 		userRating: 0,
 	}
@@ -103,8 +104,8 @@ func (um *UserMovie) SetUserMovieID(id object3.UserMovieID) error {
 	return error2.ErrUserMovieIDAlreadyExists
 }
 
-func (um *UserMovie) MovieID() object2.MovieID {
-	return um.movieID
+func (um *UserMovie) Target() titleobject.Target {
+	return um.target
 }
 
 func (um *UserMovie) UserID() object.UserID {
@@ -114,3 +115,18 @@ func (um *UserMovie) UserID() object.UserID {
 func (um *UserMovie) IsEmpty() bool {
 	return um.listType == ListTypeNone && um.userRating == EmptyRating
 }
+
+func (um *UserMovie) StatsContribution() stats.MovieStatsDelta {
+	contribution := stats.MovieStatsDelta{}
+	if um.HasRating() {
+		contribution.RatingSum = um.userRating
+		contribution.RatingCount = 1
+	}
+	if um.IsFavorite() {
+		contribution.FavoriteCount = 1
+	}
+	if um.IsInWatchlist() {
+		contribution.WatchlistCount = 1
+	}
+	return contribution
+}
@@ -0,0 +1,28 @@
+package usermovie
+
+import (
+	"time"
+
+	seriesobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+type WatchProgress struct {
+	UserID          object.UserID
+	SeriesID        seriesobject.SeriesID
+	EpisodeID       seriesobject.EpisodeID
+	Code            seriesobject.EpisodeCode
+	EpisodeTitle    string
+	Next            *seriesobject.EpisodeCode
+	WatchedEpisodes int
+	TotalEpisodes   int
+	UpdatedAt       time.Time
+}
+
+func NewWatchProgress(userID object.UserID, seriesID seriesobject.SeriesID, episodeID seriesobject.EpisodeID, code seriesobject.EpisodeCode) *WatchProgress {
+	return &WatchProgress{UserID: userID, SeriesID: seriesID, EpisodeID: episodeID, Code: code}
+}
+
+func (p *WatchProgress) IsFinished() bool {
+	return p.Next == nil
+}
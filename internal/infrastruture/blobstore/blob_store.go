@@ -0,0 +1,33 @@
+package blobstore
+
+import (
+	"errors"
+	"log/slog"
+
+	imagedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/image"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/blobstore/blobstoreconfig"
+)
+
+const (
+	StorageLocal    = "local"
+	defaultLocalDir = "data/images"
+)
+
+var ErrUnknownStorage = errors.New("unknown blob storage")
+
+func NewBlobStore(config blobstoreconfig.BlobStoreConfig) (imagedomain.BlobStore, error) {
+	switch config.Storage {
+	case "", StorageLocal:
+		dir := config.LocalDir
+		if dir == "" {
+			dir = defaultLocalDir
+		}
+		store, err := NewLocalBlobStore(dir)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	}
+	slog.Error("Unknown blob storage", "storage", config.Storage)
+	return nil, ErrUnknownStorage
+}
@@ -0,0 +1,7 @@
+package blobstoreconfig
+
+type BlobStoreConfig struct {
+	Storage        string `yaml:"storage"`
+	LocalDir       string `yaml:"local_dir"`
+	MaxUploadBytes int64  `yaml:"max_upload_bytes"`
+}
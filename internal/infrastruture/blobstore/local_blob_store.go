@@ -0,0 +1,103 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	imagedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/image"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/error"
+)
+
+type LocalBlobStore struct {
+	root string
+}
+
+func NewLocalBlobStore(root string) (*LocalBlobStore, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		slog.Error("LocalBlobStore error creating root directory", "error", err, "root", root)
+		return nil, err
+	}
+	return &LocalBlobStore{root: root}, nil
+}
+
+func (l *LocalBlobStore) Put(ctx context.Context, key string, body io.Reader) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		slog.Error("LocalBlobStore.Put error creating directory", "error", err, "key", key)
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		slog.Error("LocalBlobStore.Put error creating temp file", "error", err, "key", key)
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		slog.Error("LocalBlobStore.Put error writing blob", "error", err, "key", key)
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		slog.Error("LocalBlobStore.Put error closing blob", "error", err, "key", key)
+		return err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		slog.Error("LocalBlobStore.Put error renaming blob", "error", err, "key", key)
+		return err
+	}
+	return nil
+}
+
+func (l *LocalBlobStore) Get(ctx context.Context, key string) (*imagedomain.Blob, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, error2.ErrBlobIsNotFound
+	}
+	if err != nil {
+		slog.Error("LocalBlobStore.Get error opening blob", "error", err, "key", key)
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		slog.Error("LocalBlobStore.Get error reading blob info", "error", err, "key", key)
+		return nil, err
+	}
+	return &imagedomain.Blob{Body: file, Size: info.Size()}, nil
+}
+
+func (l *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		slog.Error("LocalBlobStore.Delete error removing blob", "error", err, "key", key)
+		return err
+	}
+	return nil
+}
+
+func (l *LocalBlobStore) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") || !filepath.IsLocal(key) {
+		return "", error2.ErrBlobKeyIsNotValid
+	}
+	return filepath.Join(l.root, filepath.FromSlash(key)), nil
+}
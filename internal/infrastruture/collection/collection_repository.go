@@ -0,0 +1,446 @@
+package collection
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	collectiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/collection/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/collection/object"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+	usermoviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
+	"github.com/lib/pq"
+)
+
+const selectCollectionQuery = `SELECT c.id, c.slug, c.name, c.description, COUNT(cm.movie_id)
+FROM collections AS c
+LEFT JOIN collection_movies AS cm ON cm.collection_id = c.id`
+
+type CollectionRepository struct {
+	db *sql.DB
+}
+
+func NewCollectionRepository(db *sql.DB) *CollectionRepository {
+	return &CollectionRepository{db: db}
+}
+
+func (c *CollectionRepository) GetAll(ctx context.Context) ([]*collectiondomain.Collection, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = c.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("CollectionRepo.GetAll Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("CollectionRepo.GetAll Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectCollectionQuery + `
+GROUP BY c.id, c.slug, c.name, c.description
+ORDER BY c.name`
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		slog.Error("CollectionRepo.GetAll Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	collections := make([]*collectiondomain.Collection, 0)
+	for rows.Next() {
+		collection, scanErr := scanCollection(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("CollectionRepo.GetAll Scan Error", "Error", err)
+			return nil, err
+		}
+		collections = append(collections, collection)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("CollectionRepo.GetAll Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("CollectionRepo.GetAll Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return collections, nil
+}
+
+func (c *CollectionRepository) GetBySlug(ctx context.Context, slug string) (*collectiondomain.Collection, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = c.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("CollectionRepo.GetBySlug Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("CollectionRepo.GetBySlug Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectCollectionQuery + `
+WHERE c.slug = $1
+GROUP BY c.id, c.slug, c.name, c.description`
+	collection, err := scanCollection(tx.QueryRowContext(ctx, query, slug))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrCollectionIsNotFound
+	}
+	if err != nil {
+		slog.Error("CollectionRepo.GetBySlug row Scan Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("CollectionRepo.GetBySlug Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return collection, nil
+}
+
+func (c *CollectionRepository) GetItems(ctx context.Context, collectionID object.CollectionID) ([]*collectiondomain.Item, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = c.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("CollectionRepo.GetItems Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("CollectionRepo.GetItems Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT m.id, m.slug, m.title, m.release_date, cm.position, COALESCE(r.rating, 0)
+FROM collection_movies AS cm
+JOIN movies AS m ON m.id = cm.movie_id
+LEFT JOIN LATERAL (SELECT ms.rating_sum::float8 / NULLIF(ms.rating_count, 0) AS rating
+                   FROM movie_stats AS ms
+                   WHERE ms.movie_id = m.id) AS r ON TRUE
+WHERE cm.collection_id = $1
+ORDER BY cm.position`
+	rows, err := tx.QueryContext(ctx, query, collectionID.ID())
+	if err != nil {
+		slog.Error("CollectionRepo.GetItems Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*collectiondomain.Item, 0)
+	for rows.Next() {
+		var id string
+		item := &collectiondomain.Item{}
+		err = rows.Scan(&id, &item.Slug, &item.Title, &item.ReleaseDate, &item.Position, &item.Rating)
+		if err != nil {
+			slog.Error("CollectionRepo.GetItems Scan Error", "Error", err)
+			return nil, err
+		}
+		item.MovieID, _ = movieobject.NewMovieID(id)
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("CollectionRepo.GetItems Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("CollectionRepo.GetItems Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return items, nil
+}
+
+func (c *CollectionRepository) GetUserStatuses(ctx context.Context, collectionID object.CollectionID, userID userobject.UserID) (map[movieobject.MovieID]collectiondomain.UserStatus, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = c.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("CollectionRepo.GetUserStatuses Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("CollectionRepo.GetUserStatuses Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT cm.movie_id, um.user_rating, um.list_type
+FROM collection_movies AS cm
+JOIN user_movies AS um ON um.movie_id = cm.movie_id AND um.user_id = $2
+WHERE cm.collection_id = $1`
+	rows, err := tx.QueryContext(ctx, query, collectionID.ID(), userID.ID())
+	if err != nil {
+		slog.Error("CollectionRepo.GetUserStatuses Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	statuses := make(map[movieobject.MovieID]collectiondomain.UserStatus)
+	for rows.Next() {
+		var id string
+		var listType sql.NullString
+		var status collectiondomain.UserStatus
+		err = rows.Scan(&id, &status.UserRating, &listType)
+		if err != nil {
+			slog.Error("CollectionRepo.GetUserStatuses Scan Error", "Error", err)
+			return nil, err
+		}
+		status.ListType = usermoviedomain.ListType(listType.String)
+		movieID, _ := movieobject.NewMovieID(id)
+		statuses[movieID] = status
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("CollectionRepo.GetUserStatuses Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("CollectionRepo.GetUserStatuses Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return statuses, nil
+}
+
+func (c *CollectionRepository) Save(ctx context.Context, collection *collectiondomain.Collection) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = c.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("CollectionRepo.Save Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("CollectionRepo.Save Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO collections (slug, name, description) VALUES ($1, $2, $3) RETURNING id`
+	var newID string
+	err = tx.QueryRowContext(ctx, query, collection.Slug, collection.Name, collection.Description).Scan(&newID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			slog.Error("CollectionRepo.Save collection already exists", "Slug", collection.Slug)
+			return error2.ErrCollectionAlreadyExists
+		}
+		slog.Error("CollectionRepo.Save Query Error", "Error", err)
+		return err
+	}
+
+	collectionID, _ := object.NewCollectionID(newID)
+	_ = collection.SetID(collectionID)
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("CollectionRepo.Save Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (c *CollectionRepository) Update(ctx context.Context, collection *collectiondomain.Collection) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = c.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("CollectionRepo.Update Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("CollectionRepo.Update Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `UPDATE collections SET slug = $1, name = $2, description = $3 WHERE id = $4`
+	result, execErr := tx.ExecContext(ctx, query, collection.Slug, collection.Name, collection.Description, collection.ID().ID())
+	if execErr != nil {
+		err = execErr
+		if isUniqueViolation(execErr) {
+			slog.Error("CollectionRepo.Update collection already exists", "Slug", collection.Slug)
+			return error2.ErrCollectionAlreadyExists
+		}
+		slog.Error("CollectionRepo.Update Exec Error", "Error", execErr)
+		return err
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("CollectionRepo.Update RowsAffected Error", "Error", rowsErr)
+		return err
+	}
+	if rowsAffected == 0 {
+		err = error2.ErrCollectionIsNotFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("CollectionRepo.Update Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (c *CollectionRepository) Delete(ctx context.Context, collectionID object.CollectionID) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = c.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("CollectionRepo.Delete Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("CollectionRepo.Delete Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	result, execErr := tx.ExecContext(ctx, `DELETE FROM collections WHERE id = $1`, collectionID.ID())
+	if execErr != nil {
+		err = execErr
+		slog.Error("CollectionRepo.Delete Exec Error", "Error", execErr)
+		return err
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("CollectionRepo.Delete RowsAffected Error", "Error", rowsErr)
+		return err
+	}
+	if rowsAffected == 0 {
+		err = error2.ErrCollectionIsNotFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("CollectionRepo.Delete Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (c *CollectionRepository) ReplaceMovies(ctx context.Context, collectionID object.CollectionID, movieIDs []movieobject.MovieID) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = c.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("CollectionRepo.ReplaceMovies Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("CollectionRepo.ReplaceMovies Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	ids := make([]string, 0, len(movieIDs))
+	for _, movieID := range movieIDs {
+		ids = append(ids, movieID.ID())
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM collection_movies WHERE collection_id = $1`, collectionID.ID())
+	if err != nil {
+		slog.Error("CollectionRepo.ReplaceMovies Delete Error", "Error", err)
+		return err
+	}
+
+	query := `INSERT INTO collection_movies (collection_id, movie_id, position)
+SELECT $1::uuid, t.movie_id, t.position
+FROM unnest($2::uuid[]) WITH ORDINALITY AS t(movie_id, position)`
+	_, err = tx.ExecContext(ctx, query, collectionID.ID(), pq.Array(ids))
+	if err != nil {
+		slog.Error("CollectionRepo.ReplaceMovies Insert Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("CollectionRepo.ReplaceMovies Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func scanCollection(row interface{ Scan(dest ...any) error }) (*collectiondomain.Collection, error) {
+	var id, slug, name, description string
+	var movieCount int
+	if err := row.Scan(&id, &slug, &name, &description, &movieCount); err != nil {
+		return nil, err
+	}
+	collection := collectiondomain.NewCollection(name, slug, description)
+	collection.MovieCount = movieCount
+	collectionID, _ := object.NewCollectionID(id)
+	_ = collection.SetID(collectionID)
+	return collection, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
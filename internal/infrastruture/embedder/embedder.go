@@ -0,0 +1,23 @@
+package embedder
+
+import (
+	"log/slog"
+
+	embeddingdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/embedder/embedderconfig"
+)
+
+const ProviderOllama = "ollama"
+
+func NewEmbedder(config embedderconfig.EmbedderConfig) (embeddingdomain.Embedder, error) {
+	switch config.Provider {
+	case "":
+		slog.Info("Embedder is disabled")
+		return nil, nil
+	case ProviderOllama:
+		return NewOllamaEmbedder(config)
+	}
+	slog.Error("Unknown embedder provider", "provider", config.Provider)
+	return nil, error2.ErrEmbedderIsNotConfigured
+}
@@ -0,0 +1,12 @@
+package embedderconfig
+
+import "time"
+
+type EmbedderConfig struct {
+	Provider    string        `yaml:"provider"`
+	OllamaHost  string        `yaml:"ollama_host"`
+	Model       string        `yaml:"model"`
+	Timeout     time.Duration `yaml:"timeout"`
+	JobInterval time.Duration `yaml:"job_interval"`
+	BatchSize   int           `yaml:"batch_size"`
+}
@@ -0,0 +1,71 @@
+package embedder
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	embeddingdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/embedder/embedderconfig"
+	"github.com/ollama/ollama/api"
+)
+
+const (
+	defaultOllamaModel   = "nomic-embed-text"
+	defaultOllamaTimeout = 30 * time.Second
+)
+
+type OllamaEmbedder struct {
+	client  *api.Client
+	model   string
+	timeout time.Duration
+}
+
+func NewOllamaEmbedder(config embedderconfig.EmbedderConfig) (*OllamaEmbedder, error) {
+	baseURL, err := url.Parse(config.OllamaHost)
+	if err != nil {
+		slog.Error("OllamaEmbedder error parsing host", "error", err, "host", config.OllamaHost)
+		return nil, error2.ErrEmbedderIsNotConfigured
+	}
+
+	model := config.Model
+	if model == "" {
+		model = defaultOllamaModel
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultOllamaTimeout
+	}
+	return &OllamaEmbedder{client: api.NewClient(baseURL, http.DefaultClient), model: model, timeout: timeout}, nil
+}
+
+func (o *OllamaEmbedder) Model() string {
+	return o.model
+}
+
+func (o *OllamaEmbedder) Embed(ctx context.Context, texts []string) ([]embeddingdomain.Vector, error) {
+	if len(texts) == 0 {
+		return []embeddingdomain.Vector{}, nil
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+	response, err := o.client.Embed(requestCtx, &api.EmbedRequest{Model: o.model, Input: texts})
+	if err != nil {
+		slog.Error("OllamaEmbedder.Embed request failed", "error", err, "model", o.model)
+		return nil, error2.ErrEmbedderRequestFailed
+	}
+	if len(response.Embeddings) != len(texts) {
+		slog.Error("OllamaEmbedder.Embed unexpected embeddings count", "expected", len(texts), "got", len(response.Embeddings))
+		return nil, error2.ErrEmbedderRequestFailed
+	}
+
+	vectors := make([]embeddingdomain.Vector, 0, len(response.Embeddings))
+	for _, embedding := range response.Embeddings {
+		vectors = append(vectors, embedding)
+	}
+	return vectors, nil
+}
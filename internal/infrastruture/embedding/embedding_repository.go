@@ -0,0 +1,327 @@
+package embedding
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	embeddingdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/embedding/object"
+	"github.com/lib/pq"
+)
+
+const getPendingQuery = `SELECT q.entity_type, q.entity_id, q.enqueued_at,
+       CASE q.entity_type
+           WHEN 'movie' THEN concat_ws(E'\n', m.title, NULLIF(m.tagline, ''),
+                                       'Genres: ' || (SELECT string_agg(g.name, ', ' ORDER BY g.name)
+                                                      FROM movie_genres AS mg JOIN genres AS g ON g.id = mg.genre_id
+                                                      WHERE mg.movie_id = m.id),
+                                       'Director: ' || NULLIF(m.director, ''), m.description)
+           ELSE concat_ws(E'\n', 'Review of ' || rm.title, r.text)
+       END
+FROM embedding_queue AS q
+LEFT JOIN movies AS m ON q.entity_type = 'movie' AND m.id = q.entity_id
+LEFT JOIN reviews AS r ON q.entity_type = 'review' AND r.id = q.entity_id
+LEFT JOIN movies AS rm ON rm.id = r.movie_id
+WHERE q.attempts < $2 AND (m.id IS NOT NULL OR r.id IS NOT NULL)
+ORDER BY q.attempts, q.enqueued_at
+LIMIT $1`
+
+const searchQuery = `SELECT e.entity_type, e.entity_id, COALESCE(r.movie_id, e.entity_id), s.similarity
+FROM embeddings AS e
+CROSS JOIN LATERAL (SELECT SUM(a::float8 * b::float8) / (e.norm * $3::float8) AS similarity
+                    FROM unnest(e.vector, $2::real[]) AS t(a, b)) AS s
+LEFT JOIN reviews AS r ON e.entity_type = 'review' AND r.id = e.entity_id
+WHERE e.model = $1 AND e.entity_type = ANY($4) AND e.norm > 0 AND cardinality(e.vector) = cardinality($2::real[])
+ORDER BY s.similarity DESC, e.entity_id
+LIMIT $5`
+
+const searchVectorQuery = `SELECT e.entity_type, e.entity_id, COALESCE(r.movie_id, e.entity_id),
+       1 - (e.vector::vector <=> $2::real[]::vector) AS similarity
+FROM embeddings AS e
+LEFT JOIN reviews AS r ON e.entity_type = 'review' AND r.id = e.entity_id
+WHERE e.model = $1 AND e.entity_type = ANY($3) AND e.norm > 0 AND cardinality(e.vector) = cardinality($2::real[])
+ORDER BY e.vector::vector <=> $2::real[]::vector, e.entity_id
+LIMIT $4`
+
+type EmbeddingRepository struct {
+	db *sql.DB
+
+	mu       sync.Mutex
+	pgvector *bool
+}
+
+func NewEmbeddingRepository(db *sql.DB) *EmbeddingRepository {
+	return &EmbeddingRepository{db: db}
+}
+
+func (e *EmbeddingRepository) GetPending(ctx context.Context, limit int, maxAttempts int) ([]*embeddingdomain.Document, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = e.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("EmbeddingRepo.GetPending Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("EmbeddingRepo.GetPending Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	rows, err := tx.QueryContext(ctx, getPendingQuery, limit, maxAttempts)
+	if err != nil {
+		slog.Error("EmbeddingRepo.GetPending Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	documents := make([]*embeddingdomain.Document, 0)
+	for rows.Next() {
+		var entityType string
+		document := &embeddingdomain.Document{}
+		err = rows.Scan(&entityType, &document.EntityID, &document.EnqueuedAt, &document.Text)
+		if err != nil {
+			slog.Error("EmbeddingRepo.GetPending Scan Error", "Error", err)
+			return nil, err
+		}
+		if document.EntityType, err = object.NewEntityType(entityType); err != nil {
+			slog.Error("EmbeddingRepo.GetPending EntityType Error", "Error", err)
+			return nil, err
+		}
+		documents = append(documents, document)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("EmbeddingRepo.GetPending Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("EmbeddingRepo.GetPending Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return documents, nil
+}
+
+func (e *EmbeddingRepository) Save(ctx context.Context, document *embeddingdomain.Document, model string, vector embeddingdomain.Vector) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = e.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("EmbeddingRepo.Save Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("EmbeddingRepo.Save Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO embeddings (entity_type, entity_id, model, vector, norm, updated_at)
+VALUES ($1, $2, $3, $4, $5, now())
+ON CONFLICT (entity_type, entity_id) DO UPDATE
+SET model = EXCLUDED.model, vector = EXCLUDED.vector, norm = EXCLUDED.norm, updated_at = EXCLUDED.updated_at`
+	_, err = tx.ExecContext(ctx, query, document.EntityType.String(), document.EntityID, model, pq.Array([]float32(vector)), vector.Norm())
+	if err != nil {
+		slog.Error("EmbeddingRepo.Save Upsert Error", "Error", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM embedding_queue WHERE entity_type = $1 AND entity_id = $2 AND enqueued_at = $3`,
+		document.EntityType.String(), document.EntityID, document.EnqueuedAt)
+	if err != nil {
+		slog.Error("EmbeddingRepo.Save Dequeue Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("EmbeddingRepo.Save Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (e *EmbeddingRepository) MarkFailed(ctx context.Context, document *embeddingdomain.Document) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = e.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("EmbeddingRepo.MarkFailed Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("EmbeddingRepo.MarkFailed Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE embedding_queue SET attempts = attempts + 1 WHERE entity_type = $1 AND entity_id = $2 AND enqueued_at = $3`,
+		document.EntityType.String(), document.EntityID, document.EnqueuedAt)
+	if err != nil {
+		slog.Error("EmbeddingRepo.MarkFailed Update Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("EmbeddingRepo.MarkFailed Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (e *EmbeddingRepository) Requeue(ctx context.Context, model string) (int, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = e.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("EmbeddingRepo.Requeue Begin Tx Error", "Error", err)
+			return 0, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("EmbeddingRepo.Requeue Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM embedding_queue AS q
+WHERE NOT EXISTS (SELECT 1 FROM movies AS m WHERE q.entity_type = 'movie' AND m.id = q.entity_id)
+  AND NOT EXISTS (SELECT 1 FROM reviews AS r WHERE q.entity_type = 'review' AND r.id = q.entity_id)`)
+	if err != nil {
+		slog.Error("EmbeddingRepo.Requeue Delete Error", "Error", err)
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO embedding_queue (entity_type, entity_id, enqueued_at, attempts)
+SELECT entity_type, entity_id, clock_timestamp(), 0 FROM embeddings WHERE model != $1
+ON CONFLICT (entity_type, entity_id) DO NOTHING`, model)
+	if err != nil {
+		slog.Error("EmbeddingRepo.Requeue Insert Error", "Error", err)
+		return 0, err
+	}
+	requeued, err := result.RowsAffected()
+	if err != nil {
+		slog.Error("EmbeddingRepo.Requeue RowsAffected Error", "Error", err)
+		return 0, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("EmbeddingRepo.Requeue Commit Error", "Error", commitErr)
+			return 0, commitErr
+		}
+	}
+	return int(requeued), nil
+}
+
+func (e *EmbeddingRepository) Search(ctx context.Context, model string, vector embeddingdomain.Vector, types []object.EntityType, limit int) ([]*embeddingdomain.Match, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = e.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("EmbeddingRepo.Search Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("EmbeddingRepo.Search Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	entityTypes := make([]string, 0, len(types))
+	for _, entityType := range types {
+		entityTypes = append(entityTypes, entityType.String())
+	}
+	var rows *sql.Rows
+	if e.hasPgvector(ctx) {
+		rows, err = tx.QueryContext(ctx, searchVectorQuery, model, pq.Array([]float32(vector)), pq.Array(entityTypes), limit)
+	} else {
+		rows, err = tx.QueryContext(ctx, searchQuery, model, pq.Array([]float32(vector)), vector.Norm(), pq.Array(entityTypes), limit)
+	}
+	if err != nil {
+		slog.Error("EmbeddingRepo.Search Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	matches := make([]*embeddingdomain.Match, 0)
+	for rows.Next() {
+		var entityType string
+		match := &embeddingdomain.Match{}
+		err = rows.Scan(&entityType, &match.EntityID, &match.MovieID, &match.Similarity)
+		if err != nil {
+			slog.Error("EmbeddingRepo.Search Scan Error", "Error", err)
+			return nil, err
+		}
+		if match.EntityType, err = object.NewEntityType(entityType); err != nil {
+			slog.Error("EmbeddingRepo.Search EntityType Error", "Error", err)
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("EmbeddingRepo.Search Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("EmbeddingRepo.Search Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return matches, nil
+}
+
+func (e *EmbeddingRepository) hasPgvector(ctx context.Context) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.pgvector != nil {
+		return *e.pgvector
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	var exists bool
+	err := e.db.QueryRowContext(checkCtx, `SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'vector')`).Scan(&exists)
+	if err != nil {
+		slog.Error("EmbeddingRepo.hasPgvector Query Error", "Error", err)
+		return false
+	}
+	slog.Info("Embedding search backend detected", "pgvector", exists)
+	e.pgvector = &exists
+	return exists
+}
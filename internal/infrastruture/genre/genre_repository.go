@@ -0,0 +1,381 @@
+package genre
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	genredomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/genre/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/genre/object"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	"github.com/lib/pq"
+)
+
+type GenreRepository struct {
+	db *sql.DB
+}
+
+func NewGenreRepository(db *sql.DB) *GenreRepository {
+	return &GenreRepository{db: db}
+}
+
+func (g *GenreRepository) GetAllWithStats(ctx context.Context) ([]*genredomain.GenreStats, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = g.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("GenreRepo.GetAllWithStats Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("GenreRepo.GetAllWithStats Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT g.id, g.slug, g.name,
+       COUNT(DISTINCT mg.movie_id),
+       COALESCE(SUM(ms.rating_sum)::float8 / NULLIF(SUM(ms.rating_count), 0), 0)
+FROM genres AS g
+LEFT JOIN movie_genres AS mg ON mg.genre_id = g.id
+LEFT JOIN movie_stats AS ms ON ms.movie_id = mg.movie_id
+GROUP BY g.id, g.slug, g.name
+ORDER BY g.name`
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		slog.Error("GenreRepo.GetAllWithStats Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make([]*genredomain.GenreStats, 0)
+	for rows.Next() {
+		genreStats := &genredomain.GenreStats{}
+		genreStats.Genre, err = scanGenre(rows, &genreStats.MovieCount, &genreStats.AverageRating)
+		if err != nil {
+			slog.Error("GenreRepo.GetAllWithStats Scan Error", "Error", err)
+			return nil, err
+		}
+		stats = append(stats, genreStats)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("GenreRepo.GetAllWithStats Rows Error", "Error", err)
+		return nil, err
+	}
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("GenreRepo.GetAllWithStats Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return stats, nil
+}
+
+func (g *GenreRepository) GetBySlug(ctx context.Context, slug string) (*genredomain.Genre, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = g.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("GenreRepo.GetBySlug Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("GenreRepo.GetBySlug Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT id, slug, name FROM genres WHERE slug = $1`
+	genre, err := scanGenre(tx.QueryRowContext(ctx, query, slug))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrGenreIsNotFound
+	}
+	if err != nil {
+		slog.Error("GenreRepo.GetBySlug row Scan Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("GenreRepo.GetBySlug Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return genre, nil
+}
+
+func (g *GenreRepository) FindByNames(ctx context.Context, names []string) ([]*genredomain.Genre, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = g.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("GenreRepo.FindByNames Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("GenreRepo.FindByNames Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	lowered := make([]string, 0, len(names))
+	slugs := make([]string, 0, len(names)*2)
+	for _, name := range names {
+		lowered = append(lowered, strings.ToLower(strings.TrimSpace(name)))
+		slugs = append(slugs, strings.ToLower(strings.TrimSpace(name)), object.NewGenreSlug(name))
+	}
+	query := `SELECT id, slug, name FROM genres
+WHERE slug = ANY($1) OR lower(name) = ANY($2)
+ORDER BY name`
+	rows, err := tx.QueryContext(ctx, query, pq.Array(slugs), pq.Array(lowered))
+	if err != nil {
+		slog.Error("GenreRepo.FindByNames Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	genres := make([]*genredomain.Genre, 0)
+	for rows.Next() {
+		genre, scanErr := scanGenre(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("GenreRepo.FindByNames Scan Error", "Error", err)
+			return nil, err
+		}
+		genres = append(genres, genre)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("GenreRepo.FindByNames Rows Error", "Error", err)
+		return nil, err
+	}
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("GenreRepo.FindByNames Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return genres, nil
+}
+
+func (g *GenreRepository) Save(ctx context.Context, genre *genredomain.Genre) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = g.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("GenreRepo.Save Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("GenreRepo.Save Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO genres (slug, name) VALUES ($1, $2) RETURNING id`
+	var newID string
+	err = tx.QueryRowContext(ctx, query, genre.Slug, genre.Name).Scan(&newID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			slog.Error("GenreRepo.Save genre already exists", "Slug", genre.Slug, "Name", genre.Name)
+			return error2.ErrGenreAlreadyExists
+		}
+		slog.Error("GenreRepo.Save Query Error", "Error", err)
+		return err
+	}
+
+	genreID, _ := object.NewGenreID(newID)
+	_ = genre.SetID(genreID)
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("GenreRepo.Save Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (g *GenreRepository) Update(ctx context.Context, genre *genredomain.Genre) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = g.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("GenreRepo.Update Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("GenreRepo.Update Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `UPDATE genres SET slug = $1, name = $2 WHERE id = $3`
+	result, execErr := tx.ExecContext(ctx, query, genre.Slug, genre.Name, genre.ID().ID())
+	if execErr != nil {
+		err = execErr
+		if isUniqueViolation(execErr) {
+			slog.Error("GenreRepo.Update genre already exists", "Slug", genre.Slug, "Name", genre.Name)
+			return error2.ErrGenreAlreadyExists
+		}
+		slog.Error("GenreRepo.Update Exec Error", "Error", execErr)
+		return err
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("GenreRepo.Update RowsAffected Error", "Error", rowsErr)
+		return err
+	}
+	if rowsAffected == 0 {
+		err = error2.ErrGenreIsNotFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("GenreRepo.Update Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (g *GenreRepository) Delete(ctx context.Context, genreID object.GenreID) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = g.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("GenreRepo.Delete Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("GenreRepo.Delete Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	result, execErr := tx.ExecContext(ctx, `DELETE FROM genres WHERE id = $1`, genreID.ID())
+	if execErr != nil {
+		err = execErr
+		slog.Error("GenreRepo.Delete Exec Error", "Error", execErr)
+		return err
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("GenreRepo.Delete RowsAffected Error", "Error", rowsErr)
+		return err
+	}
+	if rowsAffected == 0 {
+		err = error2.ErrGenreIsNotFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("GenreRepo.Delete Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (g *GenreRepository) ReplaceMovieGenres(ctx context.Context, movieID movieobject.MovieID, genreIDs []object.GenreID) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = g.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("GenreRepo.ReplaceMovieGenres Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("GenreRepo.ReplaceMovieGenres Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	ids := make([]string, 0, len(genreIDs))
+	for _, genreID := range genreIDs {
+		ids = append(ids, genreID.ID())
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM movie_genres WHERE movie_id = $1 AND NOT (genre_id = ANY($2::uuid[]))`, movieID.ID(), pq.Array(ids))
+	if err != nil {
+		slog.Error("GenreRepo.ReplaceMovieGenres Delete Error", "Error", err)
+		return err
+	}
+
+	query := `INSERT INTO movie_genres (movie_id, genre_id)
+SELECT $1::uuid, unnest($2::uuid[])
+ON CONFLICT DO NOTHING`
+	_, err = tx.ExecContext(ctx, query, movieID.ID(), pq.Array(ids))
+	if err != nil {
+		slog.Error("GenreRepo.ReplaceMovieGenres Insert Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("GenreRepo.ReplaceMovieGenres Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func scanGenre(row interface{ Scan(dest ...any) error }, extra ...any) (*genredomain.Genre, error) {
+	var id, slug, name string
+	if err := row.Scan(append([]any{&id, &slug, &name}, extra...)...); err != nil {
+		return nil, err
+	}
+	genre := genredomain.NewGenre(name, slug)
+	genreID, _ := object.NewGenreID(id)
+	_ = genre.SetID(genreID)
+	return genre, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
@@ -0,0 +1,201 @@
+package image
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	imagedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/image"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/image/object"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+const selectImageQuery = `SELECT id, movie_id, kind, content_type, width, height, size_bytes, created_at FROM movie_images`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanImage(row rowScanner) (*imagedomain.Image, error) {
+	var id, movieID, kind, contentType string
+	var width, height int
+	var sizeBytes int64
+	var createdAt time.Time
+	err := row.Scan(&id, &movieID, &kind, &contentType, &width, &height, &sizeBytes, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	imageID, _ := object.NewImageID(id)
+	movieObjectID, _ := movieobject.NewMovieID(movieID)
+	return imagedomain.RestoreImage(imageID, movieObjectID, object.Kind(kind), contentType, width, height, sizeBytes, createdAt), nil
+}
+
+type ImageRepository struct {
+	db *sql.DB
+}
+
+func NewImageRepository(db *sql.DB) *ImageRepository {
+	return &ImageRepository{db: db}
+}
+
+func (i *ImageRepository) GetByID(ctx context.Context, imageID object.ImageID) (*imagedomain.Image, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = i.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("ImageRepo.GetByID Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("ImageRepo.GetByID Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	image, err := scanImage(tx.QueryRowContext(ctx, selectImageQuery+` WHERE id = $1`, imageID.ID()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrImageIsNotFound
+	}
+	if err != nil {
+		slog.Error("ImageRepo.GetByID row Scan Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("ImageRepo.GetByID Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return image, nil
+}
+
+func (i *ImageRepository) GetByMovieAndKind(ctx context.Context, movieID movieobject.MovieID, kind object.Kind) (*imagedomain.Image, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = i.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("ImageRepo.GetByMovieAndKind Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("ImageRepo.GetByMovieAndKind Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectImageQuery + ` WHERE movie_id = $1 AND kind = $2`
+	image, err := scanImage(tx.QueryRowContext(ctx, query, movieID.ID(), kind.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrImageIsNotFound
+	}
+	if err != nil {
+		slog.Error("ImageRepo.GetByMovieAndKind row Scan Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("ImageRepo.GetByMovieAndKind Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return image, nil
+}
+
+func (i *ImageRepository) Save(ctx context.Context, image *imagedomain.Image) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = i.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("ImageRepo.Save Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("ImageRepo.Save Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO movie_images (id, movie_id, kind, content_type, width, height, size_bytes, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err = tx.ExecContext(ctx, query, image.ID().ID(), image.MovieID.ID(), image.Kind.String(), image.ContentType,
+		image.Width, image.Height, image.SizeBytes, image.CreatedAt)
+	if err != nil {
+		slog.Error("ImageRepo.Save Exec Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("ImageRepo.Save Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (i *ImageRepository) Delete(ctx context.Context, imageID object.ImageID) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = i.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("ImageRepo.Delete Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("ImageRepo.Delete Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	result, execErr := tx.ExecContext(ctx, `DELETE FROM movie_images WHERE id = $1`, imageID.ID())
+	if execErr != nil {
+		err = execErr
+		slog.Error("ImageRepo.Delete Exec Error", "Error", execErr)
+		return err
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("ImageRepo.Delete RowsAffected Error", "Error", rowsErr)
+		return err
+	}
+	if rowsAffected == 0 {
+		err = error2.ErrImageIsNotFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("ImageRepo.Delete Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
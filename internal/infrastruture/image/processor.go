@@ -0,0 +1,78 @@
+package image
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"log/slog"
+	"net/http"
+
+	imagedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/image"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/image/object"
+)
+
+const jpegQuality = 85
+
+type StdProcessor struct{}
+
+func NewStdProcessor() *StdProcessor {
+	return &StdProcessor{}
+}
+
+func (s *StdProcessor) Process(data []byte, kind object.Kind) (*imagedomain.ProcessedImage, error) {
+	contentType := http.DetectContentType(data)
+	if err := imagedomain.ValidateContentType(contentType); err != nil {
+		return nil, err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		slog.Error("StdProcessor.Process error decoding image config", "error", err)
+		return nil, error2.ErrImageIsNotValid
+	}
+	if err = imagedomain.ValidateDimensions(config.Width, config.Height); err != nil {
+		return nil, err
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		slog.Error("StdProcessor.Process error decoding image", "error", err)
+		return nil, error2.ErrImageIsNotValid
+	}
+
+	processed := &imagedomain.ProcessedImage{
+		ContentType: contentType,
+		Width:       config.Width,
+		Height:      config.Height,
+		Variants: map[object.Variant]*imagedomain.EncodedImage{
+			object.VariantOriginal: {Data: data, Width: config.Width, Height: config.Height},
+		},
+	}
+	for _, variant := range object.ResizedVariants {
+		resized := resizeToWidth(src, kind.VariantWidth(variant))
+		encoded, err := encode(resized, contentType)
+		if err != nil {
+			slog.Error("StdProcessor.Process error encoding variant", "error", err, "variant", variant)
+			return nil, err
+		}
+		processed.Variants[variant] = &imagedomain.EncodedImage{Data: encoded, Width: resized.Bounds().Dx(), Height: resized.Bounds().Dy()}
+		src = resized
+	}
+	return processed, nil
+}
+
+func encode(img image.Image, contentType string) ([]byte, error) {
+	var buffer bytes.Buffer
+	var err error
+	if contentType == "image/png" {
+		err = png.Encode(&buffer, img)
+	} else {
+		err = jpeg.Encode(&buffer, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,36 @@
+package image
+
+import (
+	"image"
+	"image/color"
+)
+
+func resizeToWidth(src image.Image, width int) image.Image {
+	bounds := src.Bounds()
+	if width <= 0 || bounds.Dx() <= width {
+		return src
+	}
+	height := max(1, bounds.Dy()*width/bounds.Dx())
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		sy0 := bounds.Min.Y + y*bounds.Dy()/height
+		sy1 := max(sy0+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+		for x := 0; x < width; x++ {
+			sx0 := bounds.Min.X + x*bounds.Dx()/width
+			sx1 := max(sx0+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			average := color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: uint16(a / n)}
+			dst.Set(x, y, average)
+		}
+	}
+	return dst
+}
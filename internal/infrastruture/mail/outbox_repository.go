@@ -0,0 +1,186 @@
+package mail
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	maildomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail"
+)
+
+const maxErrorLength = 1000
+
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+func (o *OutboxRepository) Enqueue(ctx context.Context, message maildomain.Message) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = o.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("OutboxRepo.Enqueue Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("OutboxRepo.Enqueue Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO email_outbox (recipient, subject, body) VALUES ($1, $2, $3)`, message.To, message.Subject, message.Body)
+	if err != nil {
+		slog.Error("OutboxRepo.Enqueue Insert Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("OutboxRepo.Enqueue Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (o *OutboxRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*maildomain.OutboxMessage, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = o.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("OutboxRepo.ClaimPending Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("OutboxRepo.ClaimPending Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `UPDATE email_outbox SET next_attempt_at = now() + make_interval(secs => $2), attempts = attempts + 1
+WHERE id IN (
+    SELECT id FROM email_outbox
+    WHERE sent_at IS NULL AND next_attempt_at <= now() AND attempts < $3
+    ORDER BY id
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, recipient, subject, body, attempts`
+	rows, err := tx.QueryContext(ctx, query, limit, lease.Seconds(), maildomain.MaxAttempts)
+	if err != nil {
+		slog.Error("OutboxRepo.ClaimPending Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]*maildomain.OutboxMessage, 0, limit)
+	for rows.Next() {
+		message := &maildomain.OutboxMessage{}
+		err = rows.Scan(&message.ID, &message.To, &message.Subject, &message.Body, &message.Attempts)
+		if err != nil {
+			slog.Error("OutboxRepo.ClaimPending Scan Error", "Error", err)
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("OutboxRepo.ClaimPending Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("OutboxRepo.ClaimPending Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return messages, nil
+}
+
+func (o *OutboxRepository) MarkSent(ctx context.Context, id int64) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = o.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("OutboxRepo.MarkSent Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("OutboxRepo.MarkSent Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE email_outbox SET sent_at = now(), last_error = '' WHERE id = $1`, id)
+	if err != nil {
+		slog.Error("OutboxRepo.MarkSent Update Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("OutboxRepo.MarkSent Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (o *OutboxRepository) MarkFailed(ctx context.Context, id int64, reason string, nextAttemptAt time.Time) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = o.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("OutboxRepo.MarkFailed Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("OutboxRepo.MarkFailed Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	if len(reason) > maxErrorLength {
+		reason = strings.ToValidUTF8(reason[:maxErrorLength], "")
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE email_outbox SET last_error = $2, next_attempt_at = $3 WHERE id = $1`, id, reason, nextAttemptAt)
+	if err != nil {
+		slog.Error("OutboxRepo.MarkFailed Update Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("OutboxRepo.MarkFailed Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
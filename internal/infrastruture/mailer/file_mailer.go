@@ -0,0 +1,58 @@
+package mailer
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	maildomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/mailer/mailerconfig"
+)
+
+const defaultMailFrom = "Movies <no-reply@localhost>"
+
+type FileMailer struct {
+	mu   sync.Mutex
+	path string
+	from string
+}
+
+func NewFileMailer(config mailerconfig.MailerConfig) (*FileMailer, error) {
+	if config.FilePath == "" {
+		slog.Error("File mailer path is empty")
+		return nil, error2.ErrMailerIsNotConfigured
+	}
+	if err := os.MkdirAll(filepath.Dir(config.FilePath), 0o755); err != nil {
+		slog.Error("Error creating mail directory", "error", err)
+		return nil, err
+	}
+	from := config.From
+	if from == "" {
+		from = defaultMailFrom
+	}
+	return &FileMailer{path: config.FilePath, from: from}, nil
+}
+
+func (f *FileMailer) Send(ctx context.Context, message maildomain.Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		slog.Error("FileMailer.Send failed to open file", "error", err)
+		return err
+	}
+	defer file.Close()
+
+	data := append(formatMessage(f.from, message, time.Now()), []byte("\r\n.\r\n\r\n")...)
+	if _, err = file.Write(data); err != nil {
+		slog.Error("FileMailer.Send failed to write message", "error", err)
+		return err
+	}
+	slog.Debug("FileMailer.Send message written", "to", message.To, "path", f.path)
+	return nil
+}
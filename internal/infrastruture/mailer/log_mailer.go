@@ -0,0 +1,19 @@
+package mailer
+
+import (
+	"context"
+	"log/slog"
+
+	maildomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail"
+)
+
+type LogMailer struct{}
+
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (l *LogMailer) Send(ctx context.Context, message maildomain.Message) error {
+	slog.Info("LogMailer.Send", "to", message.To, "subject", message.Subject, "body", message.Body)
+	return nil
+}
@@ -0,0 +1,31 @@
+package mailer
+
+import (
+	"log/slog"
+
+	maildomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/mailer/mailerconfig"
+)
+
+const (
+	ProviderSMTP = "smtp"
+	ProviderFile = "file"
+	ProviderLog  = "log"
+)
+
+func NewMailer(config mailerconfig.MailerConfig) (maildomain.Mailer, error) {
+	switch config.Provider {
+	case "":
+		slog.Info("Mailer is disabled")
+		return nil, nil
+	case ProviderSMTP:
+		return NewSMTPMailer(config)
+	case ProviderFile:
+		return NewFileMailer(config)
+	case ProviderLog:
+		return NewLogMailer(), nil
+	}
+	slog.Error("Unknown mailer provider", "provider", config.Provider)
+	return nil, error2.ErrMailerIsNotConfigured
+}
@@ -0,0 +1,17 @@
+package mailerconfig
+
+import "time"
+
+type MailerConfig struct {
+	Provider    string        `yaml:"provider"`
+	From        string        `yaml:"from"`
+	Host        string        `yaml:"host"`
+	Port        int           `yaml:"port"`
+	Username    string        `yaml:"username"`
+	Password    string        `yaml:"password"`
+	ImplicitTLS bool          `yaml:"implicit_tls"`
+	FilePath    string        `yaml:"file_path"`
+	Timeout     time.Duration `yaml:"timeout"`
+	JobInterval time.Duration `yaml:"job_interval"`
+	BatchSize   int           `yaml:"batch_size"`
+}
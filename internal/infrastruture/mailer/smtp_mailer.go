@@ -0,0 +1,110 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"log/slog"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	maildomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/mail/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/mailer/mailerconfig"
+)
+
+const defaultSMTPTimeout = 30 * time.Second
+
+type SMTPMailer struct {
+	host        string
+	addr        string
+	from        *mail.Address
+	auth        smtp.Auth
+	implicitTLS bool
+	timeout     time.Duration
+}
+
+func NewSMTPMailer(config mailerconfig.MailerConfig) (*SMTPMailer, error) {
+	from, err := mail.ParseAddress(config.From)
+	if err != nil || config.Host == "" || config.Port <= 0 {
+		slog.Error("SMTP mailer is not configured", "host", config.Host, "port", config.Port, "from", config.From)
+		return nil, error2.ErrMailerIsNotConfigured
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultSMTPTimeout
+	}
+	var auth smtp.Auth
+	if config.Username != "" {
+		auth = smtp.PlainAuth("", config.Username, config.Password, config.Host)
+	}
+	return &SMTPMailer{host: config.Host, addr: net.JoinHostPort(config.Host, strconv.Itoa(config.Port)), from: from, auth: auth,
+		implicitTLS: config.ImplicitTLS, timeout: timeout}, nil
+}
+
+func (s *SMTPMailer) Send(ctx context.Context, message maildomain.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	err := s.send(ctx, message)
+	if err != nil {
+		slog.Error("SMTPMailer.Send failed", "error", err, "to", message.To)
+		return error2.ErrMailDeliveryFailed
+	}
+	slog.Debug("SMTPMailer.Send message sent", "to", message.To)
+	return nil
+}
+
+func (s *SMTPMailer) send(ctx context.Context, message maildomain.Message) error {
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	if s.implicitTLS {
+		conn = tls.Client(conn, &tls.Config{ServerName: s.host})
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if !s.implicitTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err = client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+				return err
+			}
+		}
+	}
+	if s.auth != nil {
+		if err = client.Auth(s.auth); err != nil {
+			return err
+		}
+	}
+	if err = client.Mail(s.from.Address); err != nil {
+		return err
+	}
+	if err = client.Rcpt(message.To); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = writer.Write(formatMessage(s.from.String(), message, time.Now())); err != nil {
+		_ = writer.Close()
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
@@ -0,0 +1,108 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"strings"
+
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	personobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+)
+
+type fileCredit struct {
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Character string `json:"character"`
+}
+
+type fileMovie struct {
+	Title          string            `json:"title"`
+	Year           int               `json:"year"`
+	ExternalIDs    map[string]string `json:"external_ids"`
+	Description    string            `json:"description"`
+	RuntimeMinutes int               `json:"runtime_minutes"`
+	Countries      []string          `json:"countries"`
+	Languages      []string          `json:"languages"`
+	PosterURL      string            `json:"poster_url"`
+	Genres         []string          `json:"genres"`
+	Credits        []fileCredit      `json:"credits"`
+
+	OriginalLanguage string            `json:"original_language"`
+	Certifications   map[string]string `json:"certifications"`
+	Budget           int64             `json:"budget"`
+	BoxOffice        int64             `json:"box_office"`
+	Tagline          string            `json:"tagline"`
+}
+
+type FileProvider struct {
+	movies []fileMovie
+}
+
+func NewFileProvider(path string) (*FileProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		slog.Error("FileProvider error reading metadata file", "error", err, "path", path)
+		return nil, err
+	}
+
+	var file struct {
+		Movies []fileMovie `json:"movies"`
+	}
+	if err = json.Unmarshal(data, &file); err != nil {
+		slog.Error("FileProvider error parsing metadata file", "error", err, "path", path)
+		return nil, err
+	}
+	return &FileProvider{movies: file.Movies}, nil
+}
+
+func (f *FileProvider) Name() string {
+	return ProviderFile
+}
+
+func (f *FileProvider) FetchMetadata(ctx context.Context, movie *moviedomain.Movie) (*moviedomain.Metadata, error) {
+	for _, candidate := range f.movies {
+		if !f.matches(candidate, movie) {
+			continue
+		}
+
+		credits := make([]*persondomain.Credit, 0, len(candidate.Credits))
+		for _, credit := range candidate.Credits {
+			role, err := personobject.NewCreditRole(credit.Role)
+			if err != nil {
+				slog.Error("FileProvider.FetchMetadata invalid credit role", "error", err, "role", credit.Role)
+				return nil, err
+			}
+			credits = append(credits, persondomain.NewCredit(personobject.PersonID{}, credit.Name, role, credit.Character))
+		}
+		return &moviedomain.Metadata{
+			ExternalIDs:    candidate.ExternalIDs,
+			Description:    candidate.Description,
+			RuntimeMinutes: candidate.RuntimeMinutes,
+			Countries:      candidate.Countries,
+			Languages:      candidate.Languages,
+			PosterURL:      candidate.PosterURL,
+			Genres:         candidate.Genres,
+			Credits:        credits,
+
+			OriginalLanguage: candidate.OriginalLanguage,
+			Certifications:   candidate.Certifications,
+			Budget:           candidate.Budget,
+			BoxOffice:        candidate.BoxOffice,
+			Tagline:          candidate.Tagline,
+		}, nil
+	}
+	return nil, error2.ErrMetadataIsNotFound
+}
+
+func (f *FileProvider) matches(candidate fileMovie, movie *moviedomain.Movie) bool {
+	for key, value := range candidate.ExternalIDs {
+		if movie.ExternalIDs[key] == value {
+			return true
+		}
+	}
+	return strings.EqualFold(candidate.Title, movie.Title) && candidate.Year == movie.ReleaseDate.Year()
+}
@@ -0,0 +1,16 @@
+package metadataconfig
+
+import "time"
+
+type MetadataConfig struct {
+	Provider     string        `yaml:"provider"`
+	BaseURL      string        `yaml:"base_url"`
+	ImageBaseURL string        `yaml:"image_base_url"`
+	APIKey       string        `yaml:"api_key"`
+	AccessToken  string        `yaml:"access_token"`
+	Timeout      time.Duration `yaml:"timeout"`
+	FilePath     string        `yaml:"file_path"`
+	JobInterval  time.Duration `yaml:"job_interval"`
+	RefreshAfter time.Duration `yaml:"refresh_after"`
+	JobBatchSize int           `yaml:"job_batch_size"`
+}
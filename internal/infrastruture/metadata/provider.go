@@ -0,0 +1,32 @@
+package metadata
+
+import (
+	"log/slog"
+
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/metadata/metadataconfig"
+)
+
+const (
+	ProviderTMDB = "tmdb"
+	ProviderFile = "file"
+)
+
+func NewMetadataProvider(config metadataconfig.MetadataConfig) (moviedomain.MetadataProvider, error) {
+	switch config.Provider {
+	case "":
+		slog.Info("Metadata provider is disabled")
+		return nil, nil
+	case ProviderTMDB:
+		return NewTMDBProvider(config), nil
+	case ProviderFile:
+		provider, err := NewFileProvider(config.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		return provider, nil
+	}
+	slog.Error("Unknown metadata provider", "provider", config.Provider)
+	return nil, error2.ErrMetadataProviderIsNotConfigured
+}
@@ -0,0 +1,235 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	personobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/metadata/metadataconfig"
+)
+
+const (
+	defaultTMDBBaseURL      = "https://api.themoviedb.org/3"
+	defaultTMDBImageBaseURL = "https://image.tmdb.org/t/p/original"
+	defaultTMDBTimeout      = 10 * time.Second
+	maxTMDBCast             = 15
+)
+
+var tmdbCrewRoles = map[string]personobject.CreditRole{
+	"Director":                personobject.CreditRoleDirector,
+	"Screenplay":              personobject.CreditRoleWriter,
+	"Writer":                  personobject.CreditRoleWriter,
+	"Original Music Composer": personobject.CreditRoleComposer,
+}
+
+type tmdbSearchResponse struct {
+	Results []struct {
+		ID int `json:"id"`
+	} `json:"results"`
+}
+
+type tmdbMovieResponse struct {
+	ID                  int    `json:"id"`
+	IMDbID              string `json:"imdb_id"`
+	Overview            string `json:"overview"`
+	Runtime             int    `json:"runtime"`
+	PosterPath          string `json:"poster_path"`
+	OriginalLanguage    string `json:"original_language"`
+	Tagline             string `json:"tagline"`
+	Budget              int64  `json:"budget"`
+	Revenue             int64  `json:"revenue"`
+	ProductionCountries []struct {
+		Code string `json:"iso_3166_1"`
+	} `json:"production_countries"`
+	SpokenLanguages []struct {
+		Code string `json:"iso_639_1"`
+	} `json:"spoken_languages"`
+	Genres []struct {
+		Name string `json:"name"`
+	} `json:"genres"`
+	Credits struct {
+		Cast []struct {
+			Name      string `json:"name"`
+			Character string `json:"character"`
+		} `json:"cast"`
+		Crew []struct {
+			Name string `json:"name"`
+			Job  string `json:"job"`
+		} `json:"crew"`
+	} `json:"credits"`
+	ReleaseDates struct {
+		Results []struct {
+			Country      string `json:"iso_3166_1"`
+			ReleaseDates []struct {
+				Certification string `json:"certification"`
+			} `json:"release_dates"`
+		} `json:"results"`
+	} `json:"release_dates"`
+}
+
+type TMDBProvider struct {
+	client       *http.Client
+	baseURL      string
+	imageBaseURL string
+	apiKey       string
+	accessToken  string
+}
+
+func NewTMDBProvider(config metadataconfig.MetadataConfig) *TMDBProvider {
+	provider := &TMDBProvider{
+		client:       &http.Client{Timeout: config.Timeout},
+		baseURL:      strings.TrimRight(config.BaseURL, "/"),
+		imageBaseURL: strings.TrimRight(config.ImageBaseURL, "/"),
+		apiKey:       config.APIKey,
+		accessToken:  config.AccessToken,
+	}
+	if provider.client.Timeout == 0 {
+		provider.client.Timeout = defaultTMDBTimeout
+	}
+	if provider.baseURL == "" {
+		provider.baseURL = defaultTMDBBaseURL
+	}
+	if provider.imageBaseURL == "" {
+		provider.imageBaseURL = defaultTMDBImageBaseURL
+	}
+	return provider
+}
+
+func (t *TMDBProvider) Name() string {
+	return ProviderTMDB
+}
+
+func (t *TMDBProvider) FetchMetadata(ctx context.Context, movie *moviedomain.Movie) (*moviedomain.Metadata, error) {
+	tmdbID := movie.ExternalIDs[ProviderTMDB]
+	if tmdbID == "" {
+		var err error
+		tmdbID, err = t.searchID(ctx, movie.Title, movie.ReleaseDate.Year())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var details tmdbMovieResponse
+	err := t.get(ctx, "/movie/"+url.PathEscape(tmdbID), url.Values{"append_to_response": {"credits,release_dates"}}, &details)
+	if err != nil {
+		return nil, err
+	}
+	return t.toMetadata(details), nil
+}
+
+func (t *TMDBProvider) searchID(ctx context.Context, title string, year int) (string, error) {
+	var search tmdbSearchResponse
+	params := url.Values{"query": {title}, "primary_release_year": {strconv.Itoa(year)}}
+	err := t.get(ctx, "/search/movie", params, &search)
+	if err != nil {
+		return "", err
+	}
+	if len(search.Results) == 0 {
+		return "", error2.ErrMetadataIsNotFound
+	}
+	return strconv.Itoa(search.Results[0].ID), nil
+}
+
+func (t *TMDBProvider) get(ctx context.Context, path string, params url.Values, dest any) error {
+	if t.apiKey != "" {
+		params.Set("api_key", t.apiKey)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, t.baseURL+path+"?"+params.Encode(), nil)
+	if err != nil {
+		slog.Error("TMDBProvider.get error creating request", "error", err, "path", path)
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if t.accessToken != "" {
+		request.Header.Set("Authorization", "Bearer "+t.accessToken)
+	}
+
+	response, err := t.client.Do(request)
+	if err != nil {
+		slog.Error("TMDBProvider.get request failed", "error", err, "path", path)
+		return error2.ErrMetadataProviderRequestFailed
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return error2.ErrMetadataIsNotFound
+	}
+	if response.StatusCode != http.StatusOK {
+		slog.Error("TMDBProvider.get unexpected status", "status", response.StatusCode, "path", path)
+		return error2.ErrMetadataProviderRequestFailed
+	}
+
+	if err = json.NewDecoder(response.Body).Decode(dest); err != nil {
+		slog.Error("TMDBProvider.get error decoding response", "error", err, "path", path)
+		return error2.ErrMetadataProviderRequestFailed
+	}
+	return nil
+}
+
+func (t *TMDBProvider) toMetadata(details tmdbMovieResponse) *moviedomain.Metadata {
+	metadata := &moviedomain.Metadata{
+		ExternalIDs:    map[string]string{ProviderTMDB: strconv.Itoa(details.ID)},
+		Description:    strings.TrimSpace(details.Overview),
+		RuntimeMinutes: details.Runtime,
+		Countries:      make([]string, 0, len(details.ProductionCountries)),
+		Languages:      make([]string, 0, len(details.SpokenLanguages)),
+		Genres:         make([]string, 0, len(details.Genres)),
+		Credits:        make([]*persondomain.Credit, 0),
+
+		OriginalLanguage: details.OriginalLanguage,
+		Certifications:   make(map[string]string),
+		Budget:           details.Budget,
+		BoxOffice:        details.Revenue,
+		Tagline:          strings.TrimSpace(details.Tagline),
+	}
+	if details.IMDbID != "" {
+		metadata.ExternalIDs["imdb"] = details.IMDbID
+	}
+	if details.PosterPath != "" {
+		metadata.PosterURL = t.imageBaseURL + details.PosterPath
+	}
+	for _, country := range details.ProductionCountries {
+		metadata.Countries = append(metadata.Countries, country.Code)
+	}
+	for _, language := range details.SpokenLanguages {
+		metadata.Languages = append(metadata.Languages, language.Code)
+	}
+	for _, genre := range details.Genres {
+		metadata.Genres = append(metadata.Genres, genre.Name)
+	}
+	for _, country := range details.ReleaseDates.Results {
+		for _, release := range country.ReleaseDates {
+			if certification := strings.TrimSpace(release.Certification); certification != "" {
+				metadata.Certifications[country.Country] = certification
+				break
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, member := range details.Credits.Crew {
+		role, ok := tmdbCrewRoles[member.Job]
+		key := string(role) + "|" + member.Name
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		metadata.Credits = append(metadata.Credits, persondomain.NewCredit(personobject.PersonID{}, member.Name, role, ""))
+	}
+	for i, member := range details.Credits.Cast {
+		if i == maxTMDBCast {
+			break
+		}
+		metadata.Credits = append(metadata.Credits, persondomain.NewCredit(personobject.PersonID{}, member.Name, personobject.CreditRoleActor, member.Character))
+	}
+	return metadata
+}
@@ -0,0 +1,157 @@
+package movie
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+func (m *MovieRepository) GetRatingMean(ctx context.Context) (float64, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetRatingMean Begin Tx Error", "Error", err)
+			return 0, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetRatingMean Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT COALESCE(SUM(rating_sum)::float8 / NULLIF(SUM(rating_count), 0), 0) FROM movie_stats`
+	var mean float64
+	err = tx.QueryRowContext(ctx, query).Scan(&mean)
+	if err != nil {
+		slog.Error("MovieRepo.GetRatingMean Query Error", "Error", err)
+		return 0, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetRatingMean Commit Error", "Error", commitErr)
+			return 0, commitErr
+		}
+	}
+	return mean, nil
+}
+
+func (m *MovieRepository) GetRatingHistogram(ctx context.Context, movieID object.MovieID) (map[int]int, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetRatingHistogram Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetRatingHistogram Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT user_rating, COUNT(*) FROM user_movies
+WHERE movie_id = $1 AND user_rating != 0
+GROUP BY user_rating`
+	rows, err := tx.QueryContext(ctx, query, movieID.ID())
+	if err != nil {
+		slog.Error("MovieRepo.GetRatingHistogram Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[int]int)
+	for rows.Next() {
+		var rating, count int
+		err = rows.Scan(&rating, &count)
+		if err != nil {
+			slog.Error("MovieRepo.GetRatingHistogram Scan Error", "Error", err)
+			return nil, err
+		}
+		counts[rating] = count
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("MovieRepo.GetRatingHistogram Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetRatingHistogram Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return counts, nil
+}
+
+func (m *MovieRepository) TopRated(ctx context.Context, query object.TopRatedQuery, stats moviedomain.RatingStats) ([]*moviedomain.Movie, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.TopRated Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.TopRated Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	conditions, args := movieFilterConditions(query.Filter)
+	args = append(args, max(query.MinVotes, 1))
+	conditions = append(conditions, fmt.Sprintf("r.rating_count >= $%d", len(args)))
+	args = append(args, stats.MinVotes, stats.Mean, query.Limit)
+	weighted := fmt.Sprintf("(r.rating_count * r.rating + $%d::float8 * $%d::float8) / (r.rating_count + $%d::float8)", len(args)-2, len(args)-1, len(args)-2)
+	sqlQuery := selectMovieQuery + whereClause(conditions) +
+		fmt.Sprintf("\nORDER BY %s DESC, r.rating_count DESC, m.title, m.id\nLIMIT $%d", weighted, len(args))
+	rows, err := tx.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		slog.Error("MovieRepo.TopRated Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	movies := make([]*moviedomain.Movie, 0)
+	for rows.Next() {
+		movie, scanErr := scanMovie(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("MovieRepo.TopRated Scan Error", "Error", err)
+			return nil, err
+		}
+		movies = append(movies, movie)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("MovieRepo.TopRated Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.TopRated Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return movies, nil
+}
@@ -0,0 +1,154 @@
+package movie
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+func (m *MovieRepository) GetRelatedMovies(ctx context.Context, movieID object.MovieID) ([]*moviedomain.RelatedMovie, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetRelatedMovies Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetRelatedMovies Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT mr.relation_type, m.id, m.slug, m.title, m.release_date
+FROM movie_relations AS mr
+JOIN movies AS m ON m.id = mr.related_movie_id
+WHERE mr.movie_id = $1
+ORDER BY m.release_date, m.title`
+	rows, err := tx.QueryContext(ctx, query, movieID.ID())
+	if err != nil {
+		slog.Error("MovieRepo.GetRelatedMovies Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	related := make([]*moviedomain.RelatedMovie, 0)
+	for rows.Next() {
+		var relationType, id string
+		relatedMovie := &moviedomain.RelatedMovie{}
+		err = rows.Scan(&relationType, &id, &relatedMovie.Slug, &relatedMovie.Title, &relatedMovie.ReleaseDate)
+		if err != nil {
+			slog.Error("MovieRepo.GetRelatedMovies Scan Error", "Error", err)
+			return nil, err
+		}
+		relatedMovie.Type = object.RelationType(relationType)
+		relatedMovie.MovieID, _ = object.NewMovieID(id)
+		related = append(related, relatedMovie)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("MovieRepo.GetRelatedMovies Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetRelatedMovies Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return related, nil
+}
+
+func (m *MovieRepository) SaveRelatedMovie(ctx context.Context, movieID object.MovieID, relatedID object.MovieID, relationType object.RelationType) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.SaveRelatedMovie Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.SaveRelatedMovie Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO movie_relations (movie_id, related_movie_id, relation_type)
+VALUES ($1, $2, $3), ($2, $1, $4)
+ON CONFLICT (movie_id, related_movie_id) DO UPDATE SET relation_type = EXCLUDED.relation_type`
+	_, err = tx.ExecContext(ctx, query, movieID.ID(), relatedID.ID(), relationType.String(), relationType.Inverse().String())
+	if err != nil {
+		slog.Error("MovieRepo.SaveRelatedMovie Exec Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.SaveRelatedMovie Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (m *MovieRepository) DeleteRelatedMovie(ctx context.Context, movieID object.MovieID, relatedID object.MovieID) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.DeleteRelatedMovie Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.DeleteRelatedMovie Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `DELETE FROM movie_relations
+WHERE (movie_id = $1 AND related_movie_id = $2) OR (movie_id = $2 AND related_movie_id = $1)`
+	result, execErr := tx.ExecContext(ctx, query, movieID.ID(), relatedID.ID())
+	if execErr != nil {
+		err = execErr
+		slog.Error("MovieRepo.DeleteRelatedMovie Exec Error", "Error", execErr)
+		return err
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("MovieRepo.DeleteRelatedMovie RowsAffected Error", "Error", rowsErr)
+		return err
+	}
+	if rowsAffected == 0 {
+		err = error2.ErrMovieRelationIsNotFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.DeleteRelatedMovie Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
@@ -3,17 +3,114 @@ package movie
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	imageobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/image/object"
 	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
 	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
 	"github.com/lib/pq"
 )
 
+const (
+	slugIndex             = "idx_movies_slug"
+	titleReleaseDateIndex = "movies_title_release_date_key"
+)
+
+const movieGenres = `ARRAY(SELECT g.name FROM movie_genres AS mg JOIN genres AS g ON g.id = mg.genre_id
+             WHERE mg.movie_id = m.id ORDER BY g.name)`
+
+const movieColumns = `m.id, m.slug, m.title, m.description, m.release_date, m.director, m.actors, ` + movieGenres + `,
+       COALESCE(r.rating, 0), r.rating_count, m.runtime_minutes, m.countries, m.languages, m.poster_url,
+       m.external_ids, m.field_sources, m.enriched_at,
+       (SELECT mi.id FROM movie_images AS mi WHERE mi.movie_id = m.id AND mi.kind = 'poster'),
+       (SELECT mi.id FROM movie_images AS mi WHERE mi.movie_id = m.id AND mi.kind = 'backdrop'),
+       m.original_language, m.certifications, m.budget, m.box_office, m.tagline,
+       r.review_count, r.favorite_count, r.watchlist_count`
+
+const movieStatsJoin = `
+LEFT JOIN movie_stats AS ms ON ms.movie_id = m.id
+CROSS JOIN LATERAL (SELECT ms.rating_sum::float8 / NULLIF(ms.rating_count, 0) AS rating,
+                           COALESCE(ms.rating_count, 0)::bigint AS rating_count,
+                           COALESCE(ms.review_count, 0) AS review_count,
+                           COALESCE(ms.favorite_count, 0) AS favorite_count,
+                           COALESCE(ms.watchlist_count, 0) AS watchlist_count) AS r`
+
+const selectMovieQuery = `SELECT ` + movieColumns + `
+FROM movies AS m` + movieStatsJoin
+
+const movieSnippet = `ts_headline('english',
+           concat_ws(' ', m.description, m.director, array_to_string(m.actors, ', '), array_to_string(` + movieGenres + `, ', ')),
+           q.query, 'StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=25, MinWords=8')`
+
+var movieSortColumns = map[object.MovieSort]string{
+	object.MovieSortTitle:       "m.title",
+	object.MovieSortReleaseDate: "m.release_date",
+	object.MovieSortRating:      "COALESCE(r.rating, 0)",
+	object.MovieSortRatingCount: "r.rating_count",
+}
+
+var movieSortCasts = map[object.MovieSort]string{
+	object.MovieSortTitle:       "text",
+	object.MovieSortReleaseDate: "date",
+	object.MovieSortRating:      "float8",
+	object.MovieSortRatingCount: "bigint",
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMovie(row rowScanner, extra ...any) (*moviedomain.Movie, error) {
+	var id, slug string
+	var description, director sql.NullString
+	var externalIDs, sources, certifications []byte
+	var enrichedAt sql.NullTime
+	var posterImage, backdropImage sql.NullString
+	movie := &moviedomain.Movie{Actors: make([]string, 0), Genres: make([]string, 0), Countries: make([]string, 0), Languages: make([]string, 0)}
+	dest := []any{&id, &slug, &movie.Title, &description, &movie.ReleaseDate, &director, pq.Array(&movie.Actors), pq.Array(&movie.Genres), &movie.Rating, &movie.RatingCount,
+		&movie.RuntimeMinutes, pq.Array(&movie.Countries), pq.Array(&movie.Languages), &movie.PosterURL, &externalIDs, &sources, &enrichedAt, &posterImage, &backdropImage,
+		&movie.OriginalLanguage, &certifications, &movie.Budget, &movie.BoxOffice, &movie.Tagline,
+		&movie.ReviewCount, &movie.FavoriteCount, &movie.WatchlistCount}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+	movie.Description = description.String
+	movie.Director = director.String
+	movie.EnrichedAt = enrichedAt.Time
+	movie.PosterImage, _ = imageobject.NewImageID(posterImage.String)
+	movie.BackdropImage, _ = imageobject.NewImageID(backdropImage.String)
+	if err = json.Unmarshal(externalIDs, &movie.ExternalIDs); err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(sources, &movie.Sources); err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(certifications, &movie.Certifications); err != nil {
+		return nil, err
+	}
+	if movie.ExternalIDs == nil {
+		movie.ExternalIDs = make(map[string]string)
+	}
+	if movie.Sources == nil {
+		movie.Sources = make(map[object.MetadataField]string)
+	}
+	if movie.Certifications == nil {
+		movie.Certifications = make(map[string]string)
+	}
+	movieID, _ := object.NewMovieID(id)
+	_ = movie.SetID(movieID)
+	_ = movie.SetSlug(slug)
+	return movie, nil
+}
+
 type MovieRepository struct {
 	db *sql.DB
 }
@@ -65,57 +162,203 @@ func NewMovieRepository(db *sql.DB) *MovieRepository {
 	return &MovieRepository{db: db}
 }
 
-func (m *MovieRepository) GetAll(ctx context.Context) ([]*moviedomain.Movie, error) {
+func (m *MovieRepository) List(ctx context.Context, listQuery object.MovieListQuery) ([]*moviedomain.Movie, error) {
 	var err error
 	tx, ok := transactionmanager.GetTxFromCtx(ctx)
 	if !ok {
 		tx, err = m.db.BeginTx(ctx, nil)
 		if err != nil {
-			slog.Error("MovieRepo.GetAll Begin Tx Error", "Error", err)
+			slog.Error("MovieRepo.List Begin Tx Error", "Error", err)
 			return nil, err
 		}
 		defer func() {
 			if err != nil {
 				if rollbackErr := tx.Rollback(); rollbackErr != nil {
-					slog.Error("MovieRepo.GetAll Rollback Error", "Error", rollbackErr)
+					slog.Error("MovieRepo.List Rollback Error", "Error", rollbackErr)
 				}
 			}
 		}()
 	}
 
-	query := `SELECT m.id, m.title, m.description, m.release_date, m.director, m.actors, m.genres,
-       COALESCE((SELECT AVG(um.user_rating)
-                 FROM user_movies as um
-                 WHERE um.movie_id = m.id AND um.user_rating !=0 ), 0)
-                 FROM movies as m`
-	rows, err := tx.QueryContext(ctx, query)
+	conditions, args := movieFilterConditions(listQuery.Filter)
+	sortColumn := movieSortColumns[listQuery.Sort]
+	direction, comparison := "ASC", ">"
+	if listQuery.Order == object.SortOrderDesc {
+		direction, comparison = "DESC", "<"
+	}
+	if listQuery.Cursor != nil {
+		args = append(args, listQuery.Cursor.Value, listQuery.Cursor.ID)
+		conditions = append(conditions, fmt.Sprintf("(%s, m.id) %s ($%d::%s, $%d::uuid)",
+			sortColumn, comparison, len(args)-1, movieSortCasts[listQuery.Sort], len(args)))
+	}
+	args = append(args, listQuery.Limit+1)
+
+	query := selectMovieQuery + whereClause(conditions) +
+		fmt.Sprintf(" ORDER BY %s %s, m.id %s LIMIT $%d", sortColumn, direction, direction, len(args))
+	rows, err := tx.QueryContext(ctx, query, args...)
 	if err != nil {
-		slog.Error("MovieRepo.GetAll Query Error", "Error", err)
+		slog.Error("MovieRepo.List Query Error", "Error", err)
 		return nil, err
 	}
 	defer rows.Close()
 
-	moviesInfos := make([]*moviedomain.Movie, 0)
+	movies := make([]*moviedomain.Movie, 0, listQuery.Limit+1)
 	for rows.Next() {
-		var id string
-		movie := &moviedomain.Movie{Actors: make([]string, 0), Genres: make([]string, 0)}
-		err = rows.Scan(&id, &movie.Title, &movie.Description, &movie.ReleaseDate, &movie.Director, pq.Array(&movie.Actors), pq.Array(&movie.Genres), &movie.Rating)
-		if err != nil {
-			slog.Error("MovieRepo.GetAll Scan Error", "Error", err)
+		movie, scanErr := scanMovie(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("MovieRepo.List Scan Error", "Error", err)
 			return nil, err
 		}
-		movieID, _ := object.NewMovieID(id)
-		_ = movie.SetID(movieID)
-		moviesInfos = append(moviesInfos, movie)
+		movies = append(movies, movie)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("MovieRepo.List Rows Error", "Error", err)
+		return nil, err
 	}
 	if !ok {
 		if commitErr := tx.Commit(); commitErr != nil {
 			_ = tx.Rollback()
-			slog.Error("MovieRepo.GetAll Commit Error", "Error", commitErr)
+			slog.Error("MovieRepo.List Commit Error", "Error", commitErr)
 			return nil, commitErr
 		}
 	}
-	return moviesInfos, nil
+	return movies, nil
+}
+
+func (m *MovieRepository) Count(ctx context.Context, filter object.MovieFilter) (int, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.Count Begin Tx Error", "Error", err)
+			return 0, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.Count Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	conditions, args := movieFilterConditions(filter)
+	query := `SELECT COUNT(*) FROM movies AS m`
+	if filter.MinRating > 0 {
+		query += movieStatsJoin
+	}
+	var total int
+	err = tx.QueryRowContext(ctx, query+whereClause(conditions), args...).Scan(&total)
+	if err != nil {
+		slog.Error("MovieRepo.Count Query Error", "Error", err)
+		return 0, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.Count Commit Error", "Error", commitErr)
+			return 0, commitErr
+		}
+	}
+	return total, nil
+}
+
+func movieFilterConditions(filter object.MovieFilter) ([]string, []any) {
+	conditions := make([]string, 0)
+	args := make([]any, 0)
+	if filter.Genre != "" {
+		args = append(args, filter.Genre)
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (SELECT 1 FROM movie_genres AS mg JOIN genres AS g ON g.id = mg.genre_id
+        WHERE mg.movie_id = m.id AND (g.slug = $%d OR lower(g.name) = lower($%d)))`, len(args), len(args)))
+	}
+	if filter.Actor != "" {
+		args = append(args, pq.Array([]string{filter.Actor}))
+		conditions = append(conditions, fmt.Sprintf("m.actors @> $%d", len(args)))
+	}
+	if filter.Director != "" {
+		args = append(args, filter.Director)
+		conditions = append(conditions, fmt.Sprintf("lower(m.director) = lower($%d)", len(args)))
+	}
+	if filter.YearFrom != 0 {
+		args = append(args, time.Date(filter.YearFrom, time.January, 1, 0, 0, 0, 0, time.UTC))
+		conditions = append(conditions, fmt.Sprintf("m.release_date >= $%d", len(args)))
+	}
+	if filter.YearTo != 0 {
+		args = append(args, time.Date(filter.YearTo+1, time.January, 1, 0, 0, 0, 0, time.UTC))
+		conditions = append(conditions, fmt.Sprintf("m.release_date < $%d", len(args)))
+	}
+	if filter.MinRating > 0 {
+		args = append(args, filter.MinRating)
+		conditions = append(conditions, fmt.Sprintf("COALESCE(r.rating, 0) >= $%d", len(args)))
+	}
+	conditions, args = movieDetailsConditions(filter, conditions, args)
+	return conditions, args
+}
+
+func movieDetailsConditions(filter object.MovieFilter, conditions []string, args []any) ([]string, []any) {
+	ranges := []struct {
+		column string
+		value  int64
+		op     string
+	}{
+		{"m.runtime_minutes", int64(filter.RuntimeMin), ">="},
+		{"m.runtime_minutes", int64(filter.RuntimeMax), "<="},
+		{"m.budget", filter.BudgetMin, ">="},
+		{"m.budget", filter.BudgetMax, "<="},
+		{"m.box_office", filter.BoxOfficeMin, ">="},
+		{"m.box_office", filter.BoxOfficeMax, "<="},
+	}
+	for _, r := range ranges {
+		if r.value == 0 {
+			continue
+		}
+		args = append(args, r.value)
+		conditions = append(conditions, fmt.Sprintf("%s %s $%d", r.column, r.op, len(args)))
+	}
+	if filter.Country != "" {
+		args = append(args, pq.Array([]string{filter.Country}))
+		conditions = append(conditions, fmt.Sprintf("m.countries @> $%d", len(args)))
+	}
+	if filter.Language != "" {
+		args = append(args, pq.Array([]string{filter.Language}))
+		conditions = append(conditions, fmt.Sprintf("m.languages @> $%d", len(args)))
+	}
+	if filter.OriginalLanguage != "" {
+		args = append(args, filter.OriginalLanguage)
+		conditions = append(conditions, fmt.Sprintf("m.original_language = $%d", len(args)))
+	}
+	switch {
+	case filter.CertificationCountry != "" && filter.Certification != "":
+		args = append(args, filter.CertificationCountry, filter.Certification)
+		conditions = append(conditions, fmt.Sprintf("upper(m.certifications ->> $%d) = upper($%d)", len(args)-1, len(args)))
+	case filter.CertificationCountry != "":
+		args = append(args, filter.CertificationCountry)
+		conditions = append(conditions, fmt.Sprintf("m.certifications ? $%d", len(args)))
+	case filter.Certification != "":
+		args = append(args, filter.Certification)
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (SELECT 1 FROM jsonb_each_text(m.certifications) AS c
+        WHERE upper(c.value) = upper($%d))`, len(args)))
+	}
+	if filter.Tagline != "" {
+		args = append(args, likePattern(strings.ToLower(filter.Tagline)))
+		conditions = append(conditions, fmt.Sprintf("lower(m.tagline) LIKE $%d", len(args)))
+	}
+	return conditions, args
+}
+
+func likePattern(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return "%" + replacer.Replace(s) + "%"
+}
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
 }
 
 func (m *MovieRepository) GetByReleaseDateAndTitle(ctx context.Context, title string, year int, month int, day int) (*moviedomain.Movie, error) {
@@ -136,18 +379,9 @@ func (m *MovieRepository) GetByReleaseDateAndTitle(ctx context.Context, title st
 		}()
 	}
 
-	var id string
 	releaseDate := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
-	movie := &moviedomain.Movie{Actors: make([]string, 0), Genres: make([]string, 0)}
-	query := `
-SELECT m.id, m.title, m.description, m.release_date, m.director, m.actors, m.genres,
-       COALESCE((SELECT AVG(um.user_rating)
-                 FROM user_movies as um
-                 WHERE um.movie_id = m.id AND um.user_rating !=0 ), 0)
-                 FROM movies as m
-WHERE m.title = $1 AND m.release_date = $2
-`
-	err = tx.QueryRowContext(ctx, query, title, releaseDate).Scan(&id, &movie.Title, &movie.Description, &movie.ReleaseDate, &movie.Director, pq.Array(&movie.Actors), pq.Array(&movie.Genres), &movie.Rating)
+	query := selectMovieQuery + ` WHERE m.title = $1 AND m.release_date = $2`
+	movie, err := scanMovie(tx.QueryRowContext(ctx, query, title, releaseDate))
 	if errors.Is(err, sql.ErrNoRows) {
 		slog.Error("MovieRepo.GetByReleaseDateAndTitle Error", "Error", err, "Title", title, "Date", releaseDate)
 		return nil, error2.ErrMovieIsNotFound
@@ -157,8 +391,6 @@ WHERE m.title = $1 AND m.release_date = $2
 		return nil, err
 	}
 
-	movieID, _ := object.NewMovieID(id)
-	_ = movie.SetID(movieID)
 	if !ok {
 		if commitErr := tx.Commit(); commitErr != nil {
 			_ = tx.Rollback()
@@ -168,3 +400,603 @@ WHERE m.title = $1 AND m.release_date = $2
 	}
 	return movie, nil
 }
+
+func (m *MovieRepository) Save(ctx context.Context, movie *moviedomain.Movie) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.Save Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.Save Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	externalIDs, sources, certifications, err := marshalMetadata(movie)
+	if err != nil {
+		slog.Error("MovieRepo.Save Marshal Error", "Error", err)
+		return err
+	}
+
+	query := `INSERT INTO movies (title, description, release_date, slug, runtime_minutes, countries, languages, poster_url,
+                    external_ids, field_sources, enriched_at, original_language, certifications, budget, box_office, tagline)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+RETURNING id`
+	var newID string
+	err = transactionmanager.WithSavepoint(ctx, "movie_save", func(ctx context.Context) error {
+		return tx.QueryRowContext(ctx, query, movie.Title, movie.Description, movie.ReleaseDate, movie.Slug(), movie.RuntimeMinutes,
+			pq.Array(movie.Countries), pq.Array(movie.Languages), movie.PosterURL, externalIDs, sources, nullTime(movie.EnrichedAt),
+			movie.OriginalLanguage, certifications, movie.Budget, movie.BoxOffice, movie.Tagline).Scan(&newID)
+	})
+	if err != nil {
+		if isUniqueViolation(err, slugIndex) {
+			slog.Error("MovieRepo.Save slug already exists", "Slug", movie.Slug())
+			return error2.ErrMovieSlugAlreadyExists
+		}
+		if isUniqueViolation(err, titleReleaseDateIndex) {
+			slog.Error("MovieRepo.Save movie already exists", "Title", movie.Title, "Date", movie.ReleaseDate)
+			return error2.ErrMovieAlreadyExists
+		}
+		slog.Error("MovieRepo.Save Query Error", "Error", err)
+		return err
+	}
+
+	movieID, _ := object.NewMovieID(newID)
+	_ = movie.SetID(movieID)
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.Save Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (m *MovieRepository) Update(ctx context.Context, movie *moviedomain.Movie) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.Update Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.Update Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	externalIDs, sources, certifications, err := marshalMetadata(movie)
+	if err != nil {
+		slog.Error("MovieRepo.Update Marshal Error", "Error", err)
+		return err
+	}
+
+	query := `UPDATE movies
+SET title = $1, description = $2, release_date = $3, runtime_minutes = $4, countries = $5, languages = $6, poster_url = $7,
+    external_ids = $8, field_sources = $9, enriched_at = $10, original_language = $11, certifications = $12, budget = $13,
+    box_office = $14, tagline = $15
+WHERE id = $16`
+	result, execErr := tx.ExecContext(ctx, query, movie.Title, movie.Description, movie.ReleaseDate, movie.RuntimeMinutes,
+		pq.Array(movie.Countries), pq.Array(movie.Languages), movie.PosterURL, externalIDs, sources, nullTime(movie.EnrichedAt),
+		movie.OriginalLanguage, certifications, movie.Budget, movie.BoxOffice, movie.Tagline, movie.ID().ID())
+	if execErr != nil {
+		err = execErr
+		if isUniqueViolation(execErr, titleReleaseDateIndex) {
+			slog.Error("MovieRepo.Update movie already exists", "Title", movie.Title, "Date", movie.ReleaseDate)
+			return error2.ErrMovieAlreadyExists
+		}
+		slog.Error("MovieRepo.Update Exec Error", "Error", execErr)
+		return err
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("MovieRepo.Update RowsAffected Error", "Error", rowsErr)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		err = error2.ErrMovieIsNotFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.Update Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (m *MovieRepository) Delete(ctx context.Context, movieID object.MovieID) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.Delete Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.Delete Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `DELETE FROM movies WHERE id = $1`
+	result, execErr := tx.ExecContext(ctx, query, movieID.ID())
+	if execErr != nil {
+		err = execErr
+		slog.Error("MovieRepo.Delete Exec Error", "Error", execErr)
+		return err
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("MovieRepo.Delete RowsAffected Error", "Error", rowsErr)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		err = error2.ErrMovieIsNotFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.Delete Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func marshalMetadata(movie *moviedomain.Movie) ([]byte, []byte, []byte, error) {
+	externalIDs, err := marshalJSONMap(movie.ExternalIDs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sources, err := marshalJSONMap(movie.Sources)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	certifications, err := marshalJSONMap(movie.Certifications)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return externalIDs, sources, certifications, nil
+}
+
+func marshalJSONMap[K ~string](values map[K]string) ([]byte, error) {
+	if values == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(values)
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func isUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) || pqErr.Code != "23505" {
+		return false
+	}
+	return constraint == "" || pqErr.Constraint == constraint
+}
+
+func (m *MovieRepository) GetByID(ctx context.Context, movieID object.MovieID) (*moviedomain.Movie, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetByID Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetByID Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectMovieQuery + ` WHERE m.id = $1`
+	movie, err := scanMovie(tx.QueryRowContext(ctx, query, movieID.ID()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrMovieIsNotFound
+	}
+	if err != nil {
+		slog.Error("MovieRepo.GetByID row Scan Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetByID Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return movie, nil
+}
+
+func (m *MovieRepository) GetByIDs(ctx context.Context, movieIDs []object.MovieID) ([]*moviedomain.Movie, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetByIDs Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetByIDs Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	ids := make([]string, 0, len(movieIDs))
+	for _, movieID := range movieIDs {
+		ids = append(ids, movieID.ID())
+	}
+	query := selectMovieQuery + ` WHERE m.id = ANY($1)`
+	rows, err := tx.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		slog.Error("MovieRepo.GetByIDs Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	found := make(map[object.MovieID]*moviedomain.Movie, len(movieIDs))
+	for rows.Next() {
+		movie, scanErr := scanMovie(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("MovieRepo.GetByIDs Scan Error", "Error", err)
+			return nil, err
+		}
+		found[movie.ID()] = movie
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("MovieRepo.GetByIDs Rows Error", "Error", err)
+		return nil, err
+	}
+
+	movies := make([]*moviedomain.Movie, 0, len(found))
+	for _, movieID := range movieIDs {
+		if movie, exists := found[movieID]; exists {
+			movies = append(movies, movie)
+		}
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetByIDs Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return movies, nil
+}
+
+func (m *MovieRepository) GetBySlug(ctx context.Context, slug string) (*moviedomain.Movie, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetBySlug Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetBySlug Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectMovieQuery + ` WHERE m.slug = $1`
+	movie, err := scanMovie(tx.QueryRowContext(ctx, query, slug))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrMovieIsNotFound
+	}
+	if err != nil {
+		slog.Error("MovieRepo.GetBySlug row Scan Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetBySlug Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return movie, nil
+}
+
+func (m *MovieRepository) GetIDBySlug(ctx context.Context, slug string) (object.MovieID, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetIDBySlug Begin Tx Error", "Error", err)
+			return object.MovieID{}, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetIDBySlug Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT id FROM movies WHERE slug = $1`
+	var id string
+	err = tx.QueryRowContext(ctx, query, slug).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return object.MovieID{}, error2.ErrMovieIsNotFound
+	} else if err != nil {
+		slog.Error("MovieRepo.GetIDBySlug row Scan Error", "Error", err)
+		return object.MovieID{}, err
+	}
+
+	movieID, _ := object.NewMovieID(id)
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetIDBySlug Commit Error", "Error", commitErr)
+			return object.MovieID{}, commitErr
+		}
+	}
+	return movieID, nil
+}
+
+func (m *MovieRepository) ExistsByID(ctx context.Context, movieID object.MovieID) (bool, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.ExistsByID Begin Tx Error", "Error", err)
+			return false, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.ExistsByID Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	var exists bool
+	query := `SELECT EXISTS(SELECT 1 FROM movies WHERE id = $1)`
+	err = tx.QueryRowContext(ctx, query, movieID.ID()).Scan(&exists)
+	if err != nil {
+		slog.Error("MovieRepo.ExistsByID Query Error", "Error", err)
+		return false, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.ExistsByID Commit Error", "Error", commitErr)
+			return false, commitErr
+		}
+	}
+	return exists, nil
+}
+
+func (m *MovieRepository) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.ExistsBySlug Begin Tx Error", "Error", err)
+			return false, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.ExistsBySlug Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	var exists bool
+	query := `SELECT EXISTS(SELECT 1 FROM movies WHERE slug = $1)`
+	err = tx.QueryRowContext(ctx, query, slug).Scan(&exists)
+	if err != nil {
+		slog.Error("MovieRepo.ExistsBySlug Query Error", "Error", err)
+		return false, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.ExistsBySlug Commit Error", "Error", commitErr)
+			return false, commitErr
+		}
+	}
+	return exists, nil
+}
+
+func (m *MovieRepository) ListForEnrichment(ctx context.Context, staleBefore time.Time, limit int) ([]*moviedomain.Movie, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.ListForEnrichment Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.ListForEnrichment Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectMovieQuery + `
+WHERE m.enriched_at IS NULL OR m.enriched_at < $1
+ORDER BY m.enriched_at NULLS FIRST, m.id
+LIMIT $2`
+	rows, err := tx.QueryContext(ctx, query, staleBefore, limit)
+	if err != nil {
+		slog.Error("MovieRepo.ListForEnrichment Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	movies := make([]*moviedomain.Movie, 0, limit)
+	for rows.Next() {
+		movie, scanErr := scanMovie(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("MovieRepo.ListForEnrichment Scan Error", "Error", err)
+			return nil, err
+		}
+		movies = append(movies, movie)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("MovieRepo.ListForEnrichment Rows Error", "Error", err)
+		return nil, err
+	}
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.ListForEnrichment Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return movies, nil
+}
+
+func (m *MovieRepository) Search(ctx context.Context, searchQuery object.MovieSearchQuery) ([]*moviedomain.SearchHit, error) {
+	query := `SELECT ` + movieColumns + `, ts_rank_cd(m.search_vector, q.query)::float8 AS rank, ` + movieSnippet + `
+FROM movies AS m
+CROSS JOIN (SELECT websearch_to_tsquery('english', $1) || websearch_to_tsquery('simple', $1) AS query) AS q` + movieStatsJoin + `
+WHERE m.search_vector @@ q.query
+ORDER BY rank DESC, m.id
+LIMIT $2`
+	return m.search(ctx, "MovieRepo.Search", query, searchQuery)
+}
+
+func (m *MovieRepository) SearchSimilar(ctx context.Context, searchQuery object.MovieSearchQuery) ([]*moviedomain.SearchHit, error) {
+	query := `WITH matched_people AS (SELECT p.id, word_similarity(lower($1), lower(p.name)) AS rank
+                        FROM people AS p
+                        WHERE lower($1) <% lower(p.name) OR lower(p.name) % lower($1)),
+     matched_genres AS (SELECT g.id, word_similarity(lower($1), lower(g.name)) AS rank
+                        FROM genres AS g
+                        WHERE lower($1) <% lower(g.name) OR lower(g.name) % lower($1))
+SELECT ` + movieColumns + `, GREATEST(word_similarity(lower($1), lower(m.title)), COALESCE(st.rank, 0), COALESCE(sp.rank, 0),
+                COALESCE(sg.rank, 0))::float8 AS rank, ` + movieSnippet + `
+FROM movies AS m
+CROSS JOIN (SELECT plainto_tsquery('english', $1) AS query) AS q` + movieStatsJoin + `
+LEFT JOIN LATERAL (SELECT MAX(word_similarity(lower($1), lower(t.title))) AS rank
+                   FROM movie_search_titles AS t
+                   WHERE t.movie_id = m.id AND (lower($1) <% lower(t.title) OR lower(t.title) % lower($1))) AS st ON TRUE
+LEFT JOIN LATERAL (SELECT MAX(mp.rank) AS rank
+                   FROM movie_credits AS mc
+                   JOIN matched_people AS mp ON mp.id = mc.person_id
+                   WHERE mc.movie_id = m.id) AS sp ON TRUE
+LEFT JOIN LATERAL (SELECT MAX(mg.rank) AS rank
+                   FROM movie_genres AS mgr
+                   JOIN matched_genres AS mg ON mg.id = mgr.genre_id
+                   WHERE mgr.movie_id = m.id) AS sg ON TRUE
+WHERE lower($1) <% lower(m.title) OR lower(m.title) % lower($1) OR st.rank IS NOT NULL OR sp.rank IS NOT NULL
+   OR sg.rank IS NOT NULL
+ORDER BY rank DESC, m.id
+LIMIT $2`
+	return m.search(ctx, "MovieRepo.SearchSimilar", query, searchQuery)
+}
+
+func (m *MovieRepository) search(ctx context.Context, op string, query string, searchQuery object.MovieSearchQuery) ([]*moviedomain.SearchHit, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error(op+" Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error(op+" Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	rows, err := tx.QueryContext(ctx, query, searchQuery.Text, searchQuery.Limit)
+	if err != nil {
+		slog.Error(op+" Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := make([]*moviedomain.SearchHit, 0)
+	for rows.Next() {
+		hit := &moviedomain.SearchHit{}
+		movie, scanErr := scanMovie(rows, &hit.Rank, &hit.Snippet)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error(op+" Scan Error", "Error", err)
+			return nil, err
+		}
+		hit.Movie = movie
+		hits = append(hits, hit)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error(op+" Rows Error", "Error", err)
+		return nil, err
+	}
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error(op+" Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return hits, nil
+}
@@ -0,0 +1,153 @@
+package movie
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	"github.com/lib/pq"
+)
+
+const getContentOverlapsQuery = `WITH shared_genres AS (
+    SELECT c.movie_id, array_agg(g.name ORDER BY g.name) AS names
+    FROM movie_genres AS s
+    JOIN movie_genres AS c ON c.genre_id = s.genre_id AND c.movie_id != s.movie_id
+    JOIN genres AS g ON g.id = s.genre_id
+    WHERE s.movie_id = $1
+    GROUP BY c.movie_id
+), shared_people AS (
+    SELECT c.movie_id, s.role, array_agg(DISTINCT p.name ORDER BY p.name) AS names
+    FROM movie_credits AS s
+    JOIN movie_credits AS c ON c.person_id = s.person_id AND c.role = s.role AND c.movie_id != s.movie_id
+    JOIN people AS p ON p.id = s.person_id
+    WHERE s.movie_id = $1 AND s.role IN ('director', 'actor')
+    GROUP BY c.movie_id, s.role
+), candidates AS (
+    SELECT movie_id FROM shared_genres
+    UNION
+    SELECT movie_id FROM shared_people
+)
+SELECT c.movie_id, COALESCE(d.names, '{}'), COALESCE(a.names, '{}'), COALESCE(g.names, '{}'),
+       (SELECT COUNT(DISTINCT genre_id) FROM movie_genres WHERE movie_id IN ($1, c.movie_id))
+FROM candidates AS c
+LEFT JOIN shared_genres AS g ON g.movie_id = c.movie_id
+LEFT JOIN shared_people AS d ON d.movie_id = c.movie_id AND d.role = 'director'
+LEFT JOIN shared_people AS a ON a.movie_id = c.movie_id AND a.role = 'actor'
+ORDER BY (d.names IS NOT NULL) DESC, cardinality(COALESCE(a.names, '{}')) DESC, cardinality(COALESCE(g.names, '{}')) DESC, c.movie_id
+LIMIT $2`
+
+func (m *MovieRepository) GetContentOverlaps(ctx context.Context, movieID object.MovieID, limit int) ([]*moviedomain.ContentOverlap, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetContentOverlaps Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetContentOverlaps Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	rows, err := tx.QueryContext(ctx, getContentOverlapsQuery, movieID.ID(), limit)
+	if err != nil {
+		slog.Error("MovieRepo.GetContentOverlaps Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	overlaps := make([]*moviedomain.ContentOverlap, 0)
+	for rows.Next() {
+		var id string
+		overlap := &moviedomain.ContentOverlap{}
+		err = rows.Scan(&id, pq.Array(&overlap.Directors), pq.Array(&overlap.Actors), pq.Array(&overlap.Genres), &overlap.GenreUnion)
+		if err != nil {
+			slog.Error("MovieRepo.GetContentOverlaps Scan Error", "Error", err)
+			return nil, err
+		}
+		if overlap.MovieID, err = object.NewMovieID(id); err != nil {
+			slog.Error("MovieRepo.GetContentOverlaps MovieID Error", "Error", err)
+			return nil, err
+		}
+		overlaps = append(overlaps, overlap)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("MovieRepo.GetContentOverlaps Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetContentOverlaps Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return overlaps, nil
+}
+
+func (m *MovieRepository) GetCoRatingNeighbors(ctx context.Context, movieID object.MovieID, limit int) ([]*moviedomain.CoRatingNeighbor, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetCoRatingNeighbors Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetCoRatingNeighbors Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT similar_movie_id, score, co_raters FROM movie_similarities
+WHERE movie_id = $1
+ORDER BY score DESC, similar_movie_id
+LIMIT $2`
+	rows, err := tx.QueryContext(ctx, query, movieID.ID(), limit)
+	if err != nil {
+		slog.Error("MovieRepo.GetCoRatingNeighbors Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	neighbors := make([]*moviedomain.CoRatingNeighbor, 0)
+	for rows.Next() {
+		var id string
+		neighbor := &moviedomain.CoRatingNeighbor{}
+		err = rows.Scan(&id, &neighbor.Score, &neighbor.CoRaters)
+		if err != nil {
+			slog.Error("MovieRepo.GetCoRatingNeighbors Scan Error", "Error", err)
+			return nil, err
+		}
+		if neighbor.MovieID, err = object.NewMovieID(id); err != nil {
+			slog.Error("MovieRepo.GetCoRatingNeighbors MovieID Error", "Error", err)
+			return nil, err
+		}
+		neighbors = append(neighbors, neighbor)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("MovieRepo.GetCoRatingNeighbors Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetCoRatingNeighbors Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return neighbors, nil
+}
@@ -0,0 +1,262 @@
+package movie
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	"github.com/lib/pq"
+)
+
+func (m *MovieRepository) GetTranslations(ctx context.Context, movieIDs []object.MovieID, bases []string) (map[object.MovieID][]*moviedomain.Translation, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetTranslations Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetTranslations Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	ids := make([]string, 0, len(movieIDs))
+	for _, movieID := range movieIDs {
+		ids = append(ids, movieID.ID())
+	}
+	query := `SELECT movie_id, language, title, description, tagline
+FROM movie_translations
+WHERE movie_id = ANY($1::uuid[]) AND ($2::text[] IS NULL OR split_part(language, '-', 1) = ANY($2::text[]))
+ORDER BY movie_id, language`
+	var languages any
+	if bases != nil {
+		languages = pq.Array(bases)
+	}
+	rows, err := tx.QueryContext(ctx, query, pq.Array(ids), languages)
+	if err != nil {
+		slog.Error("MovieRepo.GetTranslations Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	translations := make(map[object.MovieID][]*moviedomain.Translation)
+	for rows.Next() {
+		var id, language string
+		translation := &moviedomain.Translation{}
+		err = rows.Scan(&id, &language, &translation.Title, &translation.Description, &translation.Tagline)
+		if err != nil {
+			slog.Error("MovieRepo.GetTranslations Scan Error", "Error", err)
+			return nil, err
+		}
+		translation.Language = object.LanguageTag(language)
+		movieID, _ := object.NewMovieID(id)
+		translations[movieID] = append(translations[movieID], translation)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("MovieRepo.GetTranslations Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetTranslations Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return translations, nil
+}
+
+func (m *MovieRepository) SaveTranslation(ctx context.Context, movieID object.MovieID, translation *moviedomain.Translation) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.SaveTranslation Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.SaveTranslation Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO movie_translations (movie_id, language, title, description, tagline)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (movie_id, language) DO UPDATE
+SET title = EXCLUDED.title, description = EXCLUDED.description, tagline = EXCLUDED.tagline`
+	_, err = tx.ExecContext(ctx, query, movieID.ID(), translation.Language.String(), translation.Title, translation.Description, translation.Tagline)
+	if err != nil {
+		slog.Error("MovieRepo.SaveTranslation Exec Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.SaveTranslation Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (m *MovieRepository) DeleteTranslation(ctx context.Context, movieID object.MovieID, language object.LanguageTag) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.DeleteTranslation Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.DeleteTranslation Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	result, execErr := tx.ExecContext(ctx, `DELETE FROM movie_translations WHERE movie_id = $1 AND language = $2`, movieID.ID(), language.String())
+	if execErr != nil {
+		err = execErr
+		slog.Error("MovieRepo.DeleteTranslation Exec Error", "Error", execErr)
+		return err
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("MovieRepo.DeleteTranslation RowsAffected Error", "Error", rowsErr)
+		return err
+	}
+	if rowsAffected == 0 {
+		err = error2.ErrTranslationIsNotFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.DeleteTranslation Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (m *MovieRepository) GetAlternateTitles(ctx context.Context, movieID object.MovieID) ([]*moviedomain.AlternateTitle, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetAlternateTitles Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetAlternateTitles Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT title, language FROM movie_alternate_titles WHERE movie_id = $1 ORDER BY language, title`
+	rows, err := tx.QueryContext(ctx, query, movieID.ID())
+	if err != nil {
+		slog.Error("MovieRepo.GetAlternateTitles Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	titles := make([]*moviedomain.AlternateTitle, 0)
+	for rows.Next() {
+		var title, language string
+		err = rows.Scan(&title, &language)
+		if err != nil {
+			slog.Error("MovieRepo.GetAlternateTitles Scan Error", "Error", err)
+			return nil, err
+		}
+		titles = append(titles, moviedomain.NewAlternateTitle(title, object.LanguageTag(language)))
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("MovieRepo.GetAlternateTitles Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetAlternateTitles Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return titles, nil
+}
+
+func (m *MovieRepository) ReplaceAlternateTitles(ctx context.Context, movieID object.MovieID, titles []*moviedomain.AlternateTitle) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.ReplaceAlternateTitles Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.ReplaceAlternateTitles Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	values := make([]string, 0, len(titles))
+	languages := make([]string, 0, len(titles))
+	for _, title := range titles {
+		values = append(values, title.Title)
+		languages = append(languages, title.Language.String())
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM movie_alternate_titles WHERE movie_id = $1`, movieID.ID())
+	if err != nil {
+		slog.Error("MovieRepo.ReplaceAlternateTitles Delete Error", "Error", err)
+		return err
+	}
+
+	query := `INSERT INTO movie_alternate_titles (movie_id, title, language)
+SELECT $1::uuid, t.title, t.language
+FROM unnest($2::text[], $3::text[]) AS t(title, language)`
+	_, err = tx.ExecContext(ctx, query, movieID.ID(), pq.Array(values), pq.Array(languages))
+	if err != nil {
+		slog.Error("MovieRepo.ReplaceAlternateTitles Insert Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.ReplaceAlternateTitles Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package movie
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+)
+
+const refreshTrendingQuery = `WITH events AS (
+    SELECT movie_id, rated_at AS happened_at, $3::float8 AS weight
+    FROM user_movies
+    WHERE movie_id IS NOT NULL AND rated_at >= $2
+    UNION ALL
+    SELECT movie_id, listed_at, $4::float8
+    FROM user_movies
+    WHERE movie_id IS NOT NULL AND listed_at >= $2
+    UNION ALL
+    SELECT movie_id, writing_date::timestamptz, $5::float8
+    FROM reviews
+    WHERE movie_id IS NOT NULL AND writing_date >= $2::date
+    UNION ALL
+    SELECT r.movie_id, rl.created_at, $6::float8
+    FROM review_likes AS rl
+    JOIN reviews AS r ON r.id = rl.review_id
+    WHERE r.movie_id IS NOT NULL AND rl.created_at >= $2
+), scores AS (
+    SELECT movie_id,
+           SUM(weight * exp(-ln(2) * GREATEST(EXTRACT(EPOCH FROM ($1::timestamptz - happened_at)), 0) / $7::float8)) AS score
+    FROM events
+    GROUP BY movie_id
+)
+INSERT INTO movie_trending (time_window, movie_id, rank, score, computed_at)
+SELECT $8, movie_id, ROW_NUMBER() OVER (ORDER BY score DESC, movie_id), score, $1
+FROM scores
+ORDER BY score DESC, movie_id
+LIMIT $9`
+
+func (m *MovieRepository) RefreshTrending(ctx context.Context, window object.TrendingWindow, now time.Time, weights moviedomain.TrendingWeights, size int) (int, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.RefreshTrending Begin Tx Error", "Error", err)
+			return 0, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.RefreshTrending Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM movie_trending WHERE time_window = $1`, window.String())
+	if err != nil {
+		slog.Error("MovieRepo.RefreshTrending Delete Error", "Error", err)
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx, refreshTrendingQuery, now, now.Add(-window.Duration()), weights.Rating, weights.ListAddition,
+		weights.Review, weights.ReviewLike, window.HalfLife().Seconds(), window.String(), size)
+	if err != nil {
+		slog.Error("MovieRepo.RefreshTrending Insert Error", "Error", err)
+		return 0, err
+	}
+	ranked, err := result.RowsAffected()
+	if err != nil {
+		slog.Error("MovieRepo.RefreshTrending RowsAffected Error", "Error", err)
+		return 0, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.RefreshTrending Commit Error", "Error", commitErr)
+			return 0, commitErr
+		}
+	}
+	return int(ranked), nil
+}
+
+func (m *MovieRepository) GetTrending(ctx context.Context, window object.TrendingWindow, limit int) ([]*moviedomain.TrendingMovie, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = m.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("MovieRepo.GetTrending Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("MovieRepo.GetTrending Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT ` + movieColumns + `, mt.rank, mt.score
+FROM movie_trending AS mt
+JOIN movies AS m ON m.id = mt.movie_id` + movieStatsJoin + `
+WHERE mt.time_window = $1
+ORDER BY mt.rank
+LIMIT $2`
+	rows, err := tx.QueryContext(ctx, query, window.String(), limit)
+	if err != nil {
+		slog.Error("MovieRepo.GetTrending Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	trending := make([]*moviedomain.TrendingMovie, 0)
+	for rows.Next() {
+		item := &moviedomain.TrendingMovie{}
+		movie, scanErr := scanMovie(rows, &item.Rank, &item.Score)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("MovieRepo.GetTrending Scan Error", "Error", err)
+			return nil, err
+		}
+		item.Movie = movie
+		trending = append(trending, item)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("MovieRepo.GetTrending Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("MovieRepo.GetTrending Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return trending, nil
+}
@@ -0,0 +1,98 @@
+package movieimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	importdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/error"
+	personobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+)
+
+const csvListSeparator = "|"
+
+type CSVParser struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+func NewCSVParser(r io.Reader) *CSVParser {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	return &CSVParser{reader: reader}
+}
+
+func (c *CSVParser) Next() (*importdomain.Record, error) {
+	if c.columns == nil {
+		if err := c.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	row, err := c.reader.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return &importdomain.Record{Line: parseErr.Line, Err: parseErr.Err}, nil
+		}
+		return nil, err
+	}
+	line, _ := c.reader.FieldPos(0)
+
+	fields := movieFields{
+		Title:       c.value(row, "title"),
+		Description: c.value(row, "description"),
+		ReleaseDate: c.value(row, "release_date"),
+		Genres:      splitList(c.value(row, "genres"), csvListSeparator),
+	}
+	for name, target := range map[string]*int{"year": &fields.Year, "month": &fields.Month, "day": &fields.Day} {
+		if value := c.value(row, name); value != "" {
+			if *target, err = strconv.Atoi(value); err != nil {
+				return &importdomain.Record{Line: line, Err: error2.ErrImportRecordIsNotValid}, nil
+			}
+		}
+	}
+	fields.addCredits(personobject.CreditRoleDirector, splitList(c.value(row, "director"), csvListSeparator))
+	fields.addCredits(personobject.CreditRoleActor, splitList(c.value(row, "actors"), csvListSeparator))
+	fields.addCredits(personobject.CreditRoleWriter, splitList(c.value(row, "writers"), csvListSeparator))
+	fields.addCredits(personobject.CreditRoleComposer, splitList(c.value(row, "composers"), csvListSeparator))
+
+	movie, err := fields.toMovie()
+	if err != nil {
+		return &importdomain.Record{Line: line, Err: err}, nil
+	}
+	return &importdomain.Record{Line: line, Movie: movie}, nil
+}
+
+func (c *CSVParser) readHeader() error {
+	header, err := c.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return io.EOF
+	}
+	if err != nil {
+		return error2.ErrImportHeaderIsNotValid
+	}
+	c.columns = make(map[string]int, len(header))
+	for i, name := range header {
+		c.columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	_, hasTitle := c.columns["title"]
+	_, hasDate := c.columns["release_date"]
+	_, hasYear := c.columns["year"]
+	if !hasTitle || (!hasDate && !hasYear) {
+		return error2.ErrImportHeaderIsNotValid
+	}
+	return nil
+}
+
+func (c *CSVParser) value(row []string, column string) string {
+	i, ok := c.columns[column]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
@@ -0,0 +1,321 @@
+package movieimport
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	importdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/error"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	personobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+)
+
+const imdbNull = `\N`
+
+var imdbTitleTypes = map[string]bool{
+	"movie":   true,
+	"tvMovie": true,
+}
+
+var imdbCategories = map[string]personobject.CreditRole{
+	"director": personobject.CreditRoleDirector,
+	"actor":    personobject.CreditRoleActor,
+	"actress":  personobject.CreditRoleActor,
+	"self":     personobject.CreditRoleActor,
+	"writer":   personobject.CreditRoleWriter,
+	"composer": personobject.CreditRoleComposer,
+}
+
+type tsvReader struct {
+	reader  *bufio.Reader
+	columns map[string]int
+	line    int
+}
+
+func newTSVReader(r io.Reader) *tsvReader {
+	return &tsvReader{reader: bufio.NewReaderSize(r, 1<<20)}
+}
+
+func (t *tsvReader) readHeader(required ...string) error {
+	row, err := t.read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return error2.ErrImportHeaderIsNotValid
+		}
+		return err
+	}
+	t.columns = make(map[string]int, len(row))
+	for i, name := range row {
+		t.columns[name] = i
+	}
+	for _, name := range required {
+		if _, ok := t.columns[name]; !ok {
+			return error2.ErrImportHeaderIsNotValid
+		}
+	}
+	return nil
+}
+
+func (t *tsvReader) read() ([]string, error) {
+	data, err := t.reader.ReadString('\n')
+	if len(data) == 0 && err != nil {
+		return nil, err
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	t.line++
+	return strings.Split(strings.TrimRight(data, "\r\n"), "\t"), nil
+}
+
+func (t *tsvReader) value(row []string, column string) string {
+	i, ok := t.columns[column]
+	if !ok || i >= len(row) || row[i] == imdbNull {
+		return ""
+	}
+	return row[i]
+}
+
+type imdbPrincipal struct {
+	tconst    string
+	nconst    string
+	category  string
+	character string
+}
+
+type IMDbParser struct {
+	basics        *tsvReader
+	principals    *tsvReader
+	basicsSrc     io.Reader
+	principalsSrc io.Reader
+	namesSrc      io.Reader
+	names         map[string]string
+	pending       *imdbPrincipal
+	started       bool
+}
+
+func NewIMDbParser(basics, principals, names io.Reader) *IMDbParser {
+	parser := &IMDbParser{basicsSrc: basics, principalsSrc: principals, namesSrc: names}
+	parser.reset()
+	return parser
+}
+
+func (p *IMDbParser) reset() {
+	p.basics = newTSVReader(p.basicsSrc)
+	p.principals = nil
+	if p.principalsSrc != nil {
+		p.principals = newTSVReader(p.principalsSrc)
+	}
+	p.pending = nil
+}
+
+func (p *IMDbParser) Next() (*importdomain.Record, error) {
+	if !p.started {
+		if err := p.start(); err != nil {
+			return nil, err
+		}
+		p.started = true
+	}
+
+	row, err := p.basics.read()
+	if err != nil {
+		return nil, err
+	}
+	line := p.basics.line
+	tconst := p.basics.value(row, "tconst")
+
+	principals, err := p.principalsFor(tconst)
+	if err != nil {
+		return nil, err
+	}
+	if !p.importable(row) {
+		return &importdomain.Record{Line: line, Skip: true}, nil
+	}
+
+	year, err := strconv.Atoi(p.basics.value(row, "startYear"))
+	if err != nil {
+		return &importdomain.Record{Line: line, Err: error2.ErrImportRecordIsNotValid}, nil
+	}
+	fields := movieFields{
+		Title:  p.basics.value(row, "primaryTitle"),
+		Year:   year,
+		Genres: splitList(p.basics.value(row, "genres"), ","),
+	}
+	for _, principal := range principals {
+		role, ok := imdbCategories[principal.category]
+		name := p.names[principal.nconst]
+		if !ok || name == "" {
+			continue
+		}
+		fields.Credits = append(fields.Credits, persondomain.NewCredit(personobject.PersonID{}, name, role, principal.character))
+	}
+
+	movie, err := fields.toMovie()
+	if err != nil {
+		return &importdomain.Record{Line: line, Err: err}, nil
+	}
+	return &importdomain.Record{Line: line, Movie: movie}, nil
+}
+
+func (p *IMDbParser) importable(row []string) bool {
+	return imdbTitleTypes[p.basics.value(row, "titleType")] && p.basics.value(row, "isAdult") != "1"
+}
+
+func (p *IMDbParser) start() error {
+	if err := p.readHeaders(); err != nil {
+		return err
+	}
+	if p.namesSrc == nil || p.principals == nil {
+		return nil
+	}
+
+	referenced, err := p.referencedNames()
+	if err != nil {
+		return err
+	}
+	if err = p.rewind(); err != nil {
+		return err
+	}
+	if err = p.readHeaders(); err != nil {
+		return err
+	}
+	return p.loadNames(referenced)
+}
+
+func (p *IMDbParser) readHeaders() error {
+	if err := p.basics.readHeader("tconst", "titleType", "primaryTitle", "startYear", "genres"); err != nil {
+		return err
+	}
+	if p.principals != nil {
+		if err := p.principals.readHeader("tconst", "nconst", "category", "characters"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *IMDbParser) referencedNames() (map[string]struct{}, error) {
+	referenced := make(map[string]struct{})
+	for {
+		row, err := p.basics.read()
+		if errors.Is(err, io.EOF) {
+			return referenced, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		principals, err := p.principalsFor(p.basics.value(row, "tconst"))
+		if err != nil {
+			return nil, err
+		}
+		if !p.importable(row) {
+			continue
+		}
+		for _, principal := range principals {
+			if _, ok := imdbCategories[principal.category]; ok {
+				referenced[principal.nconst] = struct{}{}
+			}
+		}
+	}
+}
+
+func (p *IMDbParser) rewind() error {
+	for _, src := range []io.Reader{p.basicsSrc, p.principalsSrc} {
+		seeker, ok := src.(io.Seeker)
+		if !ok {
+			return error2.ErrImportSourceIsNotSeekable
+		}
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+	p.reset()
+	return nil
+}
+
+func (p *IMDbParser) loadNames(referenced map[string]struct{}) error {
+	names := newTSVReader(p.namesSrc)
+	if err := names.readHeader("nconst", "primaryName"); err != nil {
+		return err
+	}
+	p.names = make(map[string]string, len(referenced))
+	for {
+		row, err := names.read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		nconst := names.value(row, "nconst")
+		if _, ok := referenced[nconst]; !ok {
+			continue
+		}
+		if name := names.value(row, "primaryName"); name != "" {
+			p.names[nconst] = name
+		}
+	}
+}
+
+func (p *IMDbParser) principalsFor(tconst string) ([]*imdbPrincipal, error) {
+	if p.principals == nil {
+		return nil, nil
+	}
+
+	var result []*imdbPrincipal
+	for {
+		if p.pending == nil {
+			row, err := p.principals.read()
+			if errors.Is(err, io.EOF) {
+				return result, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			p.pending = p.parsePrincipal(row)
+		}
+
+		switch compareTconst(p.pending.tconst, tconst) {
+		case -1:
+			p.pending = nil
+		case 0:
+			result = append(result, p.pending)
+			p.pending = nil
+		default:
+			return result, nil
+		}
+	}
+}
+
+func (p *IMDbParser) parsePrincipal(row []string) *imdbPrincipal {
+	principal := &imdbPrincipal{
+		tconst:   p.principals.value(row, "tconst"),
+		nconst:   p.principals.value(row, "nconst"),
+		category: p.principals.value(row, "category"),
+	}
+
+	var characters []string
+	if raw := p.principals.value(row, "characters"); raw != "" && json.Unmarshal([]byte(raw), &characters) == nil {
+		principal.character = strings.Join(nonEmpty(characters), " / ")
+	}
+	return principal
+}
+
+func compareTconst(a, b string) int {
+	switch {
+	case len(a) != len(b):
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,103 @@
+package movieimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+
+	importdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	personobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+)
+
+type jsonlCredit struct {
+	PersonID  string `json:"person_id"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Character string `json:"character"`
+}
+
+type jsonlMovie struct {
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	ReleaseDate string        `json:"release_date"`
+	Year        int           `json:"year"`
+	Month       int           `json:"month"`
+	Day         int           `json:"day"`
+	Director    string        `json:"director"`
+	Actors      []string      `json:"actors"`
+	Genres      []string      `json:"genres"`
+	Credits     []jsonlCredit `json:"credits"`
+}
+
+type JSONLParser struct {
+	reader *bufio.Reader
+	line   int
+}
+
+func NewJSONLParser(r io.Reader) *JSONLParser {
+	return &JSONLParser{reader: bufio.NewReader(r)}
+}
+
+func (j *JSONLParser) Next() (*importdomain.Record, error) {
+	for {
+		data, err := j.reader.ReadBytes('\n')
+		if len(data) == 0 && err != nil {
+			return nil, err
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		j.line++
+
+		data = bytes.TrimSpace(data)
+		if len(data) == 0 {
+			continue
+		}
+		return j.parse(data), nil
+	}
+}
+
+func (j *JSONLParser) parse(data []byte) *importdomain.Record {
+	var raw jsonlMovie
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return &importdomain.Record{Line: j.line, Err: err}
+	}
+
+	fields := movieFields{
+		Title:       raw.Title,
+		Description: raw.Description,
+		ReleaseDate: raw.ReleaseDate,
+		Year:        raw.Year,
+		Month:       raw.Month,
+		Day:         raw.Day,
+		Genres:      raw.Genres,
+	}
+	if len(raw.Credits) > 0 {
+		for _, credit := range raw.Credits {
+			var personID personobject.PersonID
+			if credit.PersonID != "" {
+				var err error
+				if personID, err = personobject.NewPersonID(credit.PersonID); err != nil {
+					return &importdomain.Record{Line: j.line, Err: err}
+				}
+			}
+			role, err := personobject.NewCreditRole(credit.Role)
+			if err != nil {
+				return &importdomain.Record{Line: j.line, Err: err}
+			}
+			fields.Credits = append(fields.Credits, persondomain.NewCredit(personID, credit.Name, role, credit.Character))
+		}
+	} else {
+		fields.addCredits(personobject.CreditRoleDirector, splitList(raw.Director, ","))
+		fields.addCredits(personobject.CreditRoleActor, raw.Actors)
+	}
+
+	movie, err := fields.toMovie()
+	if err != nil {
+		return &importdomain.Record{Line: j.line, Err: err}
+	}
+	return &importdomain.Record{Line: j.line, Movie: movie}
+}
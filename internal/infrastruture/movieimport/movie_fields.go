@@ -0,0 +1,76 @@
+package movieimport
+
+import (
+	"strings"
+	"time"
+
+	moviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/error"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	personobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+)
+
+const releaseDateLayout = "2006-01-02"
+
+type movieFields struct {
+	Title       string
+	Description string
+	ReleaseDate string
+	Year        int
+	Month       int
+	Day         int
+	Genres      []string
+	Credits     []*persondomain.Credit
+}
+
+func (f *movieFields) addCredits(role personobject.CreditRole, names []string) {
+	for _, name := range names {
+		if strings.TrimSpace(name) != "" {
+			f.Credits = append(f.Credits, persondomain.NewCredit(personobject.PersonID{}, name, role, ""))
+		}
+	}
+}
+
+func (f *movieFields) toMovie() (*moviedomain.Movie, error) {
+	var releaseDate time.Time
+	var err error
+	if f.ReleaseDate != "" {
+		releaseDate, err = time.Parse(releaseDateLayout, strings.TrimSpace(f.ReleaseDate))
+		if err != nil {
+			return nil, error2.ErrImportRecordIsNotValid
+		}
+	} else {
+		month, day := f.Month, f.Day
+		if month == 0 {
+			month = 1
+		}
+		if day == 0 {
+			day = 1
+		}
+		releaseDate, err = moviedomain.NewReleaseDate(f.Year, month, day)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	movie := moviedomain.NewMovie(f.Title, f.Description, releaseDate, "", nil, nonEmpty(f.Genres), 0)
+	movie.SetCredits(f.Credits)
+	return movie, nil
+}
+
+func splitList(value string, separator string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return nonEmpty(strings.Split(value, separator))
+}
+
+func nonEmpty(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
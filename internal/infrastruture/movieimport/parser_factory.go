@@ -0,0 +1,29 @@
+package movieimport
+
+import (
+	importdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/movieimport/object"
+)
+
+type ParserFactory struct{}
+
+func NewParserFactory() *ParserFactory {
+	return &ParserFactory{}
+}
+
+func (f *ParserFactory) NewParser(format object.Format, sources importdomain.Sources) (importdomain.Parser, error) {
+	if sources.Main == nil {
+		return nil, error2.ErrImportSourceIsMissing
+	}
+
+	switch format {
+	case object.FormatCSV:
+		return NewCSVParser(sources.Main), nil
+	case object.FormatJSONL:
+		return NewJSONLParser(sources.Main), nil
+	case object.FormatIMDb:
+		return NewIMDbParser(sources.Main, sources.Principals, sources.Names), nil
+	}
+	return nil, error2.ErrImportFormatIsNotValid
+}
@@ -0,0 +1,344 @@
+package person
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	persondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/person"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/person/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/person/object"
+	"github.com/lib/pq"
+)
+
+type PersonRepository struct {
+	db *sql.DB
+}
+
+func NewPersonRepository(db *sql.DB) *PersonRepository {
+	return &PersonRepository{db: db}
+}
+
+func (p *PersonRepository) GetByID(ctx context.Context, personID object.PersonID) (*persondomain.Person, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = p.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("PersonRepo.GetByID Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("PersonRepo.GetByID Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT id, name FROM people WHERE id = $1`
+	person, err := scanPerson(tx.QueryRowContext(ctx, query, personID.ID()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrPersonIsNotFound
+	}
+	if err != nil {
+		slog.Error("PersonRepo.GetByID row Scan Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("PersonRepo.GetByID Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return person, nil
+}
+
+func (p *PersonRepository) FindByName(ctx context.Context, name string) ([]*persondomain.Person, error) {
+	query := `SELECT id, name FROM people WHERE lower(name) = lower($1) ORDER BY id`
+	return p.queryPeople(ctx, "PersonRepo.FindByName", query, name)
+}
+
+func (p *PersonRepository) SearchByName(ctx context.Context, name string, limit int) ([]*persondomain.Person, error) {
+	query := `SELECT id, name FROM people
+WHERE lower(name) LIKE '%' || lower($1) || '%'
+ORDER BY lower(name) = lower($1) DESC, name, id
+LIMIT $2`
+	return p.queryPeople(ctx, "PersonRepo.SearchByName", query, name, limit)
+}
+
+func (p *PersonRepository) queryPeople(ctx context.Context, op string, query string, args ...any) ([]*persondomain.Person, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = p.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error(op+" Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error(op+" Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.Error(op+" Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	people := make([]*persondomain.Person, 0)
+	for rows.Next() {
+		person, scanErr := scanPerson(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error(op+" Scan Error", "Error", err)
+			return nil, err
+		}
+		people = append(people, person)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error(op+" Rows Error", "Error", err)
+		return nil, err
+	}
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error(op+" Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return people, nil
+}
+
+func (p *PersonRepository) Save(ctx context.Context, person *persondomain.Person) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = p.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("PersonRepo.Save Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("PersonRepo.Save Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO people (name) VALUES ($1) RETURNING id`
+	var newID string
+	err = tx.QueryRowContext(ctx, query, person.Name).Scan(&newID)
+	if err != nil {
+		slog.Error("PersonRepo.Save Query Error", "Error", err)
+		return err
+	}
+
+	personID, _ := object.NewPersonID(newID)
+	_ = person.SetID(personID)
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("PersonRepo.Save Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (p *PersonRepository) GetFilmography(ctx context.Context, personID object.PersonID) ([]*persondomain.FilmographyEntry, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = p.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("PersonRepo.GetFilmography Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("PersonRepo.GetFilmography Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT m.id, m.slug, m.title, m.release_date, mc.role, mc.character_name
+FROM movie_credits AS mc
+JOIN movies AS m ON m.id = mc.movie_id
+WHERE mc.person_id = $1
+ORDER BY m.release_date DESC, m.title, mc.role`
+	rows, err := tx.QueryContext(ctx, query, personID.ID())
+	if err != nil {
+		slog.Error("PersonRepo.GetFilmography Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*persondomain.FilmographyEntry, 0)
+	for rows.Next() {
+		var movieID, role string
+		entry := &persondomain.FilmographyEntry{}
+		err = rows.Scan(&movieID, &entry.Slug, &entry.Title, &entry.ReleaseDate, &role, &entry.Character)
+		if err != nil {
+			slog.Error("PersonRepo.GetFilmography Scan Error", "Error", err)
+			return nil, err
+		}
+		entry.MovieID, _ = movieobject.NewMovieID(movieID)
+		entry.Role = object.CreditRole(role)
+		entries = append(entries, entry)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("PersonRepo.GetFilmography Rows Error", "Error", err)
+		return nil, err
+	}
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("PersonRepo.GetFilmography Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return entries, nil
+}
+
+func (p *PersonRepository) GetMovieCredits(ctx context.Context, movieID movieobject.MovieID) ([]*persondomain.Credit, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = p.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("PersonRepo.GetMovieCredits Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("PersonRepo.GetMovieCredits Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT p.id, p.name, mc.role, mc.character_name, mc.position
+FROM movie_credits AS mc
+JOIN people AS p ON p.id = mc.person_id
+WHERE mc.movie_id = $1
+ORDER BY mc.role, mc.position, p.name`
+	rows, err := tx.QueryContext(ctx, query, movieID.ID())
+	if err != nil {
+		slog.Error("PersonRepo.GetMovieCredits Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	credits := make([]*persondomain.Credit, 0)
+	for rows.Next() {
+		var personID, role string
+		credit := &persondomain.Credit{}
+		err = rows.Scan(&personID, &credit.Name, &role, &credit.Character, &credit.Position)
+		if err != nil {
+			slog.Error("PersonRepo.GetMovieCredits Scan Error", "Error", err)
+			return nil, err
+		}
+		credit.PersonID, _ = object.NewPersonID(personID)
+		credit.Role = object.CreditRole(role)
+		credits = append(credits, credit)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("PersonRepo.GetMovieCredits Rows Error", "Error", err)
+		return nil, err
+	}
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("PersonRepo.GetMovieCredits Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return credits, nil
+}
+
+func (p *PersonRepository) ReplaceMovieCredits(ctx context.Context, movieID movieobject.MovieID, credits []*persondomain.Credit) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = p.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("PersonRepo.ReplaceMovieCredits Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("PersonRepo.ReplaceMovieCredits Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM movie_credits WHERE movie_id = $1`, movieID.ID())
+	if err != nil {
+		slog.Error("PersonRepo.ReplaceMovieCredits Delete Error", "Error", err)
+		return err
+	}
+
+	if len(credits) > 0 {
+		personIDs := make([]string, 0, len(credits))
+		roles := make([]string, 0, len(credits))
+		characters := make([]string, 0, len(credits))
+		positions := make([]int64, 0, len(credits))
+		for _, credit := range credits {
+			personIDs = append(personIDs, credit.PersonID.ID())
+			roles = append(roles, credit.Role.String())
+			characters = append(characters, credit.Character)
+			positions = append(positions, int64(credit.Position))
+		}
+
+		query := `INSERT INTO movie_credits (movie_id, person_id, role, character_name, position)
+SELECT $1, c.person_id, c.role, c.character_name, c.position
+FROM unnest($2::uuid[], $3::varchar[], $4::varchar[], $5::integer[]) AS c(person_id, role, character_name, position)
+ON CONFLICT DO NOTHING`
+		_, err = tx.ExecContext(ctx, query, movieID.ID(), pq.Array(personIDs), pq.Array(roles), pq.Array(characters), pq.Array(positions))
+		if err != nil {
+			slog.Error("PersonRepo.ReplaceMovieCredits Insert Error", "Error", err)
+			return err
+		}
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("PersonRepo.ReplaceMovieCredits Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func scanPerson(row interface{ Scan(dest ...any) error }) (*persondomain.Person, error) {
+	var id, name string
+	if err := row.Scan(&id, &name); err != nil {
+		return nil, err
+	}
+	person := persondomain.NewPerson(name)
+	personID, _ := object.NewPersonID(id)
+	_ = person.SetID(personID)
+	return person, nil
+}
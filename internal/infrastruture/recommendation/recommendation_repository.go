@@ -0,0 +1,384 @@
+package recommendation
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	recommendationdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/recommendation"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+	"github.com/lib/pq"
+)
+
+const rebuildSimilaritiesQuery = `WITH ratings AS (
+    SELECT user_id, movie_id, user_rating - AVG(user_rating) OVER (PARTITION BY user_id) AS centered
+    FROM user_movies
+    WHERE movie_id IS NOT NULL AND user_rating != 0
+), norms AS (
+    SELECT movie_id, sqrt(SUM(centered * centered)) AS norm
+    FROM ratings
+    GROUP BY movie_id
+), pairs AS (
+    SELECT a.movie_id, b.movie_id AS similar_movie_id, SUM(a.centered * b.centered) AS dot, COUNT(*) AS co_raters
+    FROM ratings AS a
+    JOIN ratings AS b ON b.user_id = a.user_id AND b.movie_id != a.movie_id
+    GROUP BY a.movie_id, b.movie_id
+    HAVING COUNT(*) >= $1::integer
+), scored AS (
+    SELECT p.movie_id, p.similar_movie_id, p.co_raters,
+           (p.dot / (na.norm * nb.norm))::float8 * p.co_raters / (p.co_raters + $2::float8) AS score
+    FROM pairs AS p
+    JOIN norms AS na ON na.movie_id = p.movie_id
+    JOIN norms AS nb ON nb.movie_id = p.similar_movie_id
+    WHERE na.norm > 0 AND nb.norm > 0
+), ranked AS (
+    SELECT movie_id, similar_movie_id, score, co_raters,
+           ROW_NUMBER() OVER (PARTITION BY movie_id ORDER BY score DESC, similar_movie_id) AS position
+    FROM scored
+    WHERE score > 0
+)
+INSERT INTO movie_similarities (movie_id, similar_movie_id, score, co_raters, computed_at)
+SELECT movie_id, similar_movie_id, score, co_raters, now()
+FROM ranked
+WHERE position <= $3::integer`
+
+const getNeighborsQuery = `SELECT s.similar_movie_id, um.movie_id, m.title, um.user_rating, s.score
+FROM user_movies AS um
+JOIN movie_similarities AS s ON s.movie_id = um.movie_id
+JOIN movies AS m ON m.id = um.movie_id
+WHERE um.user_id = $1 AND um.movie_id IS NOT NULL AND um.user_rating != 0
+  AND NOT EXISTS (SELECT 1 FROM user_movies AS rated
+                  WHERE rated.user_id = $1 AND rated.movie_id = s.similar_movie_id AND rated.user_rating != 0)`
+
+const getSeedsQuery = `SELECT m.id, m.title, um.user_rating, um.list_type IS NOT DISTINCT FROM 'favorite'
+FROM user_movies AS um
+JOIN movies AS m ON m.id = um.movie_id
+WHERE um.user_id = $1 AND um.movie_id IS NOT NULL
+  AND (um.user_rating >= $2 OR um.list_type = 'favorite')
+ORDER BY um.user_rating DESC, m.title, m.id
+LIMIT $3`
+
+const getContentMatchesQuery = `WITH seeds AS (
+    SELECT unnest($2::uuid[]) AS movie_id
+), seed_genres AS (
+    SELECT s.movie_id AS seed_id, c.movie_id, COUNT(*) AS shared
+    FROM seeds AS s
+    JOIN movie_genres AS sg ON sg.movie_id = s.movie_id
+    JOIN movie_genres AS c ON c.genre_id = sg.genre_id AND c.movie_id != s.movie_id
+    GROUP BY s.movie_id, c.movie_id
+), seed_people AS (
+    SELECT s.movie_id AS seed_id, c.movie_id, sc.role,
+           array_agg(DISTINCT p.name ORDER BY p.name) AS names
+    FROM seeds AS s
+    JOIN movie_credits AS sc ON sc.movie_id = s.movie_id AND sc.role IN ('director', 'actor')
+    JOIN movie_credits AS c ON c.person_id = sc.person_id AND c.role = sc.role AND c.movie_id != s.movie_id
+    JOIN people AS p ON p.id = sc.person_id
+    GROUP BY s.movie_id, c.movie_id, sc.role
+), pairs AS (
+    SELECT seed_id, movie_id FROM seed_genres
+    UNION
+    SELECT seed_id, movie_id FROM seed_people
+)
+SELECT pr.movie_id, pr.seed_id, COALESCE(g.shared, 0),
+       COALESCE(d.names, '{}'), COALESCE(a.names, '{}')
+FROM pairs AS pr
+LEFT JOIN seed_genres AS g ON g.seed_id = pr.seed_id AND g.movie_id = pr.movie_id
+LEFT JOIN seed_people AS d ON d.seed_id = pr.seed_id AND d.movie_id = pr.movie_id AND d.role = 'director'
+LEFT JOIN seed_people AS a ON a.seed_id = pr.seed_id AND a.movie_id = pr.movie_id AND a.role = 'actor'
+WHERE pr.movie_id != ALL($2::uuid[])
+  AND NOT EXISTS (SELECT 1 FROM user_movies AS rated
+                  WHERE rated.user_id = $1 AND rated.movie_id = pr.movie_id AND rated.user_rating != 0)`
+
+type RecommendationRepository struct {
+	db *sql.DB
+}
+
+func NewRecommendationRepository(db *sql.DB) *RecommendationRepository {
+	return &RecommendationRepository{db: db}
+}
+
+func (r *RecommendationRepository) RebuildSimilarities(ctx context.Context, params recommendationdomain.ModelParams) (int, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = r.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("RecommendationRepo.RebuildSimilarities Begin Tx Error", "Error", err)
+			return 0, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("RecommendationRepo.RebuildSimilarities Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	_, err = tx.ExecContext(ctx, `LOCK TABLE movie_similarities IN EXCLUSIVE MODE`)
+	if err != nil {
+		slog.Error("RecommendationRepo.RebuildSimilarities Lock Error", "Error", err)
+		return 0, err
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM movie_similarities`)
+	if err != nil {
+		slog.Error("RecommendationRepo.RebuildSimilarities Delete Error", "Error", err)
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx, rebuildSimilaritiesQuery, params.MinCoRaters, params.Shrinkage, params.Neighbors)
+	if err != nil {
+		slog.Error("RecommendationRepo.RebuildSimilarities Insert Error", "Error", err)
+		return 0, err
+	}
+	pairs, err := result.RowsAffected()
+	if err != nil {
+		slog.Error("RecommendationRepo.RebuildSimilarities RowsAffected Error", "Error", err)
+		return 0, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("RecommendationRepo.RebuildSimilarities Commit Error", "Error", commitErr)
+			return 0, commitErr
+		}
+	}
+	return int(pairs), nil
+}
+
+func (r *RecommendationRepository) GetNeighbors(ctx context.Context, userID userobject.UserID) ([]*recommendationdomain.Neighbor, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = r.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("RecommendationRepo.GetNeighbors Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("RecommendationRepo.GetNeighbors Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	rows, err := tx.QueryContext(ctx, getNeighborsQuery, userID.ID())
+	if err != nil {
+		slog.Error("RecommendationRepo.GetNeighbors Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	neighbors := make([]*recommendationdomain.Neighbor, 0)
+	for rows.Next() {
+		var movieID, sourceID string
+		neighbor := &recommendationdomain.Neighbor{}
+		err = rows.Scan(&movieID, &sourceID, &neighbor.SourceTitle, &neighbor.UserRating, &neighbor.Similarity)
+		if err != nil {
+			slog.Error("RecommendationRepo.GetNeighbors Scan Error", "Error", err)
+			return nil, err
+		}
+		if neighbor.MovieID, err = movieobject.NewMovieID(movieID); err != nil {
+			slog.Error("RecommendationRepo.GetNeighbors MovieID Error", "Error", err)
+			return nil, err
+		}
+		if neighbor.SourceID, err = movieobject.NewMovieID(sourceID); err != nil {
+			slog.Error("RecommendationRepo.GetNeighbors MovieID Error", "Error", err)
+			return nil, err
+		}
+		neighbors = append(neighbors, neighbor)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("RecommendationRepo.GetNeighbors Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("RecommendationRepo.GetNeighbors Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return neighbors, nil
+}
+
+func (r *RecommendationRepository) GetSeeds(ctx context.Context, userID userobject.UserID, minRating int, limit int) ([]*recommendationdomain.Seed, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = r.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("RecommendationRepo.GetSeeds Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("RecommendationRepo.GetSeeds Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	rows, err := tx.QueryContext(ctx, getSeedsQuery, userID.ID(), minRating, limit)
+	if err != nil {
+		slog.Error("RecommendationRepo.GetSeeds Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	seeds := make([]*recommendationdomain.Seed, 0)
+	for rows.Next() {
+		var movieID string
+		seed := &recommendationdomain.Seed{}
+		err = rows.Scan(&movieID, &seed.Title, &seed.Rating, &seed.Favorite)
+		if err != nil {
+			slog.Error("RecommendationRepo.GetSeeds Scan Error", "Error", err)
+			return nil, err
+		}
+		if seed.MovieID, err = movieobject.NewMovieID(movieID); err != nil {
+			slog.Error("RecommendationRepo.GetSeeds MovieID Error", "Error", err)
+			return nil, err
+		}
+		seeds = append(seeds, seed)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("RecommendationRepo.GetSeeds Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("RecommendationRepo.GetSeeds Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return seeds, nil
+}
+
+func (r *RecommendationRepository) GetContentMatches(ctx context.Context, userID userobject.UserID, seedIDs []movieobject.MovieID) ([]*recommendationdomain.ContentMatch, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = r.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("RecommendationRepo.GetContentMatches Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("RecommendationRepo.GetContentMatches Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	ids := make([]string, 0, len(seedIDs))
+	for _, seedID := range seedIDs {
+		ids = append(ids, seedID.ID())
+	}
+	rows, err := tx.QueryContext(ctx, getContentMatchesQuery, userID.ID(), pq.Array(ids))
+	if err != nil {
+		slog.Error("RecommendationRepo.GetContentMatches Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	matches := make([]*recommendationdomain.ContentMatch, 0)
+	for rows.Next() {
+		var movieID, seedID string
+		match := &recommendationdomain.ContentMatch{}
+		err = rows.Scan(&movieID, &seedID, &match.SharedGenres, pq.Array(&match.Directors), pq.Array(&match.Actors))
+		if err != nil {
+			slog.Error("RecommendationRepo.GetContentMatches Scan Error", "Error", err)
+			return nil, err
+		}
+		if match.MovieID, err = movieobject.NewMovieID(movieID); err != nil {
+			slog.Error("RecommendationRepo.GetContentMatches MovieID Error", "Error", err)
+			return nil, err
+		}
+		if match.SeedID, err = movieobject.NewMovieID(seedID); err != nil {
+			slog.Error("RecommendationRepo.GetContentMatches MovieID Error", "Error", err)
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("RecommendationRepo.GetContentMatches Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("RecommendationRepo.GetContentMatches Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return matches, nil
+}
+
+func (r *RecommendationRepository) GetRatedMovieIDs(ctx context.Context, userID userobject.UserID) ([]movieobject.MovieID, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = r.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("RecommendationRepo.GetRatedMovieIDs Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("RecommendationRepo.GetRatedMovieIDs Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT movie_id FROM user_movies WHERE user_id = $1 AND movie_id IS NOT NULL AND user_rating != 0`
+	rows, err := tx.QueryContext(ctx, query, userID.ID())
+	if err != nil {
+		slog.Error("RecommendationRepo.GetRatedMovieIDs Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	movieIDs := make([]movieobject.MovieID, 0)
+	for rows.Next() {
+		var id string
+		err = rows.Scan(&id)
+		if err != nil {
+			slog.Error("RecommendationRepo.GetRatedMovieIDs Scan Error", "Error", err)
+			return nil, err
+		}
+		movieID, idErr := movieobject.NewMovieID(id)
+		if idErr != nil {
+			err = idErr
+			slog.Error("RecommendationRepo.GetRatedMovieIDs MovieID Error", "Error", err)
+			return nil, err
+		}
+		movieIDs = append(movieIDs, movieID)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("RecommendationRepo.GetRatedMovieIDs Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("RecommendationRepo.GetRatedMovieIDs Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return movieIDs, nil
+}
@@ -3,16 +3,17 @@ package reviewrepo
 import (
 	"time"
 
-	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
 	reviewdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/review"
 	object3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 )
 
 type ReviewModel struct {
 	ID          string
 	UserID      string
-	MovieID     string
+	TargetType  string
+	TargetID    string
 	Text        string
 	WritingDate time.Time
 	UserRating  int
@@ -24,12 +25,12 @@ func (r *ReviewModel) ToDomain() (*reviewdomain.Review, error) {
 		return nil, err
 	}
 
-	movieID, err := object2.NewMovieID(r.MovieID)
+	target, err := titleobject.NewTarget(r.TargetType, r.TargetID)
 	if err != nil {
 		return nil, err
 	}
 
-	review := reviewdomain.NewReview(userID, movieID)
+	review := reviewdomain.NewReview(userID, target)
 	reviewID, err := object3.NewReviewID(r.ID)
 	if err != nil {
 		return nil, err
@@ -8,11 +8,13 @@ import (
 	"time"
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
-	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
 	reviewdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/review"
 	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/error"
 	object3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+	titlerepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/title"
+	"github.com/lib/pq"
 )
 
 type ReviewRepository struct {
@@ -43,12 +45,12 @@ func (r *ReviewRepository) Save(ctx context.Context, review *reviewdomain.Review
 
 	if review.ID().IsEmpty() {
 		var newID string
-		query := `INSERT INTO reviews (user_id, movie_id, text, writing_date) VALUES 
-                                                                ($1, $2, $3, $4)
+		query := `INSERT INTO reviews (user_id, target_type, ` + titlerepo.TargetColumn(review.Target()) + `, text, writing_date) VALUES 
+                                                                ($1, $2, $3, $4, $5)
                                                                 RETURNING id`
-		execErr := tx.QueryRowContext(ctx, query, review.UserID().ID(), review.MovieID().ID(), review.Text(), review.WritingDate()).Scan(&newID)
+		execErr := tx.QueryRowContext(ctx, query, review.UserID().ID(), review.Target().Type(), review.Target().ID(), review.Text(), review.WritingDate()).Scan(&newID)
 		if execErr != nil {
-			slog.Error("ReviewRepo.Save Exec Error", "Error", execErr, "UserID", review.UserID().ID(), "MovieID", review.MovieID().ID())
+			slog.Error("ReviewRepo.Save Exec Error", "Error", execErr, "UserID", review.UserID().ID(), "TargetID", review.Target().ID())
 			err = execErr
 			return err
 		}
@@ -106,8 +108,8 @@ func (r *ReviewRepository) Delete(ctx context.Context, review *reviewdomain.Revi
 		}()
 	}
 
-	query := `DELETE FROM reviews WHERE user_id = $1 AND movie_id = $2`
-	result, execErr := tx.ExecContext(ctx, query, review.UserID().ID(), review.MovieID().ID())
+	query := `DELETE FROM reviews WHERE user_id = $1 AND target_type = $2 AND target_id = $3`
+	result, execErr := tx.ExecContext(ctx, query, review.UserID().ID(), review.Target().Type(), review.Target().ID())
 	if execErr != nil {
 		slog.Error("ReviewRepo.Delete Exec Error", "Error", execErr)
 		err = execErr
@@ -127,42 +129,42 @@ func (r *ReviewRepository) Delete(ctx context.Context, review *reviewdomain.Revi
 	return nil
 }
 
-func (r *ReviewRepository) GetReviewByUserAndMovie(ctx context.Context, userID object.UserID, movieID object2.MovieID) (*reviewdomain.Review, error) {
+func (r *ReviewRepository) GetReviewByUserAndTarget(ctx context.Context, userID object.UserID, target titleobject.Target) (*reviewdomain.Review, error) {
 	var err error
 	tx, ok := transactionmanager.GetTxFromCtx(ctx)
 	if !ok {
 		tx, err = r.db.BeginTx(ctx, nil)
 		if err != nil {
-			slog.Error("ReviewRepo.GetReviewByUserAndMovie Begin Tx Error", "Error", err)
+			slog.Error("ReviewRepo.GetReviewByUserAndTarget Begin Tx Error", "Error", err)
 			return nil, err
 		}
 		defer func() {
 			if err != nil {
 				if rollbackErr := tx.Rollback(); rollbackErr != nil {
-					slog.Error("ReviewRepo.GetReviewByUserAndMovie Rollback Error", "Error", rollbackErr)
+					slog.Error("ReviewRepo.GetReviewByUserAndTarget Rollback Error", "Error", rollbackErr)
 				}
 			}
 		}()
 	}
 
 	reviewModel := &ReviewModel{}
-	query := `SELECT id, user_id, movie_id, text, writing_date FROM reviews WHERE user_id = $1 AND movie_id = $2`
-	err = tx.QueryRowContext(ctx, query, userID.ID(), movieID.ID()).Scan(&reviewModel.ID, &reviewModel.UserID, &reviewModel.MovieID, &reviewModel.Text, &reviewModel.WritingDate)
+	query := `SELECT id, user_id, target_type, target_id, text, writing_date FROM reviews WHERE user_id = $1 AND target_type = $2 AND target_id = $3`
+	err = tx.QueryRowContext(ctx, query, userID.ID(), target.Type(), target.ID()).Scan(&reviewModel.ID, &reviewModel.UserID, &reviewModel.TargetType, &reviewModel.TargetID, &reviewModel.Text, &reviewModel.WritingDate)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, error2.ErrReviewNotFound
 	} else if err != nil {
-		slog.Error("ReviewRepo.GetReviewByUserAndMovie Query Error", "Error", err)
+		slog.Error("ReviewRepo.GetReviewByUserAndTarget Query Error", "Error", err)
 		return nil, err
 	}
 	review, err := reviewModel.ToDomain()
 	if err != nil {
-		slog.Error("ReviewRepo.GetReviewByUserAndMovie ToDomain Error", "Error", err)
+		slog.Error("ReviewRepo.GetReviewByUserAndTarget ToDomain Error", "Error", err)
 		return nil, err
 	}
 
 	if !ok {
 		if commitErr := tx.Commit(); commitErr != nil {
-			slog.Error("ReviewRepo.GetReviewByUserAndMovie Commit Error", "Error", err)
+			slog.Error("ReviewRepo.GetReviewByUserAndTarget Commit Error", "Error", err)
 			_ = tx.Rollback()
 			return nil, commitErr
 		}
@@ -171,35 +173,35 @@ func (r *ReviewRepository) GetReviewByUserAndMovie(ctx context.Context, userID o
 	return review, nil
 }
 
-func (r *ReviewRepository) GetReviewsByMovie(ctx context.Context, movieID object2.MovieID) ([]*reviewdomain.ReviewInfo, error) {
+func (r *ReviewRepository) GetReviewsByTarget(ctx context.Context, target titleobject.Target) ([]*reviewdomain.ReviewInfo, error) {
 	var err error
 	tx, ok := transactionmanager.GetTxFromCtx(ctx)
 	if !ok {
 		tx, err = r.db.BeginTx(ctx, nil)
 		if err != nil {
-			slog.Error("ReviewRepo.GetReviewsByMovie Begin Tx Error", "Error", err)
+			slog.Error("ReviewRepo.GetReviewsByTarget Begin Tx Error", "Error", err)
 			return nil, err
 		}
 		defer func() {
 			if err != nil {
 				if rollbackErr := tx.Rollback(); rollbackErr != nil {
-					slog.Error("ReviewRepo.GetReviewsByMovie Rollback Error", "Error", rollbackErr)
+					slog.Error("ReviewRepo.GetReviewsByTarget Rollback Error", "Error", rollbackErr)
 				}
 			}
 		}()
 	}
 
 	query := `SELECT id, (SELECT u.username FROM users AS u WHERE u.id = r.user_id), r.text, r.writing_date, COALESCE((SELECT um.user_rating FROM user_movies AS um
-              WHERE um.user_id = r.user_id AND um.movie_id = r.movie_id), 0), (SELECT COUNT(*) FROM review_likes AS rl WHERE rl.review_id = r.id) as likes FROM reviews AS r
-              WHERE r.movie_id = $1
+              WHERE um.user_id = r.user_id AND um.target_type = r.target_type AND um.target_id = r.target_id), 0), COALESCE((SELECT rs.like_count FROM review_stats AS rs WHERE rs.review_id = r.id), 0) as likes FROM reviews AS r
+              WHERE r.target_type = $1 AND r.target_id = $2
               ORDER BY likes DESC 
               LIMIT 100`
 
 	reviews := make([]*reviewdomain.ReviewInfo, 0)
-	rows, err := tx.QueryContext(ctx, query, movieID.ID())
+	rows, err := tx.QueryContext(ctx, query, target.Type(), target.ID())
 
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		slog.Error("ReviewRepo.GetReviewsByMovie Query Error", "Error", err)
+		slog.Error("ReviewRepo.GetReviewsByTarget Query Error", "Error", err)
 		return nil, err
 	}
 
@@ -212,7 +214,7 @@ func (r *ReviewRepository) GetReviewsByMovie(ctx context.Context, movieID object
 		reviewInfo.ReviewMonth = int(date.Month())
 		reviewInfo.ReviewDay = date.Day()
 		if err != nil {
-			slog.Error("ReviewRepo.GetReviewsByMovie Scan", "Error", err)
+			slog.Error("ReviewRepo.GetReviewsByTarget Scan", "Error", err)
 			return nil, err
 		}
 
@@ -221,13 +223,13 @@ func (r *ReviewRepository) GetReviewsByMovie(ctx context.Context, movieID object
 
 	err = rows.Err()
 	if err != nil {
-		slog.Error("ReviewRepo.GetReviewsByMovie Rows Error", "Error", err)
+		slog.Error("ReviewRepo.GetReviewsByTarget Rows Error", "Error", err)
 		return nil, err
 	}
 
 	if !ok {
 		if commitErr := tx.Commit(); commitErr != nil {
-			slog.Error("ReviewRepo.GetReviewsByMovie Commit Error", "Error", err)
+			slog.Error("ReviewRepo.GetReviewsByTarget Commit Error", "Error", err)
 			_ = tx.Rollback()
 			return nil, commitErr
 		}
@@ -235,35 +237,35 @@ func (r *ReviewRepository) GetReviewsByMovie(ctx context.Context, movieID object
 	return reviews, nil
 }
 
-func (r *ReviewRepository) GetReviewByMovieForUser(ctx context.Context, movieID object2.MovieID, userID object.UserID) ([]*reviewdomain.ReviewInfo, error) {
+func (r *ReviewRepository) GetReviewsByTargetForUser(ctx context.Context, target titleobject.Target, userID object.UserID) ([]*reviewdomain.ReviewInfo, error) {
 	var err error
 	tx, ok := transactionmanager.GetTxFromCtx(ctx)
 	if !ok {
 		tx, err = r.db.BeginTx(ctx, nil)
 		if err != nil {
-			slog.Error("ReviewRepo.GetReviewByMovieForUser Begin Tx Error", "Error", err)
+			slog.Error("ReviewRepo.GetReviewsByTargetForUser Begin Tx Error", "Error", err)
 			return nil, err
 		}
 		defer func() {
 			if err != nil {
 				if rollbackErr := tx.Rollback(); rollbackErr != nil {
-					slog.Error("ReviewRepo.GetReviewByMovieForUser Rollback Error", "Error", rollbackErr)
+					slog.Error("ReviewRepo.GetReviewsByTargetForUser Rollback Error", "Error", rollbackErr)
 				}
 			}
 		}()
 	}
 
 	query := `SELECT id, (SELECT u.username FROM users AS u WHERE u.id = r.user_id), r.text, r.writing_date, COALESCE((SELECT um.user_rating FROM user_movies AS um
-              WHERE um.user_id = r.user_id AND um.movie_id = r.movie_id), 0), EXISTS(SELECT 1 FROM review_likes AS rl WHERE rl.review_id = r.id AND rl.user_id = $2),  (SELECT COUNT(*) FROM review_likes AS rl WHERE rl.review_id = r.id) as likes FROM reviews AS r
-              WHERE r.movie_id = $1
+              WHERE um.user_id = r.user_id AND um.target_type = r.target_type AND um.target_id = r.target_id), 0), EXISTS(SELECT 1 FROM review_likes AS rl WHERE rl.review_id = r.id AND rl.user_id = $3),  COALESCE((SELECT rs.like_count FROM review_stats AS rs WHERE rs.review_id = r.id), 0) as likes FROM reviews AS r
+              WHERE r.target_type = $1 AND r.target_id = $2
               ORDER BY likes DESC 
               LIMIT 100`
 
 	reviews := make([]*reviewdomain.ReviewInfo, 0)
-	rows, err := tx.QueryContext(ctx, query, movieID.ID(), userID.ID())
+	rows, err := tx.QueryContext(ctx, query, target.Type(), target.ID(), userID.ID())
 
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		slog.Error("ReviewRepo.GetReviewByMovieForUser Query Error", "Error", err)
+		slog.Error("ReviewRepo.GetReviewsByTargetForUser Query Error", "Error", err)
 		return nil, err
 	}
 
@@ -276,7 +278,7 @@ func (r *ReviewRepository) GetReviewByMovieForUser(ctx context.Context, movieID
 		reviewInfo.ReviewMonth = int(date.Month())
 		reviewInfo.ReviewDay = date.Day()
 		if err != nil {
-			slog.Error("ReviewRepo.GetReviewByMovieForUser Scan", "Error", err)
+			slog.Error("ReviewRepo.GetReviewsByTargetForUser Scan", "Error", err)
 			return nil, err
 		}
 
@@ -285,13 +287,13 @@ func (r *ReviewRepository) GetReviewByMovieForUser(ctx context.Context, movieID
 
 	err = rows.Err()
 	if err != nil {
-		slog.Error("ReviewRepo.GetReviewByMovieForUser Rows Error", "Error", err)
+		slog.Error("ReviewRepo.GetReviewsByTargetForUser Rows Error", "Error", err)
 		return nil, err
 	}
 
 	if !ok {
 		if commitErr := tx.Commit(); commitErr != nil {
-			slog.Error("ReviewRepo.GetReviewByMovieForUser Commit Error", "Error", err)
+			slog.Error("ReviewRepo.GetReviewsByTargetForUser Commit Error", "Error", err)
 			_ = tx.Rollback()
 			return nil, commitErr
 		}
@@ -318,8 +320,8 @@ func (r *ReviewRepository) GetReviewByID(ctx context.Context, reviewID object3.R
 	}
 
 	reviewModel := &ReviewModel{}
-	query := `SELECT id, user_id, movie_id, text, writing_date FROM reviews WHERE id = $1`
-	err = tx.QueryRowContext(ctx, query, reviewID.ID()).Scan(&reviewModel.ID, &reviewModel.UserID, &reviewModel.MovieID, &reviewModel.Text, &reviewModel.WritingDate)
+	query := `SELECT id, user_id, target_type, target_id, text, writing_date FROM reviews WHERE id = $1`
+	err = tx.QueryRowContext(ctx, query, reviewID.ID()).Scan(&reviewModel.ID, &reviewModel.UserID, &reviewModel.TargetType, &reviewModel.TargetID, &reviewModel.Text, &reviewModel.WritingDate)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, error2.ErrReviewNotFound
 	} else if err != nil {
@@ -342,3 +344,71 @@ func (r *ReviewRepository) GetReviewByID(ctx context.Context, reviewID object3.R
 
 	return review, nil
 }
+
+func (r *ReviewRepository) GetReviewsByIDs(ctx context.Context, reviewIDs []object3.ReviewID) ([]*reviewdomain.ReviewInfo, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = r.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("ReviewRepo.GetReviewsByIDs Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("ReviewRepo.GetReviewsByIDs Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	ids := make([]string, 0, len(reviewIDs))
+	for _, reviewID := range reviewIDs {
+		ids = append(ids, reviewID.ID())
+	}
+	query := `SELECT id, (SELECT u.username FROM users AS u WHERE u.id = r.user_id), r.text, r.writing_date, COALESCE((SELECT um.user_rating FROM user_movies AS um
+              WHERE um.user_id = r.user_id AND um.target_type = r.target_type AND um.target_id = r.target_id), 0), COALESCE((SELECT rs.like_count FROM review_stats AS rs WHERE rs.review_id = r.id), 0) FROM reviews AS r
+              WHERE r.id = ANY($1)`
+	rows, err := tx.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		slog.Error("ReviewRepo.GetReviewsByIDs Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	found := make(map[string]*reviewdomain.ReviewInfo, len(reviewIDs))
+	for rows.Next() {
+		reviewInfo := &reviewdomain.ReviewInfo{}
+		var date time.Time
+		err = rows.Scan(&reviewInfo.ID, &reviewInfo.Username, &reviewInfo.Text, &date, &reviewInfo.UserRating, &reviewInfo.Likes)
+		if err != nil {
+			slog.Error("ReviewRepo.GetReviewsByIDs Scan Error", "Error", err)
+			return nil, err
+		}
+		reviewInfo.ReviewYear = date.Year()
+		reviewInfo.ReviewMonth = int(date.Month())
+		reviewInfo.ReviewDay = date.Day()
+		found[reviewInfo.ID] = reviewInfo
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("ReviewRepo.GetReviewsByIDs Rows Error", "Error", err)
+		return nil, err
+	}
+
+	reviews := make([]*reviewdomain.ReviewInfo, 0, len(found))
+	for _, reviewID := range reviewIDs {
+		if reviewInfo, exists := found[reviewID.ID()]; exists {
+			reviews = append(reviews, reviewInfo)
+		}
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("ReviewRepo.GetReviewsByIDs Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return reviews, nil
+}
@@ -0,0 +1,765 @@
+package series
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	seriesdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/series"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	"github.com/lib/pq"
+)
+
+const slugIndex = "idx_series_slug"
+
+const selectSeriesQuery = `SELECT s.id, s.slug, s.title, s.description, s.start_year, s.end_year,
+       COALESCE(r.rating, 0), r.rating_count,
+       (SELECT COUNT(*) FROM series_seasons AS se WHERE se.series_id = s.id AND se.number > 0),
+       (SELECT COUNT(*) FROM series_episodes AS e JOIN series_seasons AS se ON se.id = e.season_id
+        WHERE se.series_id = s.id AND se.number > 0)
+FROM series AS s
+LEFT JOIN LATERAL (SELECT AVG(um.user_rating)::float8 AS rating, COUNT(*) AS rating_count
+                   FROM user_movies AS um
+                   WHERE um.series_id = s.id AND um.user_rating != 0) AS r ON TRUE`
+
+const selectSeasonQuery = `SELECT se.id, se.series_id, se.number, se.title, se.air_date, COALESCE(r.rating, 0), r.rating_count
+FROM series_seasons AS se
+LEFT JOIN LATERAL (SELECT AVG(um.user_rating)::float8 AS rating, COUNT(*) AS rating_count
+                   FROM user_movies AS um
+                   WHERE um.season_id = se.id AND um.user_rating != 0) AS r ON TRUE`
+
+const selectEpisodeQuery = `SELECT e.id, e.season_id, se.series_id, se.number, e.number, e.title, e.description, e.air_date,
+       e.runtime_minutes, COALESCE(r.rating, 0), r.rating_count
+FROM series_episodes AS e
+JOIN series_seasons AS se ON se.id = e.season_id
+LEFT JOIN LATERAL (SELECT AVG(um.user_rating)::float8 AS rating, COUNT(*) AS rating_count
+                   FROM user_movies AS um
+                   WHERE um.episode_id = e.id AND um.user_rating != 0) AS r ON TRUE`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSeries(row rowScanner) (*seriesdomain.Series, error) {
+	var id, slug string
+	series := &seriesdomain.Series{}
+	err := row.Scan(&id, &slug, &series.Title, &series.Description, &series.StartYear, &series.EndYear,
+		&series.Rating, &series.RatingCount, &series.SeasonCount, &series.EpisodeCount)
+	if err != nil {
+		return nil, err
+	}
+	seriesID, _ := object.NewSeriesID(id)
+	_ = series.SetID(seriesID)
+	_ = series.SetSlug(slug)
+	return series, nil
+}
+
+func scanSeason(row rowScanner) (*seriesdomain.Season, error) {
+	var id, seriesID string
+	var airDate sql.NullTime
+	season := &seriesdomain.Season{Episodes: make([]*seriesdomain.Episode, 0)}
+	err := row.Scan(&id, &seriesID, &season.Number, &season.Title, &airDate, &season.Rating, &season.RatingCount)
+	if err != nil {
+		return nil, err
+	}
+	season.AirDate = airDate.Time
+	season.SeriesID, _ = object.NewSeriesID(seriesID)
+	seasonID, _ := object.NewSeasonID(id)
+	_ = season.SetID(seasonID)
+	return season, nil
+}
+
+func scanEpisode(row rowScanner) (*seriesdomain.Episode, error) {
+	var id, seasonID, seriesID string
+	var airDate sql.NullTime
+	episode := &seriesdomain.Episode{}
+	err := row.Scan(&id, &seasonID, &seriesID, &episode.SeasonNumber, &episode.Number, &episode.Title, &episode.Description,
+		&airDate, &episode.RuntimeMinutes, &episode.Rating, &episode.RatingCount)
+	if err != nil {
+		return nil, err
+	}
+	episode.AirDate = airDate.Time
+	episode.SeasonID, _ = object.NewSeasonID(seasonID)
+	episode.SeriesID, _ = object.NewSeriesID(seriesID)
+	episodeID, _ := object.NewEpisodeID(id)
+	_ = episode.SetID(episodeID)
+	return episode, nil
+}
+
+type SeriesRepository struct {
+	db *sql.DB
+}
+
+func NewSeriesRepository(db *sql.DB) *SeriesRepository {
+	return &SeriesRepository{db: db}
+}
+
+func (s *SeriesRepository) List(ctx context.Context, limit int, offset int) ([]*seriesdomain.Series, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo.List Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo.List Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectSeriesQuery + `
+ORDER BY s.title, s.start_year
+LIMIT $1 OFFSET $2`
+	rows, err := tx.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		slog.Error("SeriesRepo.List Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	seriesList := make([]*seriesdomain.Series, 0)
+	for rows.Next() {
+		series, scanErr := scanSeries(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("SeriesRepo.List Scan Error", "Error", err)
+			return nil, err
+		}
+		seriesList = append(seriesList, series)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("SeriesRepo.List Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo.List Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return seriesList, nil
+}
+
+func (s *SeriesRepository) Count(ctx context.Context) (int, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo.Count Begin Tx Error", "Error", err)
+			return 0, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo.Count Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	var total int
+	err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM series`).Scan(&total)
+	if err != nil {
+		slog.Error("SeriesRepo.Count Query Error", "Error", err)
+		return 0, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo.Count Commit Error", "Error", commitErr)
+			return 0, commitErr
+		}
+	}
+	return total, nil
+}
+
+func (s *SeriesRepository) GetByID(ctx context.Context, seriesID object.SeriesID) (*seriesdomain.Series, error) {
+	return s.getOne(ctx, "GetByID", selectSeriesQuery+` WHERE s.id = $1`, seriesID.ID())
+}
+
+func (s *SeriesRepository) GetBySlug(ctx context.Context, slug string) (*seriesdomain.Series, error) {
+	return s.getOne(ctx, "GetBySlug", selectSeriesQuery+` WHERE s.slug = $1`, slug)
+}
+
+func (s *SeriesRepository) getOne(ctx context.Context, op string, query string, arg any) (*seriesdomain.Series, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo."+op+" Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo."+op+" Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	series, err := scanSeries(tx.QueryRowContext(ctx, query, arg))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrSeriesIsNotFound
+	}
+	if err != nil {
+		slog.Error("SeriesRepo."+op+" row Scan Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo."+op+" Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return series, nil
+}
+
+func (s *SeriesRepository) GetIDBySlug(ctx context.Context, slug string) (object.SeriesID, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo.GetIDBySlug Begin Tx Error", "Error", err)
+			return object.SeriesID{}, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo.GetIDBySlug Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	var id string
+	err = tx.QueryRowContext(ctx, `SELECT id FROM series WHERE slug = $1`, slug).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return object.SeriesID{}, error2.ErrSeriesIsNotFound
+	} else if err != nil {
+		slog.Error("SeriesRepo.GetIDBySlug row Scan Error", "Error", err)
+		return object.SeriesID{}, err
+	}
+
+	seriesID, _ := object.NewSeriesID(id)
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo.GetIDBySlug Commit Error", "Error", commitErr)
+			return object.SeriesID{}, commitErr
+		}
+	}
+	return seriesID, nil
+}
+
+func (s *SeriesRepository) ExistsByID(ctx context.Context, seriesID object.SeriesID) (bool, error) {
+	return s.exists(ctx, "ExistsByID", `SELECT EXISTS(SELECT 1 FROM series WHERE id = $1)`, seriesID.ID())
+}
+
+func (s *SeriesRepository) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	return s.exists(ctx, "ExistsBySlug", `SELECT EXISTS(SELECT 1 FROM series WHERE slug = $1)`, slug)
+}
+
+func (s *SeriesRepository) exists(ctx context.Context, op string, query string, arg any) (bool, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo."+op+" Begin Tx Error", "Error", err)
+			return false, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo."+op+" Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, query, arg).Scan(&exists)
+	if err != nil {
+		slog.Error("SeriesRepo."+op+" Query Error", "Error", err)
+		return false, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo."+op+" Commit Error", "Error", commitErr)
+			return false, commitErr
+		}
+	}
+	return exists, nil
+}
+
+func (s *SeriesRepository) Save(ctx context.Context, series *seriesdomain.Series) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo.Save Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo.Save Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO series (slug, title, description, start_year, end_year) VALUES ($1, $2, $3, $4, $5) RETURNING id`
+	var newID string
+	err = tx.QueryRowContext(ctx, query, series.Slug(), series.Title, series.Description, series.StartYear, series.EndYear).Scan(&newID)
+	if err != nil {
+		if isUniqueViolation(err, slugIndex) {
+			slog.Error("SeriesRepo.Save slug already exists", "Slug", series.Slug())
+			return error2.ErrSeriesSlugAlreadyExists
+		}
+		if isUniqueViolation(err, "") {
+			slog.Error("SeriesRepo.Save series already exists", "Title", series.Title, "StartYear", series.StartYear)
+			return error2.ErrSeriesAlreadyExists
+		}
+		slog.Error("SeriesRepo.Save Query Error", "Error", err)
+		return err
+	}
+
+	seriesID, _ := object.NewSeriesID(newID)
+	_ = series.SetID(seriesID)
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo.Save Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (s *SeriesRepository) Update(ctx context.Context, series *seriesdomain.Series) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo.Update Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo.Update Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `UPDATE series SET slug = $1, title = $2, description = $3, start_year = $4, end_year = $5 WHERE id = $6`
+	result, execErr := tx.ExecContext(ctx, query, series.Slug(), series.Title, series.Description, series.StartYear, series.EndYear, series.ID().ID())
+	if execErr != nil {
+		err = execErr
+		if isUniqueViolation(execErr, slugIndex) {
+			return error2.ErrSeriesSlugAlreadyExists
+		}
+		if isUniqueViolation(execErr, "") {
+			return error2.ErrSeriesAlreadyExists
+		}
+		slog.Error("SeriesRepo.Update Exec Error", "Error", execErr)
+		return err
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("SeriesRepo.Update RowsAffected Error", "Error", rowsErr)
+		return err
+	}
+	if rowsAffected == 0 {
+		err = error2.ErrSeriesIsNotFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo.Update Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (s *SeriesRepository) Delete(ctx context.Context, seriesID object.SeriesID) error {
+	return s.delete(ctx, "Delete", `DELETE FROM series WHERE id = $1`, error2.ErrSeriesIsNotFound, seriesID.ID())
+}
+
+func (s *SeriesRepository) DeleteSeason(ctx context.Context, seriesID object.SeriesID, number int) error {
+	return s.delete(ctx, "DeleteSeason", `DELETE FROM series_seasons WHERE series_id = $1 AND number = $2`,
+		error2.ErrSeasonIsNotFound, seriesID.ID(), number)
+}
+
+func (s *SeriesRepository) DeleteEpisode(ctx context.Context, seriesID object.SeriesID, code object.EpisodeCode) error {
+	query := `DELETE FROM series_episodes AS e USING series_seasons AS se
+WHERE se.id = e.season_id AND se.series_id = $1 AND se.number = $2 AND e.number = $3`
+	return s.delete(ctx, "DeleteEpisode", query, error2.ErrEpisodeIsNotFound, seriesID.ID(), code.Season, code.Episode)
+}
+
+func (s *SeriesRepository) delete(ctx context.Context, op string, query string, notFound error, args ...any) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo."+op+" Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo."+op+" Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	result, execErr := tx.ExecContext(ctx, query, args...)
+	if execErr != nil {
+		err = execErr
+		slog.Error("SeriesRepo."+op+" Exec Error", "Error", execErr)
+		return err
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("SeriesRepo."+op+" RowsAffected Error", "Error", rowsErr)
+		return err
+	}
+	if rowsAffected == 0 {
+		err = notFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo."+op+" Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (s *SeriesRepository) GetSeasons(ctx context.Context, seriesID object.SeriesID) ([]*seriesdomain.Season, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo.GetSeasons Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo.GetSeasons Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectSeasonQuery + `
+WHERE se.series_id = $1
+ORDER BY se.number`
+	rows, err := tx.QueryContext(ctx, query, seriesID.ID())
+	if err != nil {
+		slog.Error("SeriesRepo.GetSeasons Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	seasons := make([]*seriesdomain.Season, 0)
+	for rows.Next() {
+		season, scanErr := scanSeason(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("SeriesRepo.GetSeasons Scan Error", "Error", err)
+			return nil, err
+		}
+		seasons = append(seasons, season)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("SeriesRepo.GetSeasons Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo.GetSeasons Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return seasons, nil
+}
+
+func (s *SeriesRepository) GetSeason(ctx context.Context, seriesID object.SeriesID, number int) (*seriesdomain.Season, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo.GetSeason Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo.GetSeason Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectSeasonQuery + ` WHERE se.series_id = $1 AND se.number = $2`
+	season, err := scanSeason(tx.QueryRowContext(ctx, query, seriesID.ID(), number))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrSeasonIsNotFound
+	}
+	if err != nil {
+		slog.Error("SeriesRepo.GetSeason row Scan Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo.GetSeason Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return season, nil
+}
+
+func (s *SeriesRepository) SaveSeason(ctx context.Context, season *seriesdomain.Season) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo.SaveSeason Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo.SaveSeason Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO series_seasons (series_id, number, title, air_date) VALUES ($1, $2, $3, $4)
+ON CONFLICT (series_id, number) DO UPDATE SET title = EXCLUDED.title, air_date = EXCLUDED.air_date
+RETURNING id`
+	var id string
+	err = tx.QueryRowContext(ctx, query, season.SeriesID.ID(), season.Number, season.Title, nullTime(season.AirDate)).Scan(&id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return error2.ErrSeriesIsNotFound
+		}
+		slog.Error("SeriesRepo.SaveSeason Query Error", "Error", err)
+		return err
+	}
+
+	seasonID, _ := object.NewSeasonID(id)
+	_ = season.SetID(seasonID)
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo.SaveSeason Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (s *SeriesRepository) GetEpisodes(ctx context.Context, seasonID object.SeasonID) ([]*seriesdomain.Episode, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo.GetEpisodes Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo.GetEpisodes Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectEpisodeQuery + `
+WHERE e.season_id = $1
+ORDER BY e.number`
+	rows, err := tx.QueryContext(ctx, query, seasonID.ID())
+	if err != nil {
+		slog.Error("SeriesRepo.GetEpisodes Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	episodes := make([]*seriesdomain.Episode, 0)
+	for rows.Next() {
+		episode, scanErr := scanEpisode(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("SeriesRepo.GetEpisodes Scan Error", "Error", err)
+			return nil, err
+		}
+		episodes = append(episodes, episode)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("SeriesRepo.GetEpisodes Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo.GetEpisodes Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return episodes, nil
+}
+
+func (s *SeriesRepository) GetEpisode(ctx context.Context, seriesID object.SeriesID, code object.EpisodeCode) (*seriesdomain.Episode, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo.GetEpisode Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo.GetEpisode Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectEpisodeQuery + ` WHERE se.series_id = $1 AND se.number = $2 AND e.number = $3`
+	episode, err := scanEpisode(tx.QueryRowContext(ctx, query, seriesID.ID(), code.Season, code.Episode))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrEpisodeIsNotFound
+	}
+	if err != nil {
+		slog.Error("SeriesRepo.GetEpisode row Scan Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo.GetEpisode Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return episode, nil
+}
+
+func (s *SeriesRepository) SaveEpisode(ctx context.Context, episode *seriesdomain.Episode) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SeriesRepo.SaveEpisode Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SeriesRepo.SaveEpisode Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO series_episodes (season_id, number, title, description, air_date, runtime_minutes)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (season_id, number) DO UPDATE SET title = EXCLUDED.title, description = EXCLUDED.description,
+    air_date = EXCLUDED.air_date, runtime_minutes = EXCLUDED.runtime_minutes
+RETURNING id`
+	var id string
+	err = tx.QueryRowContext(ctx, query, episode.SeasonID.ID(), episode.Number, episode.Title, episode.Description,
+		nullTime(episode.AirDate), episode.RuntimeMinutes).Scan(&id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return error2.ErrSeasonIsNotFound
+		}
+		slog.Error("SeriesRepo.SaveEpisode Query Error", "Error", err)
+		return err
+	}
+
+	episodeID, _ := object.NewEpisodeID(id)
+	_ = episode.SetID(episodeID)
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SeriesRepo.SaveEpisode Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func isUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) || pqErr.Code != "23505" {
+		return false
+	}
+	return constraint == "" || pqErr.Constraint == constraint
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
@@ -0,0 +1,591 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	sessiondomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/session"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/session/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/session/object"
+	userobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+	"github.com/lib/pq"
+)
+
+type SessionRepository struct {
+	db *sql.DB
+}
+
+func NewSessionRepository(db *sql.DB) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+const selectSessionQuery = `SELECT id, family_id, user_id, token_hash, user_agent, ip, started_at, last_seen_at, created_at, expires_at, rotated_at, revoked_at
+FROM sessions`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSession(row rowScanner) (*sessiondomain.Session, error) {
+	var familyID, userID string
+	var rotatedAt, revokedAt sql.NullTime
+	session := &sessiondomain.Session{}
+	err := row.Scan(&session.ID, &familyID, &userID, &session.TokenHash, &session.UserAgent, &session.IP, &session.StartedAt, &session.LastSeenAt,
+		&session.CreatedAt, &session.ExpiresAt, &rotatedAt, &revokedAt)
+	if err != nil {
+		return nil, err
+	}
+	if session.FamilyID, err = object.NewSessionID(familyID); err != nil {
+		return nil, err
+	}
+	if session.UserID, err = userobject.NewUserID(userID); err != nil {
+		return nil, err
+	}
+	if rotatedAt.Valid {
+		session.RotatedAt = &rotatedAt.Time
+	}
+	if revokedAt.Valid {
+		session.RevokedAt = &revokedAt.Time
+	}
+	return session, nil
+}
+
+func (s *SessionRepository) Save(ctx context.Context, session *sessiondomain.Session) (*sessiondomain.Session, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.Save Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.Save Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO sessions (family_id, user_id, token_hash, user_agent, ip, started_at, last_seen_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, created_at`
+	err = tx.QueryRowContext(ctx, query, session.FamilyID.ID(), session.UserID.ID(), session.TokenHash, session.UserAgent, session.IP, session.StartedAt,
+		session.LastSeenAt, session.ExpiresAt).Scan(&session.ID, &session.CreatedAt)
+	if err != nil {
+		slog.Error("SessionRepo.Save Insert Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.Save Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return session, nil
+}
+
+func (s *SessionRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*sessiondomain.Session, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.GetByTokenHash Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.GetByTokenHash Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	session, err := scanSession(tx.QueryRowContext(ctx, selectSessionQuery+`
+WHERE token_hash = $1`, tokenHash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = error2.ErrSessionIsNotFound
+			return nil, err
+		}
+		slog.Error("SessionRepo.GetByTokenHash Query Row Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.GetByTokenHash Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return session, nil
+}
+
+func (s *SessionRepository) MarkRotated(ctx context.Context, id string) (bool, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.MarkRotated Begin Tx Error", "Error", err)
+			return false, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.MarkRotated Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	result, err := tx.ExecContext(ctx, `UPDATE sessions SET rotated_at = now() WHERE id = $1 AND rotated_at IS NULL AND revoked_at IS NULL`, id)
+	if err != nil {
+		slog.Error("SessionRepo.MarkRotated Update Error", "Error", err)
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		slog.Error("SessionRepo.MarkRotated RowsAffected Error", "Error", err)
+		return false, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.MarkRotated Commit Error", "Error", commitErr)
+			return false, commitErr
+		}
+	}
+	return rowsAffected == 1, nil
+}
+
+func (s *SessionRepository) RevokeFamily(ctx context.Context, familyID object.SessionID) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.RevokeFamily Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.RevokeFamily Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE sessions SET revoked_at = now() WHERE family_id = $1 AND revoked_at IS NULL`, familyID.ID())
+	if err != nil {
+		slog.Error("SessionRepo.RevokeFamily Update Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.RevokeFamily Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (s *SessionRepository) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.RevokeToken Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.RevokeToken Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO revoked_tokens (token_id, expires_at) VALUES ($1, $2) ON CONFLICT (token_id) DO NOTHING`, tokenID, expiresAt)
+	if err != nil {
+		slog.Error("SessionRepo.RevokeToken Insert Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.RevokeToken Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (s *SessionRepository) GetActiveByUserID(ctx context.Context, userID userobject.UserID) ([]*sessiondomain.Session, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.GetActiveByUserID Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.GetActiveByUserID Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectSessionQuery + `
+WHERE user_id = $1 AND rotated_at IS NULL AND revoked_at IS NULL AND expires_at > now()
+ORDER BY last_seen_at DESC, started_at DESC`
+	rows, err := tx.QueryContext(ctx, query, userID.ID())
+	if err != nil {
+		slog.Error("SessionRepo.GetActiveByUserID Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]*sessiondomain.Session, 0)
+	for rows.Next() {
+		session, scanErr := scanSession(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("SessionRepo.GetActiveByUserID Scan Error", "Error", err)
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("SessionRepo.GetActiveByUserID Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.GetActiveByUserID Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return sessions, nil
+}
+
+func (s *SessionRepository) RevokeUserFamily(ctx context.Context, userID userobject.UserID, familyID object.SessionID) (bool, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.RevokeUserFamily Begin Tx Error", "Error", err)
+			return false, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.RevokeUserFamily Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `UPDATE sessions SET revoked_at = now() WHERE user_id = $1 AND family_id = $2 AND revoked_at IS NULL AND expires_at > now()`
+	result, err := tx.ExecContext(ctx, query, userID.ID(), familyID.ID())
+	if err != nil {
+		slog.Error("SessionRepo.RevokeUserFamily Update Error", "Error", err)
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		slog.Error("SessionRepo.RevokeUserFamily RowsAffected Error", "Error", err)
+		return false, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.RevokeUserFamily Commit Error", "Error", commitErr)
+			return false, commitErr
+		}
+	}
+	return rowsAffected > 0, nil
+}
+
+func (s *SessionRepository) RevokeOtherFamilies(ctx context.Context, userID userobject.UserID, keep object.SessionID) ([]object.SessionID, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.RevokeOtherFamilies Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.RevokeOtherFamilies Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `WITH revoked AS (
+    UPDATE sessions SET revoked_at = now()
+    WHERE user_id = $1 AND ($2 = '' OR family_id != NULLIF($2, '')::uuid) AND revoked_at IS NULL AND expires_at > now()
+    RETURNING family_id
+)
+SELECT DISTINCT family_id FROM revoked`
+	rows, err := tx.QueryContext(ctx, query, userID.ID(), keep.ID())
+	if err != nil {
+		slog.Error("SessionRepo.RevokeOtherFamilies Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	revoked := make([]object.SessionID, 0)
+	for rows.Next() {
+		var familyID string
+		if err = rows.Scan(&familyID); err != nil {
+			slog.Error("SessionRepo.RevokeOtherFamilies Scan Error", "Error", err)
+			return nil, err
+		}
+		sessionID, idErr := object.NewSessionID(familyID)
+		if idErr != nil {
+			err = idErr
+			slog.Error("SessionRepo.RevokeOtherFamilies Session ID Error", "Error", err)
+			return nil, err
+		}
+		revoked = append(revoked, sessionID)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("SessionRepo.RevokeOtherFamilies Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.RevokeOtherFamilies Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return revoked, nil
+}
+
+func (s *SessionRepository) TouchFamilies(ctx context.Context, seen map[string]time.Time) error {
+	if len(seen) == 0 {
+		return nil
+	}
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.TouchFamilies Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.TouchFamilies Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	familyIDs := make([]string, 0, len(seen))
+	seenAt := make([]float64, 0, len(seen))
+	for familyID, at := range seen {
+		familyIDs = append(familyIDs, familyID)
+		seenAt = append(seenAt, float64(at.UnixMilli())/1000)
+	}
+	query := `UPDATE sessions AS s SET last_seen_at = GREATEST(s.last_seen_at, to_timestamp(t.seen_at))
+FROM unnest($1::uuid[], $2::float8[]) AS t(family_id, seen_at)
+WHERE s.family_id = t.family_id AND s.rotated_at IS NULL AND s.revoked_at IS NULL`
+	_, err = tx.ExecContext(ctx, query, pq.Array(familyIDs), pq.Array(seenAt))
+	if err != nil {
+		slog.Error("SessionRepo.TouchFamilies Update Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.TouchFamilies Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (s *SessionRepository) GetRevocations(ctx context.Context, window time.Duration) (*sessiondomain.Revocations, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.GetRevocations Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.GetRevocations Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	revocations := sessiondomain.NewRevocations()
+	tokenRows, err := tx.QueryContext(ctx, `SELECT token_id, expires_at FROM revoked_tokens WHERE expires_at > now()`)
+	if err != nil {
+		slog.Error("SessionRepo.GetRevocations Tokens Query Error", "Error", err)
+		return nil, err
+	}
+	defer tokenRows.Close()
+	for tokenRows.Next() {
+		var tokenID string
+		var expiresAt time.Time
+		if err = tokenRows.Scan(&tokenID, &expiresAt); err != nil {
+			slog.Error("SessionRepo.GetRevocations Tokens Scan Error", "Error", err)
+			return nil, err
+		}
+		revocations.Tokens[tokenID] = expiresAt
+	}
+	if err = tokenRows.Err(); err != nil {
+		slog.Error("SessionRepo.GetRevocations Tokens Rows Error", "Error", err)
+		return nil, err
+	}
+
+	sessionRows, err := tx.QueryContext(ctx, `SELECT family_id, MAX(revoked_at) + make_interval(secs => $1) FROM sessions
+WHERE revoked_at > now() - make_interval(secs => $1)
+GROUP BY family_id`, window.Seconds())
+	if err != nil {
+		slog.Error("SessionRepo.GetRevocations Sessions Query Error", "Error", err)
+		return nil, err
+	}
+	defer sessionRows.Close()
+	for sessionRows.Next() {
+		var familyID string
+		var until time.Time
+		if err = sessionRows.Scan(&familyID, &until); err != nil {
+			slog.Error("SessionRepo.GetRevocations Sessions Scan Error", "Error", err)
+			return nil, err
+		}
+		revocations.Sessions[familyID] = until
+	}
+	if err = sessionRows.Err(); err != nil {
+		slog.Error("SessionRepo.GetRevocations Sessions Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.GetRevocations Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return revocations, nil
+}
+
+func (s *SessionRepository) IsRevoked(ctx context.Context, tokenID string, familyID string) (bool, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.IsRevoked Begin Tx Error", "Error", err)
+			return false, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.IsRevoked Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE token_id = $1 AND expires_at > now())
+    OR EXISTS (SELECT 1 FROM sessions WHERE family_id = NULLIF($2, '')::uuid AND revoked_at IS NOT NULL)`
+	var revoked bool
+	err = tx.QueryRowContext(ctx, query, tokenID, familyID).Scan(&revoked)
+	if err != nil {
+		slog.Error("SessionRepo.IsRevoked Query Error", "Error", err)
+		return false, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.IsRevoked Commit Error", "Error", commitErr)
+			return false, commitErr
+		}
+	}
+	return revoked, nil
+}
+
+func (s *SessionRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("SessionRepo.DeleteExpired Begin Tx Error", "Error", err)
+			return 0, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("SessionRepo.DeleteExpired Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM sessions WHERE expires_at < now()`)
+	if err != nil {
+		slog.Error("SessionRepo.DeleteExpired Sessions Delete Error", "Error", err)
+		return 0, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		slog.Error("SessionRepo.DeleteExpired RowsAffected Error", "Error", err)
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM revoked_tokens WHERE expires_at < now()`)
+	if err != nil {
+		slog.Error("SessionRepo.DeleteExpired Tokens Delete Error", "Error", err)
+		return 0, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("SessionRepo.DeleteExpired Commit Error", "Error", commitErr)
+			return 0, commitErr
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,121 @@
+package signingkey
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"log/slog"
+	"os"
+
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	usererror "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+	"github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/signingkey/signingkeyconfig"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const minRSAKeyBits = 2048
+
+func NewKeyRing(config signingkeyconfig.SigningKeyConfig, secretKey string) (*userdomain.KeyRing, error) {
+	algorithm := config.Algorithm
+	if algorithm == "" {
+		algorithm = object.AlgorithmHS256
+	}
+	if err := object.ValidateAlgorithm(algorithm); err != nil {
+		slog.Error("Unknown token signing algorithm", "algorithm", algorithm)
+		return nil, err
+	}
+
+	if algorithm == object.AlgorithmHS256 && len(config.Keys) == 0 {
+		if secretKey == "" {
+			slog.Error("Secret key is empty")
+			return nil, usererror.ErrSigningKeyIsNotConfigured
+		}
+		slog.Info("Tokens are signed with a shared secret", "algorithm", algorithm)
+		secret := []byte(secretKey)
+		return userdomain.NewKeyRing(config.SigningKeyID, object.SigningKey{ID: config.SigningKeyID, Algorithm: algorithm, Private: secret, Public: secret})
+	}
+
+	keys := make([]object.SigningKey, 0, len(config.Keys))
+	for _, keyConfig := range config.Keys {
+		if keyConfig.Algorithm == "" {
+			keyConfig.Algorithm = algorithm
+		}
+		key, err := loadKey(keyConfig)
+		if err != nil {
+			slog.Error("Error loading token signing key", "kid", keyConfig.ID, "error", err)
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	ring, err := userdomain.NewKeyRing(config.SigningKeyID, keys...)
+	if err != nil {
+		slog.Error("Error creating token key ring", "error", err)
+		return nil, err
+	}
+	slog.Info("Tokens are signed with key", "kid", ring.Signing().ID, "algorithm", ring.Signing().Algorithm, "keys", len(keys))
+	return ring, nil
+}
+
+func loadKey(config signingkeyconfig.KeyConfig) (object.SigningKey, error) {
+	key := object.SigningKey{ID: config.ID, Algorithm: config.Algorithm}
+	switch config.Algorithm {
+	case object.AlgorithmRS256:
+		if config.PrivateKeyFile != "" {
+			data, err := os.ReadFile(config.PrivateKeyFile)
+			if err != nil {
+				return object.SigningKey{}, err
+			}
+			privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+			if err != nil {
+				return object.SigningKey{}, usererror.ErrSigningKeyIsNotValid
+			}
+			key.Private, key.Public = privateKey, &privateKey.PublicKey
+		} else if config.PublicKeyFile != "" {
+			data, err := os.ReadFile(config.PublicKeyFile)
+			if err != nil {
+				return object.SigningKey{}, err
+			}
+			publicKey, err := jwt.ParseRSAPublicKeyFromPEM(data)
+			if err != nil {
+				return object.SigningKey{}, usererror.ErrSigningKeyIsNotValid
+			}
+			key.Public = publicKey
+		}
+		if publicKey, ok := key.Public.(*rsa.PublicKey); !ok || publicKey.N.BitLen() < minRSAKeyBits {
+			return object.SigningKey{}, usererror.ErrSigningKeyIsNotValid
+		}
+	case object.AlgorithmEdDSA:
+		if config.PrivateKeyFile != "" {
+			data, err := os.ReadFile(config.PrivateKeyFile)
+			if err != nil {
+				return object.SigningKey{}, err
+			}
+			privateKey, err := jwt.ParseEdPrivateKeyFromPEM(data)
+			if err != nil {
+				return object.SigningKey{}, usererror.ErrSigningKeyIsNotValid
+			}
+			edKey, ok := privateKey.(ed25519.PrivateKey)
+			if !ok {
+				return object.SigningKey{}, usererror.ErrSigningKeyIsNotValid
+			}
+			key.Private, key.Public = edKey, edKey.Public()
+		} else if config.PublicKeyFile != "" {
+			data, err := os.ReadFile(config.PublicKeyFile)
+			if err != nil {
+				return object.SigningKey{}, err
+			}
+			publicKey, err := jwt.ParseEdPublicKeyFromPEM(data)
+			if err != nil {
+				return object.SigningKey{}, usererror.ErrSigningKeyIsNotValid
+			}
+			key.Public = publicKey
+		}
+		if _, ok := key.Public.(ed25519.PublicKey); !ok {
+			return object.SigningKey{}, usererror.ErrSigningKeyIsNotValid
+		}
+	default:
+		return object.SigningKey{}, usererror.ErrSigningAlgorithmIsNotValid
+	}
+	return key, nil
+}
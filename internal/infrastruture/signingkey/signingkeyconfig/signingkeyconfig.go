@@ -0,0 +1,14 @@
+package signingkeyconfig
+
+type SigningKeyConfig struct {
+	Algorithm    string      `yaml:"algorithm"`
+	SigningKeyID string      `yaml:"signing_key_id"`
+	Keys         []KeyConfig `yaml:"keys"`
+}
+
+type KeyConfig struct {
+	ID             string `yaml:"kid"`
+	Algorithm      string `yaml:"algorithm"`
+	PrivateKeyFile string `yaml:"private_key_file"`
+	PublicKeyFile  string `yaml:"public_key_file"`
+}
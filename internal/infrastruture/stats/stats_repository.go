@@ -0,0 +1,187 @@
+package stats
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	movieobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	reviewobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/review/object"
+	statsdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/stats"
+)
+
+const rebuildMovieStatsQuery = `INSERT INTO movie_stats (movie_id, rating_sum, rating_count, review_count, favorite_count, watchlist_count)
+SELECT m.id,
+       COALESCE(um.rating_sum, 0), COALESCE(um.rating_count, 0), COALESCE(rv.review_count, 0),
+       COALESCE(um.favorite_count, 0), COALESCE(um.watchlist_count, 0)
+FROM movies AS m
+LEFT JOIN (SELECT movie_id,
+                  SUM(user_rating) FILTER (WHERE user_rating != 0) AS rating_sum,
+                  COUNT(*) FILTER (WHERE user_rating != 0) AS rating_count,
+                  COUNT(*) FILTER (WHERE list_type = 'favorite') AS favorite_count,
+                  COUNT(*) FILTER (WHERE list_type = 'watchlist') AS watchlist_count
+           FROM user_movies
+           WHERE movie_id IS NOT NULL
+           GROUP BY movie_id) AS um ON um.movie_id = m.id
+LEFT JOIN (SELECT movie_id, COUNT(*) AS review_count
+           FROM reviews
+           WHERE movie_id IS NOT NULL
+           GROUP BY movie_id) AS rv ON rv.movie_id = m.id`
+
+const rebuildReviewStatsQuery = `INSERT INTO review_stats (review_id, like_count)
+SELECT review_id, COUNT(*) FROM review_likes GROUP BY review_id`
+
+type StatsRepository struct {
+	db *sql.DB
+}
+
+func NewStatsRepository(db *sql.DB) *StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+func (s *StatsRepository) ApplyMovieDelta(ctx context.Context, movieID movieobject.MovieID, delta statsdomain.MovieStatsDelta) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("StatsRepo.ApplyMovieDelta Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("StatsRepo.ApplyMovieDelta Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO movie_stats (movie_id, rating_sum, rating_count, review_count, favorite_count, watchlist_count)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (movie_id) DO UPDATE SET rating_sum = movie_stats.rating_sum + EXCLUDED.rating_sum,
+                                     rating_count = movie_stats.rating_count + EXCLUDED.rating_count,
+                                     review_count = movie_stats.review_count + EXCLUDED.review_count,
+                                     favorite_count = movie_stats.favorite_count + EXCLUDED.favorite_count,
+                                     watchlist_count = movie_stats.watchlist_count + EXCLUDED.watchlist_count`
+	_, err = tx.ExecContext(ctx, query, movieID.ID(), delta.RatingSum, delta.RatingCount, delta.ReviewCount, delta.FavoriteCount, delta.WatchlistCount)
+	if err != nil {
+		slog.Error("StatsRepo.ApplyMovieDelta Exec Error", "Error", err, "movieID", movieID.ID())
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("StatsRepo.ApplyMovieDelta Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (s *StatsRepository) ApplyReviewDelta(ctx context.Context, reviewID reviewobject.ReviewID, likes int) error {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("StatsRepo.ApplyReviewDelta Begin Tx Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("StatsRepo.ApplyReviewDelta Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO review_stats (review_id, like_count) VALUES ($1, $2)
+ON CONFLICT (review_id) DO UPDATE SET like_count = review_stats.like_count + EXCLUDED.like_count`
+	_, err = tx.ExecContext(ctx, query, reviewID.ID(), likes)
+	if err != nil {
+		slog.Error("StatsRepo.ApplyReviewDelta Exec Error", "Error", err, "reviewID", reviewID.ID())
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("StatsRepo.ApplyReviewDelta Commit Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (s *StatsRepository) Reconcile(ctx context.Context) (*statsdomain.ReconcileReport, error) {
+	var err error
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	if !ok {
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("StatsRepo.Reconcile Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("StatsRepo.Reconcile Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	_, err = tx.ExecContext(ctx, `LOCK TABLE movie_stats, review_stats IN EXCLUSIVE MODE`)
+	if err != nil {
+		slog.Error("StatsRepo.Reconcile Lock Error", "Error", err)
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM movie_stats`)
+	if err != nil {
+		slog.Error("StatsRepo.Reconcile Delete Movie Stats Error", "Error", err)
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM review_stats`)
+	if err != nil {
+		slog.Error("StatsRepo.Reconcile Delete Review Stats Error", "Error", err)
+		return nil, err
+	}
+
+	report := &statsdomain.ReconcileReport{}
+	result, err := tx.ExecContext(ctx, rebuildMovieStatsQuery)
+	if err != nil {
+		slog.Error("StatsRepo.Reconcile Rebuild Movie Stats Error", "Error", err)
+		return nil, err
+	}
+	movies, err := result.RowsAffected()
+	if err != nil {
+		slog.Error("StatsRepo.Reconcile RowsAffected Error", "Error", err)
+		return nil, err
+	}
+	report.Movies = int(movies)
+
+	result, err = tx.ExecContext(ctx, rebuildReviewStatsQuery)
+	if err != nil {
+		slog.Error("StatsRepo.Reconcile Rebuild Review Stats Error", "Error", err)
+		return nil, err
+	}
+	reviews, err := result.RowsAffected()
+	if err != nil {
+		slog.Error("StatsRepo.Reconcile RowsAffected Error", "Error", err)
+		return nil, err
+	}
+	report.Reviews = int(reviews)
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("StatsRepo.Reconcile Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,14 @@
+package title
+
+import titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
+
+var targetColumns = map[titleobject.TargetType]string{
+	titleobject.TargetTypeMovie:   "movie_id",
+	titleobject.TargetTypeSeries:  "series_id",
+	titleobject.TargetTypeSeason:  "season_id",
+	titleobject.TargetTypeEpisode: "episode_id",
+}
+
+func TargetColumn(target titleobject.Target) string {
+	return targetColumns[target.Type()]
+}
@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+func (u *UserRepository) MarkVerificationSent(ctx context.Context, userID object.UserID, minInterval time.Duration) (bool, error) {
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	var err error
+	if !ok {
+		tx, err = u.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("UserRepo.MarkVerificationSent Begin Tx Error", "Error", err)
+			return false, err
+		}
+		defer func() {
+			if err != nil {
+				_ = tx.Rollback()
+			}
+		}()
+	}
+
+	query := `UPDATE users SET verification_sent_at = now()
+WHERE id = $1 AND (verification_sent_at IS NULL OR verification_sent_at <= now() - make_interval(secs => $2))`
+	result, err := tx.ExecContext(ctx, query, userID.ID(), minInterval.Seconds())
+	if err != nil {
+		slog.Error("UserRepo.MarkVerificationSent Exec Error", "Error", err)
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		slog.Error("UserRepo.MarkVerificationSent RowsAffected Error", "Error", err)
+		return false, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("UserRepo.MarkVerificationSent Commit Error", "Error", commitErr)
+			return false, commitErr
+		}
+	}
+	return rowsAffected > 0, nil
+}
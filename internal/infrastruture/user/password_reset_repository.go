@@ -0,0 +1,141 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	userdomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/user"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/user/error"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+)
+
+func (u *UserRepository) SavePasswordReset(ctx context.Context, reset *userdomain.PasswordReset) (*userdomain.PasswordReset, error) {
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	var err error
+	if !ok {
+		tx, err = u.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("UserRepo.SavePasswordReset Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				_ = tx.Rollback()
+			}
+		}()
+	}
+
+	query := `INSERT INTO password_reset_tokens (user_id, token_hash, expires_at) VALUES ($1, $2, $3) RETURNING id, created_at`
+	err = tx.QueryRowContext(ctx, query, reset.UserID.ID(), reset.TokenHash, reset.ExpiresAt).Scan(&reset.ID, &reset.CreatedAt)
+	if err != nil {
+		slog.Error("UserRepo.SavePasswordReset Query Row Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("UserRepo.SavePasswordReset Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return reset, nil
+}
+
+func (u *UserRepository) GetPasswordResetByHash(ctx context.Context, tokenHash string) (*userdomain.PasswordReset, error) {
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	var err error
+	if !ok {
+		tx, err = u.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("UserRepo.GetPasswordResetByHash Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				_ = tx.Rollback()
+			}
+		}()
+	}
+
+	var userID string
+	var usedAt sql.NullTime
+	reset := &userdomain.PasswordReset{}
+	query := `SELECT id, user_id, token_hash, created_at, expires_at, used_at FROM password_reset_tokens WHERE token_hash = $1`
+	err = tx.QueryRowContext(ctx, query, tokenHash).Scan(&reset.ID, &userID, &reset.TokenHash, &reset.CreatedAt, &reset.ExpiresAt, &usedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, error2.ErrPasswordResetTokenIsNotValid
+		}
+		slog.Error("UserRepo.GetPasswordResetByHash Query Row Error", "Error", err)
+		return nil, err
+	}
+	reset.UserID, err = object.NewUserID(userID)
+	if err != nil {
+		slog.Error("UserRepo.GetPasswordResetByHash User ID Error", "Error", err)
+		return nil, err
+	}
+	if usedAt.Valid {
+		reset.UsedAt = &usedAt.Time
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("UserRepo.GetPasswordResetByHash Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return reset, nil
+}
+
+func (u *UserRepository) ConsumePasswordResets(ctx context.Context, userID object.UserID) ([]string, error) {
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	var err error
+	if !ok {
+		tx, err = u.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("UserRepo.ConsumePasswordResets Begin Tx Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				_ = tx.Rollback()
+			}
+		}()
+	}
+
+	query := `UPDATE password_reset_tokens SET used_at = now() WHERE user_id = $1 AND used_at IS NULL RETURNING id`
+	rows, err := tx.QueryContext(ctx, query, userID.ID())
+	if err != nil {
+		slog.Error("UserRepo.ConsumePasswordResets Query Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	consumed := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			slog.Error("UserRepo.ConsumePasswordResets Scan Error", "Error", err)
+			return nil, err
+		}
+		consumed = append(consumed, id)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("UserRepo.ConsumePasswordResets Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("UserRepo.ConsumePasswordResets Commit Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return consumed, nil
+}
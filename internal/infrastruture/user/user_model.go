@@ -10,11 +10,16 @@ type UserModel struct {
 	Username string
 	Email    string
 	Password string
+	Role     string
+	Verified bool
 }
 
 func (u *UserModel) ToDomain() *userdomain.User {
 	user := userdomain.NewUser(u.Username, u.Password, u.Email)
 	userID, _ := object.NewUserID(u.ID)
 	_ = user.SetID(userID)
+	role, _ := object.NewRole(u.Role)
+	user.SetRole(role)
+	user.SetEmailVerified(u.Verified)
 	return user
 }
@@ -36,12 +36,14 @@ func (u *UserRepository) GetByUserID(ctx context.Context, id object.UserID) (*us
 		}()
 	}
 	userModel := &UserModel{}
-	query := "SELECT id, username, email, password_hash FROM users WHERE id = $1"
+	query := "SELECT id, username, email, password_hash, role, email_verified FROM users WHERE id = $1"
 	err = tx.QueryRowContext(ctx, query, id.ID()).Scan(
 		&userModel.ID,
 		&userModel.Username,
 		&userModel.Email,
-		&userModel.Password)
+		&userModel.Password,
+		&userModel.Role,
+		&userModel.Verified)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, error2.ErrUserIsNotFound
@@ -76,12 +78,14 @@ func (u *UserRepository) GetByEmail(ctx context.Context, email string) (*userdom
 		}()
 	}
 	userModel := &UserModel{}
-	query := "SELECT id, username, email, password_hash FROM users WHERE email = $1"
+	query := "SELECT id, username, email, password_hash, role, email_verified FROM users WHERE email = $1"
 	err = tx.QueryRowContext(ctx, query, email).Scan(
 		&userModel.ID,
 		&userModel.Username,
 		&userModel.Email,
-		&userModel.Password)
+		&userModel.Password,
+		&userModel.Role,
+		&userModel.Verified)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, error2.ErrUserIsNotFound
@@ -117,10 +121,10 @@ func (u *UserRepository) Save(ctx context.Context, user *userdomain.User) (*user
 
 	if user.ID().IsEmpty() {
 		query := `
-INSERT INTO users (username, email, password_hash) VALUES ($1, $2, $3)
+INSERT INTO users (username, email, password_hash, role, email_verified) VALUES ($1, $2, $3, $4, $5)
 RETURNING id`
 		var newID string
-		err = tx.QueryRowContext(ctx, query, user.Username(), user.Email(), user.Password()).Scan(&newID)
+		err = tx.QueryRowContext(ctx, query, user.Username(), user.Email(), user.Password(), user.Role().String(), user.EmailVerified()).Scan(&newID)
 		if err != nil {
 			slog.Error("UserRepo.Save Query Row Error", "Error", err)
 			return nil, err
@@ -131,9 +135,9 @@ RETURNING id`
 	} else {
 		query := `
 UPDATE users
-SET username = $1, email = $2, password_hash = $3
-WHERE id = $4`
-		result, execErr := tx.ExecContext(ctx, query, user.Username(), user.Email(), user.Password(), user.ID())
+SET username = $1, email = $2, password_hash = $3, role = $4, email_verified = $5
+WHERE id = $6`
+		result, execErr := tx.ExecContext(ctx, query, user.Username(), user.Email(), user.Password(), user.Role().String(), user.EmailVerified(), user.ID().ID())
 		if execErr != nil {
 			err = execErr
 			slog.Error("UserRepo.Save Exec Error", "Error", err)
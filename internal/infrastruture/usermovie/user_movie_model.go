@@ -1,7 +1,7 @@
 package usermovie
 
 import (
-	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 	usermoviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
 	object3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie/object"
@@ -10,7 +10,8 @@ import (
 type UserMovieModel struct {
 	ID         string
 	UserID     string
-	MovieID    string
+	TargetType string
+	TargetID   string
 	ListType   string
 	UserRating int
 }
@@ -20,7 +21,7 @@ func (u *UserMovieModel) ToDomain() (*usermoviedomain.UserMovie, error) {
 	if err != nil {
 		return nil, err
 	}
-	movieID, err := object2.NewMovieID(u.MovieID)
+	target, err := titleobject.NewTarget(u.TargetType, u.TargetID)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +29,7 @@ func (u *UserMovieModel) ToDomain() (*usermoviedomain.UserMovie, error) {
 	if err != nil {
 		return nil, err
 	}
-	userMovie := usermoviedomain.NewUserMovie(userID, movieID)
+	userMovie := usermoviedomain.NewUserMovie(userID, target)
 	err = userMovie.SetUserMovieID(userMovieID)
 	if err != nil {
 		return nil, err
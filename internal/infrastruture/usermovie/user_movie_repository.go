@@ -8,10 +8,12 @@ import (
 
 	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
 	object2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/movie/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
 	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
 	usermoviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
 	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie/error"
 	object3 "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie/object"
+	titlerepo "github.com/Vlad-Ali/Movies-service-back/internal/infrastruture/title"
 	"github.com/lib/pq"
 )
 
@@ -50,10 +52,11 @@ func (u *UserMovieRepository) Save(ctx context.Context, userMovie *usermoviedoma
 	}
 
 	if userMovie.UserMovieID().IsEmpty() {
-		query := `INSERT INTO user_movies (user_id, movie_id, list_type, user_rating) VALUES ($1, $2, $3, $4)
+		query := `INSERT INTO user_movies (user_id, target_type, ` + titlerepo.TargetColumn(userMovie.Target()) + `, list_type, user_rating, rated_at, listed_at)
+VALUES ($1, $2, $3, $4, $5, CASE WHEN $5::integer != 0 THEN now() END, CASE WHEN $4::varchar IS NOT NULL THEN now() END)
 RETURNING id`
 		var newID string
-		err = tx.QueryRowContext(ctx, query, userMovie.UserID().ID(), userMovie.MovieID().ID(), listType, userMovie.UserRating()).Scan(&newID)
+		err = tx.QueryRowContext(ctx, query, userMovie.UserID().ID(), userMovie.Target().Type(), userMovie.Target().ID(), listType, userMovie.UserRating()).Scan(&newID)
 		if err != nil {
 			slog.Error("UserMovieRepository.Save Error", "Error", err)
 			return err
@@ -63,8 +66,11 @@ RETURNING id`
 		_ = userMovie.SetUserMovieID(userMovieID)
 	} else {
 		query := `
-UPDATE user_movies SET list_type=$1, user_rating=$2 WHERE user_id=$3 AND movie_id=$4`
-		result, execErr := tx.ExecContext(ctx, query, listType, userMovie.UserRating(), userMovie.UserID().ID(), userMovie.MovieID().ID())
+UPDATE user_movies SET list_type=$1, user_rating=$2,
+    rated_at = CASE WHEN $2::integer = 0 THEN NULL WHEN user_rating IS DISTINCT FROM $2::integer THEN now() ELSE rated_at END,
+    listed_at = CASE WHEN $1::varchar IS NULL THEN NULL WHEN list_type IS DISTINCT FROM $1::varchar THEN now() ELSE listed_at END
+WHERE user_id=$3 AND target_type=$4 AND target_id=$5`
+		result, execErr := tx.ExecContext(ctx, query, listType, userMovie.UserRating(), userMovie.UserID().ID(), userMovie.Target().Type(), userMovie.Target().ID())
 		if execErr != nil {
 			err = execErr
 			slog.Error("UserMovieRepository.Save Error", "Error", execErr)
@@ -110,8 +116,8 @@ func (u *UserMovieRepository) Delete(ctx context.Context, userMovie *usermoviedo
 			}
 		}()
 	}
-	query := `DELETE FROM user_movies WHERE user_id=$1 AND movie_id=$2`
-	result, execErr := tx.ExecContext(ctx, query, userMovie.UserID().ID(), userMovie.MovieID().ID())
+	query := `DELETE FROM user_movies WHERE user_id=$1 AND target_type=$2 AND target_id=$3`
+	result, execErr := tx.ExecContext(ctx, query, userMovie.UserID().ID(), userMovie.Target().Type(), userMovie.Target().ID())
 	if execErr != nil {
 		err = execErr
 		slog.Error("UserMovieRepository.Delete Error", "Error", err)
@@ -138,46 +144,55 @@ func (u *UserMovieRepository) Delete(ctx context.Context, userMovie *usermoviedo
 	return nil
 }
 
-func (u *UserMovieRepository) GetByUserAndMovie(ctx context.Context, userID object.UserID, movieID object2.MovieID) (*usermoviedomain.UserMovie, error) {
+func (u *UserMovieRepository) GetByUserAndTarget(ctx context.Context, userID object.UserID, target titleobject.Target) (*usermoviedomain.UserMovie, error) {
+	return u.getByUserAndTarget(ctx, userID, target, "GetByUserAndTarget", "")
+}
+
+func (u *UserMovieRepository) GetByUserAndTargetForUpdate(ctx context.Context, userID object.UserID, target titleobject.Target) (*usermoviedomain.UserMovie, error) {
+	return u.getByUserAndTarget(ctx, userID, target, "GetByUserAndTargetForUpdate", "\nFOR UPDATE")
+}
+
+func (u *UserMovieRepository) getByUserAndTarget(ctx context.Context, userID object.UserID, target titleobject.Target, method string, lock string) (*usermoviedomain.UserMovie, error) {
 	tx, ok := transactionmanager.GetTxFromCtx(ctx)
 	var err error
 	if !ok {
 		tx, err = u.db.BeginTx(ctx, nil)
 		if err != nil {
-			slog.Error("UserMovieRepository.GetByUserAndMovie Error", "Error", err)
+			slog.Error("UserMovieRepository."+method+" Error", "Error", err)
 			return nil, err
 		}
 		defer func() {
 			if err != nil {
 				if rollbackErr := tx.Rollback(); rollbackErr != nil {
-					slog.Error("UserMovieRepository.GetByUserAndMovie Rollback Error", "Error", rollbackErr)
+					slog.Error("UserMovieRepository."+method+" Rollback Error", "Error", rollbackErr)
 				}
 			}
 		}()
 	}
 	var nullListType sql.NullString
 	userMovieModel := &UserMovieModel{}
-	query := `SELECT id, user_id, movie_id, list_type, user_rating
-FROM user_movies WHERE user_id=$1 AND movie_id=$2`
-	err = tx.QueryRowContext(ctx, query, userID.ID(), movieID.ID()).Scan(&userMovieModel.ID, &userMovieModel.UserID, &userMovieModel.MovieID, &nullListType, &userMovieModel.UserRating)
+	query := `SELECT id, user_id, target_type, target_id, list_type, user_rating
+FROM user_movies WHERE user_id=$1 AND target_type=$2 AND target_id=$3` + lock
+	err = tx.QueryRowContext(ctx, query, userID.ID(), target.Type(), target.ID()).Scan(&userMovieModel.ID, &userMovieModel.UserID, &userMovieModel.TargetType,
+		&userMovieModel.TargetID, &nullListType, &userMovieModel.UserRating)
 	userMovieModel.ListType = nullListType.String
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, error2.ErrUserMovieIsNotFound
 		}
-		slog.Error("UserMovieRepository.GetByUserAndMovie Error", "Error", err)
+		slog.Error("UserMovieRepository."+method+" Error", "Error", err)
 		return nil, err
 	}
 	userMovie, err := userMovieModel.ToDomain()
 	if err != nil {
-		slog.Error("UserMovieRepository.GetByUserAndMovie Error", "Error", err)
+		slog.Error("UserMovieRepository."+method+" Error", "Error", err)
 		return nil, err
 	}
 
 	if !ok {
 		if commitErr := tx.Commit(); commitErr != nil {
 			_ = tx.Rollback()
-			slog.Error("UserMovieRepository.GetByUserAndMovie Error", "Error", commitErr)
+			slog.Error("UserMovieRepository."+method+" Error", "Error", commitErr)
 			return nil, commitErr
 		}
 	}
@@ -205,16 +220,19 @@ func (u *UserMovieRepository) GetMoviesByUserAndListType(ctx context.Context, us
 
 	var movieUserInfos []*usermoviedomain.MovieUserInfo
 	query := `SELECT 
+            m.id,
+            m.slug,
             m.title,
             m.description,
             m.release_date,
             m.director,
             m.actors,
-            m.genres,
+            ARRAY(SELECT g.name FROM movie_genres AS mg JOIN genres AS g ON g.id = mg.genre_id
+                  WHERE mg.movie_id = m.id ORDER BY g.name) AS genres,
             COALESCE((
-                SELECT AVG(um2.user_rating)
-                FROM user_movies um2 
-                WHERE um2.movie_id = m.id AND um2.user_rating != 0
+                SELECT ms.rating_sum::float8 / NULLIF(ms.rating_count, 0)
+                FROM movie_stats ms
+                WHERE ms.movie_id = m.id
             ), 0) as rating,
             COALESCE(um.user_rating, 0) as user_rating
             FROM movies m
@@ -236,7 +254,7 @@ func (u *UserMovieRepository) GetMoviesByUserAndListType(ctx context.Context, us
 	movieUserInfos = make([]*usermoviedomain.MovieUserInfo, 0)
 	for rows.Next() {
 		movieUserInfo := &usermoviedomain.MovieUserInfo{Actors: make([]string, 0), Genres: make([]string, 0)}
-		err = rows.Scan(&movieUserInfo.Title, &movieUserInfo.Description, &movieUserInfo.ReleaseDate, &movieUserInfo.Director,
+		err = rows.Scan(&movieUserInfo.ID, &movieUserInfo.Slug, &movieUserInfo.Title, &movieUserInfo.Description, &movieUserInfo.ReleaseDate, &movieUserInfo.Director,
 			pq.Array(&movieUserInfo.Actors), pq.Array(&movieUserInfo.Genres), &movieUserInfo.Rating, &movieUserInfo.UserRating)
 		if err != nil {
 			slog.Error("UserMovieRepository.GetMoviesByUserAndListType Error", "Error", err)
@@ -276,16 +294,19 @@ func (u *UserMovieRepository) GetMovieByUserAndListType(ctx context.Context, use
 
 	movieUserInfo := &usermoviedomain.MovieUserInfo{Actors: make([]string, 0), Genres: make([]string, 0)}
 	query := `SELECT 
+            m.id,
+            m.slug,
             m.title,
             m.description,
             m.release_date,
             m.director,
             m.actors,
-            m.genres,
+            ARRAY(SELECT g.name FROM movie_genres AS mg JOIN genres AS g ON g.id = mg.genre_id
+                  WHERE mg.movie_id = m.id ORDER BY g.name) AS genres,
             COALESCE((
-                SELECT AVG(um2.user_rating)
-                FROM user_movies um2 
-                WHERE um2.movie_id = m.id AND um2.user_rating != 0
+                SELECT ms.rating_sum::float8 / NULLIF(ms.rating_count, 0)
+                FROM movie_stats ms
+                WHERE ms.movie_id = m.id
             ), 0) as rating,
             COALESCE(um.user_rating, 0) as user_rating
             FROM movies m
@@ -299,7 +320,7 @@ func (u *UserMovieRepository) GetMovieByUserAndListType(ctx context.Context, use
 	}
 
 	err = tx.QueryRowContext(ctx, query, userID.ID(), movieID.ID(), nullListType).Scan(
-		&movieUserInfo.Title, &movieUserInfo.Description, &movieUserInfo.ReleaseDate, &movieUserInfo.Director,
+		&movieUserInfo.ID, &movieUserInfo.Slug, &movieUserInfo.Title, &movieUserInfo.Description, &movieUserInfo.ReleaseDate, &movieUserInfo.Director,
 		pq.Array(&movieUserInfo.Actors), pq.Array(&movieUserInfo.Genres), &movieUserInfo.Rating, &movieUserInfo.UserRating)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
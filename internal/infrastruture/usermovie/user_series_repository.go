@@ -0,0 +1,294 @@
+package usermovie
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/Vlad-Ali/Movies-service-back/internal/application/usecase/transactionmanager"
+	seriesobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/series/object"
+	titleobject "github.com/Vlad-Ali/Movies-service-back/internal/domain/title/object"
+	"github.com/Vlad-Ali/Movies-service-back/internal/domain/user/object"
+	usermoviedomain "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie"
+	error2 "github.com/Vlad-Ali/Movies-service-back/internal/domain/usermovie/error"
+)
+
+const selectSeriesUserInfoQuery = `SELECT um.target_type, um.target_id, s.id, s.slug, s.title, se.number, e.number,
+       COALESCE(e.title, se.title, ''),
+       COALESCE((SELECT AVG(um2.user_rating)::float8 FROM user_movies AS um2
+                 WHERE um2.target_type = um.target_type AND um2.target_id = um.target_id AND um2.user_rating != 0), 0),
+       um.user_rating
+FROM user_movies AS um
+LEFT JOIN series_episodes AS e ON e.id = um.episode_id
+LEFT JOIN series_seasons AS se ON se.id = COALESCE(um.season_id, e.season_id)
+JOIN series AS s ON s.id = COALESCE(um.series_id, se.series_id)
+WHERE um.user_id = $1 AND um.target_type != 'movie' AND um.list_type IS NOT DISTINCT FROM $2`
+
+const seriesEpisodesQuery = `SELECT se2.number AS season, e2.number AS episode
+FROM series_episodes AS e2
+JOIN series_seasons AS se2 ON se2.id = e2.season_id
+WHERE se2.series_id = p.series_id AND se2.number > 0`
+
+func scanSeriesUserInfo(row rowScanner) (*usermoviedomain.SeriesUserInfo, error) {
+	info := &usermoviedomain.SeriesUserInfo{}
+	var season, episode sql.NullInt64
+	err := row.Scan(&info.TargetType, &info.TargetID, &info.SeriesID, &info.SeriesSlug, &info.SeriesTitle, &season, &episode,
+		&info.Title, &info.Rating, &info.UserRating)
+	if err != nil {
+		return nil, err
+	}
+	if season.Valid {
+		number := int(season.Int64)
+		info.Season = &number
+	}
+	if episode.Valid {
+		number := int(episode.Int64)
+		info.Episode = &number
+	}
+	return info, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func nullListType(listType usermoviedomain.ListType) sql.NullString {
+	if listType == usermoviedomain.ListTypeNone {
+		return sql.NullString{Valid: false}
+	}
+	return sql.NullString{String: string(listType), Valid: true}
+}
+
+func (u *UserMovieRepository) GetSeriesByUserAndListType(ctx context.Context, userID object.UserID, listType usermoviedomain.ListType) ([]*usermoviedomain.SeriesUserInfo, error) {
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	var err error
+	if !ok {
+		tx, err = u.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("UserMovieRepository.GetSeriesByUserAndListType Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("UserMovieRepository.GetSeriesByUserAndListType Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectSeriesUserInfoQuery + `
+ORDER BY s.title, se.number NULLS FIRST, e.number NULLS FIRST`
+	rows, err := tx.QueryContext(ctx, query, userID.ID(), nullListType(listType))
+	if err != nil {
+		slog.Error("UserMovieRepository.GetSeriesByUserAndListType Error", "Error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	infos := make([]*usermoviedomain.SeriesUserInfo, 0)
+	for rows.Next() {
+		info, scanErr := scanSeriesUserInfo(rows)
+		if scanErr != nil {
+			err = scanErr
+			slog.Error("UserMovieRepository.GetSeriesByUserAndListType Scan Error", "Error", err)
+			return nil, err
+		}
+		info.ListType = listType
+		infos = append(infos, info)
+	}
+	if err = rows.Err(); err != nil {
+		slog.Error("UserMovieRepository.GetSeriesByUserAndListType Rows Error", "Error", err)
+		return nil, err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("UserMovieRepository.GetSeriesByUserAndListType Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return infos, nil
+}
+
+func (u *UserMovieRepository) GetSeriesTargetByUserAndListType(ctx context.Context, userID object.UserID, target titleobject.Target, listType usermoviedomain.ListType) (*usermoviedomain.SeriesUserInfo, error) {
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	var err error
+	if !ok {
+		tx, err = u.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("UserMovieRepository.GetSeriesTargetByUserAndListType Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("UserMovieRepository.GetSeriesTargetByUserAndListType Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := selectSeriesUserInfoQuery + ` AND um.target_type = $3 AND um.target_id = $4`
+	info, err := scanSeriesUserInfo(tx.QueryRowContext(ctx, query, userID.ID(), nullListType(listType), target.Type(), target.ID()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrUserMovieIsNotFound
+	}
+	if err != nil {
+		slog.Error("UserMovieRepository.GetSeriesTargetByUserAndListType Error", "Error", err)
+		return nil, err
+	}
+	info.ListType = listType
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("UserMovieRepository.GetSeriesTargetByUserAndListType Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return info, nil
+}
+
+func (u *UserMovieRepository) SaveProgress(ctx context.Context, progress *usermoviedomain.WatchProgress) error {
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	var err error
+	if !ok {
+		tx, err = u.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("UserMovieRepository.SaveProgress Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("UserMovieRepository.SaveProgress Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `INSERT INTO series_progress (user_id, series_id, episode_id, updated_at)
+VALUES ($1, $2, $3, now())
+ON CONFLICT (user_id, series_id) DO UPDATE SET episode_id = EXCLUDED.episode_id, updated_at = EXCLUDED.updated_at
+RETURNING updated_at`
+	err = tx.QueryRowContext(ctx, query, progress.UserID.ID(), progress.SeriesID.ID(), progress.EpisodeID.ID()).Scan(&progress.UpdatedAt)
+	if err != nil {
+		slog.Error("UserMovieRepository.SaveProgress Error", "Error", err)
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("UserMovieRepository.SaveProgress Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
+
+func (u *UserMovieRepository) GetProgress(ctx context.Context, userID object.UserID, seriesID seriesobject.SeriesID) (*usermoviedomain.WatchProgress, error) {
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	var err error
+	if !ok {
+		tx, err = u.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("UserMovieRepository.GetProgress Error", "Error", err)
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("UserMovieRepository.GetProgress Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `SELECT p.episode_id, se.number, e.number, e.title, p.updated_at,
+       (SELECT COUNT(*) FROM (` + seriesEpisodesQuery + `) AS w WHERE (w.season, w.episode) <= (se.number, e.number)),
+       (SELECT COUNT(*) FROM (` + seriesEpisodesQuery + `) AS t),
+       nxt.season, nxt.episode
+FROM series_progress AS p
+JOIN series_episodes AS e ON e.id = p.episode_id
+JOIN series_seasons AS se ON se.id = e.season_id
+LEFT JOIN LATERAL (SELECT n.season, n.episode FROM (` + seriesEpisodesQuery + `) AS n
+                   WHERE (n.season, n.episode) > (se.number, e.number)
+                   ORDER BY n.season, n.episode
+                   LIMIT 1) AS nxt ON TRUE
+WHERE p.user_id = $1 AND p.series_id = $2`
+	var episodeID string
+	var nextSeason, nextEpisode sql.NullInt64
+	progress := &usermoviedomain.WatchProgress{UserID: userID, SeriesID: seriesID}
+	err = tx.QueryRowContext(ctx, query, userID.ID(), seriesID.ID()).Scan(&episodeID, &progress.Code.Season, &progress.Code.Episode,
+		&progress.EpisodeTitle, &progress.UpdatedAt, &progress.WatchedEpisodes, &progress.TotalEpisodes, &nextSeason, &nextEpisode)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, error2.ErrWatchProgressIsNotFound
+	}
+	if err != nil {
+		slog.Error("UserMovieRepository.GetProgress Error", "Error", err)
+		return nil, err
+	}
+	progress.EpisodeID, _ = seriesobject.NewEpisodeID(episodeID)
+	if nextSeason.Valid && nextEpisode.Valid {
+		progress.Next = &seriesobject.EpisodeCode{Season: int(nextSeason.Int64), Episode: int(nextEpisode.Int64)}
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("UserMovieRepository.GetProgress Error", "Error", commitErr)
+			return nil, commitErr
+		}
+	}
+	return progress, nil
+}
+
+func (u *UserMovieRepository) DeleteProgress(ctx context.Context, userID object.UserID, seriesID seriesobject.SeriesID) error {
+	tx, ok := transactionmanager.GetTxFromCtx(ctx)
+	var err error
+	if !ok {
+		tx, err = u.db.BeginTx(ctx, nil)
+		if err != nil {
+			slog.Error("UserMovieRepository.DeleteProgress Error", "Error", err)
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if rollbackErr := tx.Rollback(); rollbackErr != nil {
+					slog.Error("UserMovieRepository.DeleteProgress Rollback Error", "Error", rollbackErr)
+				}
+			}
+		}()
+	}
+
+	query := `DELETE FROM series_progress WHERE user_id = $1 AND series_id = $2`
+	result, execErr := tx.ExecContext(ctx, query, userID.ID(), seriesID.ID())
+	if execErr != nil {
+		err = execErr
+		slog.Error("UserMovieRepository.DeleteProgress Error", "Error", err)
+		return err
+	}
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		err = rowsErr
+		slog.Error("UserMovieRepository.DeleteProgress Error", "Error", err)
+		return err
+	}
+	if rowsAffected == 0 {
+		err = error2.ErrWatchProgressIsNotFound
+		return err
+	}
+
+	if !ok {
+		if commitErr := tx.Commit(); commitErr != nil {
+			_ = tx.Rollback()
+			slog.Error("UserMovieRepository.DeleteProgress Error", "Error", commitErr)
+			return commitErr
+		}
+	}
+	return nil
+}
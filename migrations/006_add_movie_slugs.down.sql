@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_movies_slug;
+
+ALTER TABLE movies DROP COLUMN IF EXISTS slug;
@@ -0,0 +1,22 @@
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS slug VARCHAR(300);
+
+WITH bases AS (
+    SELECT id, release_date,
+           trim(both '-' from regexp_replace(lower(title), '[^a-z0-9]+', '-', 'g')
+               || COALESCE('-' || EXTRACT(YEAR FROM release_date)::int, '')) AS base
+    FROM movies
+),
+numbered AS (
+    SELECT id,
+           CASE WHEN base = '' THEN 'movie' ELSE base END AS base,
+           ROW_NUMBER() OVER (PARTITION BY base ORDER BY release_date, id) AS n
+    FROM bases
+)
+UPDATE movies AS m
+SET slug = CASE WHEN numbered.n = 1 THEN numbered.base ELSE numbered.base || '-' || numbered.n END
+FROM numbered
+WHERE m.id = numbered.id AND m.slug IS NULL;
+
+ALTER TABLE movies ALTER COLUMN slug SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_movies_slug ON movies(slug);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_user_movies_movie_rating;
+DROP INDEX IF EXISTS idx_movies_director;
+DROP INDEX IF EXISTS idx_movies_actors;
+DROP INDEX IF EXISTS idx_movies_genres;
+
+ALTER TABLE movies ALTER COLUMN genres TYPE VARCHAR(200) USING genres::TEXT;
+ALTER TABLE movies ALTER COLUMN actors TYPE TEXT USING actors::TEXT;
@@ -0,0 +1,7 @@
+ALTER TABLE movies ALTER COLUMN actors TYPE TEXT[] USING COALESCE(actors, '{}')::TEXT[];
+ALTER TABLE movies ALTER COLUMN genres TYPE TEXT[] USING COALESCE(genres, '{}')::TEXT[];
+
+CREATE INDEX IF NOT EXISTS idx_movies_genres ON movies USING GIN (genres);
+CREATE INDEX IF NOT EXISTS idx_movies_actors ON movies USING GIN (actors);
+CREATE INDEX IF NOT EXISTS idx_movies_director ON movies (lower(director));
+CREATE INDEX IF NOT EXISTS idx_user_movies_movie_rating ON user_movies (movie_id, user_rating);
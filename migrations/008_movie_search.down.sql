@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_movies_title_trgm;
+DROP INDEX IF EXISTS idx_movies_search_vector;
+DROP TRIGGER IF EXISTS movies_search_vector_update ON movies;
+DROP FUNCTION IF EXISTS movies_search_vector_refresh();
+ALTER TABLE movies DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,25 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+CREATE OR REPLACE FUNCTION movies_search_vector_refresh() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('english', COALESCE(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(NEW.director, '')), 'B') ||
+        setweight(to_tsvector('english', array_to_string(COALESCE(NEW.actors, '{}'), ' ')), 'B') ||
+        setweight(to_tsvector('english', array_to_string(COALESCE(NEW.genres, '{}'), ' ')), 'C') ||
+        setweight(to_tsvector('english', COALESCE(NEW.description, '')), 'D');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS movies_search_vector_update ON movies;
+CREATE TRIGGER movies_search_vector_update
+    BEFORE INSERT OR UPDATE OF title, description, director, actors, genres ON movies
+    FOR EACH ROW EXECUTE FUNCTION movies_search_vector_refresh();
+
+UPDATE movies SET title = title;
+
+CREATE INDEX IF NOT EXISTS idx_movies_search_vector ON movies USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_movies_title_trgm ON movies USING GIN (lower(title) gin_trgm_ops);
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS people_refresh_names ON people;
+DROP TRIGGER IF EXISTS movie_credits_refresh_names ON movie_credits;
+DROP FUNCTION IF EXISTS people_name_changed();
+DROP FUNCTION IF EXISTS movie_credits_changed();
+DROP FUNCTION IF EXISTS movies_refresh_credit_names(UUID);
+
+ALTER TABLE movies ALTER COLUMN director TYPE VARCHAR(100) USING left(director, 100);
+
+DROP TABLE IF EXISTS movie_credits;
+DROP TABLE IF EXISTS people;
@@ -0,0 +1,82 @@
+CREATE TABLE IF NOT EXISTS people (
+                        id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                        name VARCHAR(255) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_people_name ON people (lower(name));
+
+CREATE TABLE IF NOT EXISTS movie_credits (
+                               id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                               movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+                               person_id UUID NOT NULL REFERENCES people(id) ON DELETE CASCADE,
+                               role VARCHAR(20) NOT NULL CHECK (role IN ('director', 'actor', 'writer', 'composer')),
+                               character_name VARCHAR(255) NOT NULL DEFAULT '',
+                               position INTEGER NOT NULL DEFAULT 0,
+                               UNIQUE (movie_id, person_id, role, character_name)
+);
+CREATE INDEX IF NOT EXISTS idx_movie_credits_person_id ON movie_credits (person_id);
+CREATE INDEX IF NOT EXISTS idx_movie_credits_movie_role ON movie_credits (movie_id, role, position);
+
+INSERT INTO people (name)
+SELECT DISTINCT name FROM (
+    SELECT trim(director) AS name FROM movies WHERE trim(COALESCE(director, '')) != ''
+    UNION
+    SELECT trim(actor) FROM movies, unnest(actors) AS actor WHERE trim(actor) != ''
+) AS names;
+
+INSERT INTO movie_credits (movie_id, person_id, role, position)
+SELECT m.id, p.id, 'director', 0
+FROM movies AS m
+JOIN people AS p ON p.name = trim(m.director)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO movie_credits (movie_id, person_id, role, position)
+SELECT m.id, p.id, 'actor', a.ord - 1
+FROM movies AS m
+CROSS JOIN LATERAL unnest(m.actors) WITH ORDINALITY AS a(name, ord)
+JOIN people AS p ON p.name = trim(a.name)
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE movies ALTER COLUMN director TYPE TEXT;
+
+CREATE OR REPLACE FUNCTION movies_refresh_credit_names(p_movie_id UUID) RETURNS void AS $$
+BEGIN
+    UPDATE movies SET
+        director = (SELECT string_agg(p.name, ', ' ORDER BY mc.position, p.name)
+                    FROM movie_credits AS mc JOIN people AS p ON p.id = mc.person_id
+                    WHERE mc.movie_id = p_movie_id AND mc.role = 'director'),
+        actors = ARRAY(SELECT p.name
+                       FROM movie_credits AS mc JOIN people AS p ON p.id = mc.person_id
+                       WHERE mc.movie_id = p_movie_id AND mc.role = 'actor'
+                       ORDER BY mc.position, p.name)
+    WHERE id = p_movie_id;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION movie_credits_changed() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        PERFORM movies_refresh_credit_names(OLD.movie_id);
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        PERFORM movies_refresh_credit_names(NEW.movie_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER movie_credits_refresh_names
+    AFTER INSERT OR UPDATE OR DELETE ON movie_credits
+    FOR EACH ROW EXECUTE FUNCTION movie_credits_changed();
+
+CREATE OR REPLACE FUNCTION people_name_changed() RETURNS trigger AS $$
+BEGIN
+    PERFORM movies_refresh_credit_names(mc.movie_id)
+    FROM (SELECT DISTINCT movie_id FROM movie_credits WHERE person_id = NEW.id) AS mc;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER people_refresh_names
+    AFTER UPDATE OF name ON people
+    FOR EACH ROW WHEN (OLD.name IS DISTINCT FROM NEW.name)
+    EXECUTE FUNCTION people_name_changed();
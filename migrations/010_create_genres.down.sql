@@ -0,0 +1,34 @@
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS genres TEXT[] NOT NULL DEFAULT '{}';
+
+UPDATE movies AS m SET genres = ARRAY(SELECT g.name
+                                      FROM movie_genres AS mg JOIN genres AS g ON g.id = mg.genre_id
+                                      WHERE mg.movie_id = m.id
+                                      ORDER BY g.name);
+CREATE INDEX IF NOT EXISTS idx_movies_genres ON movies USING GIN (genres);
+
+DROP TRIGGER IF EXISTS genres_refresh_search ON genres;
+DROP TRIGGER IF EXISTS movie_genres_refresh_search ON movie_genres;
+DROP FUNCTION IF EXISTS genres_name_changed();
+DROP FUNCTION IF EXISTS movie_genres_changed();
+
+CREATE OR REPLACE FUNCTION movies_search_vector_refresh() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('english', COALESCE(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(NEW.director, '')), 'B') ||
+        setweight(to_tsvector('english', array_to_string(COALESCE(NEW.actors, '{}'), ' ')), 'B') ||
+        setweight(to_tsvector('english', array_to_string(COALESCE(NEW.genres, '{}'), ' ')), 'C') ||
+        setweight(to_tsvector('english', COALESCE(NEW.description, '')), 'D');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS movies_search_vector_update ON movies;
+CREATE TRIGGER movies_search_vector_update
+    BEFORE INSERT OR UPDATE OF title, description, director, actors, genres ON movies
+    FOR EACH ROW EXECUTE FUNCTION movies_search_vector_refresh();
+
+UPDATE movies SET title = title;
+
+DROP TABLE IF EXISTS movie_genres;
+DROP TABLE IF EXISTS genres;
@@ -0,0 +1,85 @@
+CREATE TABLE IF NOT EXISTS genres (
+                        id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                        slug VARCHAR(100) NOT NULL,
+                        name VARCHAR(100) NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_genres_slug ON genres (slug);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_genres_name ON genres (lower(name));
+
+CREATE TABLE IF NOT EXISTS movie_genres (
+                              movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+                              genre_id UUID NOT NULL REFERENCES genres(id) ON DELETE CASCADE,
+                              PRIMARY KEY (movie_id, genre_id)
+);
+CREATE INDEX IF NOT EXISTS idx_movie_genres_genre_id ON movie_genres (genre_id);
+
+CREATE OR REPLACE FUNCTION genre_slug(p_name TEXT) RETURNS TEXT AS $$
+    SELECT COALESCE(NULLIF(trim(BOTH '-' FROM regexp_replace(lower(trim(p_name)), '[^a-z0-9]+', '-', 'g')), ''),
+                    'genre-' || substr(md5(lower(trim(p_name))), 1, 8));
+$$ LANGUAGE sql IMMUTABLE;
+
+INSERT INTO genres (slug, name)
+SELECT DISTINCT ON (genre_slug(g)) genre_slug(g), trim(g)
+FROM movies, unnest(genres) AS g
+WHERE trim(g) != ''
+ORDER BY genre_slug(g), trim(g)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO movie_genres (movie_id, genre_id)
+SELECT DISTINCT m.id, gr.id
+FROM movies AS m
+CROSS JOIN LATERAL unnest(m.genres) AS g
+JOIN genres AS gr ON gr.slug = genre_slug(g)
+WHERE trim(g) != '';
+
+DROP FUNCTION genre_slug(TEXT);
+
+CREATE OR REPLACE FUNCTION movies_search_vector_refresh() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('english', COALESCE(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(NEW.director, '')), 'B') ||
+        setweight(to_tsvector('english', array_to_string(COALESCE(NEW.actors, '{}'), ' ')), 'B') ||
+        setweight(to_tsvector('english', COALESCE((SELECT string_agg(g.name, ' ')
+                                                   FROM movie_genres AS mg JOIN genres AS g ON g.id = mg.genre_id
+                                                   WHERE mg.movie_id = NEW.id), '')), 'C') ||
+        setweight(to_tsvector('english', COALESCE(NEW.description, '')), 'D');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS movies_search_vector_update ON movies;
+CREATE TRIGGER movies_search_vector_update
+    BEFORE INSERT OR UPDATE OF title, description, director, actors ON movies
+    FOR EACH ROW EXECUTE FUNCTION movies_search_vector_refresh();
+
+CREATE OR REPLACE FUNCTION movie_genres_changed() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE movies SET title = title WHERE id = OLD.movie_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        UPDATE movies SET title = title WHERE id = NEW.movie_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER movie_genres_refresh_search
+    AFTER INSERT OR UPDATE OR DELETE ON movie_genres
+    FOR EACH ROW EXECUTE FUNCTION movie_genres_changed();
+
+CREATE OR REPLACE FUNCTION genres_name_changed() RETURNS trigger AS $$
+BEGIN
+    UPDATE movies SET title = title
+    WHERE id IN (SELECT movie_id FROM movie_genres WHERE genre_id = NEW.id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER genres_refresh_search
+    AFTER UPDATE OF name ON genres
+    FOR EACH ROW WHEN (OLD.name IS DISTINCT FROM NEW.name)
+    EXECUTE FUNCTION genres_name_changed();
+
+ALTER TABLE movies DROP COLUMN genres;
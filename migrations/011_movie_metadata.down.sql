@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_movies_external_ids;
+DROP INDEX IF EXISTS idx_movies_enriched_at;
+
+ALTER TABLE movies DROP COLUMN IF EXISTS enriched_at;
+ALTER TABLE movies DROP COLUMN IF EXISTS field_sources;
+ALTER TABLE movies DROP COLUMN IF EXISTS external_ids;
+ALTER TABLE movies DROP COLUMN IF EXISTS poster_url;
+ALTER TABLE movies DROP COLUMN IF EXISTS languages;
+ALTER TABLE movies DROP COLUMN IF EXISTS countries;
+ALTER TABLE movies DROP COLUMN IF EXISTS runtime_minutes;
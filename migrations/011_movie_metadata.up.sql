@@ -0,0 +1,10 @@
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS runtime_minutes INTEGER NOT NULL DEFAULT 0 CHECK (runtime_minutes >= 0);
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS countries TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS languages TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS poster_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS external_ids JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS field_sources JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS enriched_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_movies_enriched_at ON movies (enriched_at NULLS FIRST);
+CREATE INDEX IF NOT EXISTS idx_movies_external_ids ON movies USING GIN (external_ids);
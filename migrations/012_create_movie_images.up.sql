@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS movie_images (
+                              id UUID PRIMARY KEY,
+                              movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+                              kind VARCHAR(16) NOT NULL CHECK (kind IN ('poster', 'backdrop')),
+                              content_type VARCHAR(64) NOT NULL,
+                              width INTEGER NOT NULL CHECK (width > 0),
+                              height INTEGER NOT NULL CHECK (height > 0),
+                              size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+                              created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                              UNIQUE (movie_id, kind)
+);
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_movies_tagline_trgm;
+DROP INDEX IF EXISTS idx_movies_box_office;
+DROP INDEX IF EXISTS idx_movies_budget;
+DROP INDEX IF EXISTS idx_movies_certifications;
+DROP INDEX IF EXISTS idx_movies_original_language;
+DROP INDEX IF EXISTS idx_movies_languages;
+DROP INDEX IF EXISTS idx_movies_countries;
+DROP INDEX IF EXISTS idx_movies_runtime;
+
+ALTER TABLE movies DROP COLUMN IF EXISTS tagline;
+ALTER TABLE movies DROP COLUMN IF EXISTS box_office;
+ALTER TABLE movies DROP COLUMN IF EXISTS budget;
+ALTER TABLE movies DROP COLUMN IF EXISTS certifications;
+ALTER TABLE movies DROP COLUMN IF EXISTS original_language;
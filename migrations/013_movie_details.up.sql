@@ -0,0 +1,18 @@
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS original_language TEXT NOT NULL DEFAULT '';
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS certifications JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS budget BIGINT NOT NULL DEFAULT 0 CHECK (budget >= 0);
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS box_office BIGINT NOT NULL DEFAULT 0 CHECK (box_office >= 0);
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS tagline TEXT NOT NULL DEFAULT '';
+
+UPDATE movies
+SET countries = ARRAY(SELECT upper(btrim(c)) FROM unnest(countries) AS c),
+    languages = ARRAY(SELECT lower(btrim(l)) FROM unnest(languages) AS l);
+
+CREATE INDEX IF NOT EXISTS idx_movies_runtime ON movies (runtime_minutes);
+CREATE INDEX IF NOT EXISTS idx_movies_countries ON movies USING GIN (countries);
+CREATE INDEX IF NOT EXISTS idx_movies_languages ON movies USING GIN (languages);
+CREATE INDEX IF NOT EXISTS idx_movies_original_language ON movies (original_language);
+CREATE INDEX IF NOT EXISTS idx_movies_certifications ON movies USING GIN (certifications);
+CREATE INDEX IF NOT EXISTS idx_movies_budget ON movies (budget);
+CREATE INDEX IF NOT EXISTS idx_movies_box_office ON movies (box_office);
+CREATE INDEX IF NOT EXISTS idx_movies_tagline_trgm ON movies USING GIN (lower(tagline) gin_trgm_ops);
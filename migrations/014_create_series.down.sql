@@ -0,0 +1,27 @@
+DROP TABLE IF EXISTS series_progress;
+
+DELETE FROM reviews WHERE movie_id IS NULL;
+DROP INDEX IF EXISTS idx_reviews_target;
+DROP INDEX IF EXISTS idx_reviews_user_target;
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_target_check;
+ALTER TABLE reviews DROP COLUMN IF EXISTS target_id;
+ALTER TABLE reviews DROP COLUMN IF EXISTS target_type;
+ALTER TABLE reviews DROP COLUMN IF EXISTS episode_id;
+ALTER TABLE reviews DROP COLUMN IF EXISTS season_id;
+ALTER TABLE reviews DROP COLUMN IF EXISTS series_id;
+ALTER TABLE reviews ALTER COLUMN movie_id SET NOT NULL;
+
+DELETE FROM user_movies WHERE movie_id IS NULL;
+DROP INDEX IF EXISTS idx_user_movies_target;
+DROP INDEX IF EXISTS idx_user_movies_user_target;
+ALTER TABLE user_movies DROP CONSTRAINT IF EXISTS user_movies_target_check;
+ALTER TABLE user_movies DROP COLUMN IF EXISTS target_id;
+ALTER TABLE user_movies DROP COLUMN IF EXISTS target_type;
+ALTER TABLE user_movies DROP COLUMN IF EXISTS episode_id;
+ALTER TABLE user_movies DROP COLUMN IF EXISTS season_id;
+ALTER TABLE user_movies DROP COLUMN IF EXISTS series_id;
+ALTER TABLE user_movies ALTER COLUMN movie_id SET NOT NULL;
+
+DROP TABLE IF EXISTS series_episodes;
+DROP TABLE IF EXISTS series_seasons;
+DROP TABLE IF EXISTS series;
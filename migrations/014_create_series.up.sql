@@ -0,0 +1,77 @@
+CREATE TABLE IF NOT EXISTS series (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slug VARCHAR(300) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    start_year INTEGER NOT NULL,
+    end_year INTEGER NOT NULL DEFAULT 0,
+    UNIQUE (title, start_year)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_series_slug ON series (slug);
+
+CREATE TABLE IF NOT EXISTS series_seasons (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+    number INTEGER NOT NULL CHECK (number >= 0),
+    title VARCHAR(255) NOT NULL DEFAULT '',
+    air_date DATE,
+    UNIQUE (series_id, number)
+);
+
+CREATE TABLE IF NOT EXISTS series_episodes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    season_id UUID NOT NULL REFERENCES series_seasons(id) ON DELETE CASCADE,
+    number INTEGER NOT NULL CHECK (number > 0),
+    title VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    air_date DATE,
+    runtime_minutes INTEGER NOT NULL DEFAULT 0 CHECK (runtime_minutes >= 0),
+    UNIQUE (season_id, number)
+);
+
+ALTER TABLE user_movies ALTER COLUMN movie_id DROP NOT NULL;
+ALTER TABLE user_movies ADD COLUMN IF NOT EXISTS series_id UUID REFERENCES series(id) ON DELETE CASCADE;
+ALTER TABLE user_movies ADD COLUMN IF NOT EXISTS season_id UUID REFERENCES series_seasons(id) ON DELETE CASCADE;
+ALTER TABLE user_movies ADD COLUMN IF NOT EXISTS episode_id UUID REFERENCES series_episodes(id) ON DELETE CASCADE;
+ALTER TABLE user_movies ADD COLUMN IF NOT EXISTS target_type VARCHAR(10) NOT NULL DEFAULT 'movie';
+ALTER TABLE user_movies ADD COLUMN IF NOT EXISTS target_id UUID
+    GENERATED ALWAYS AS (COALESCE(movie_id, series_id, season_id, episode_id)) STORED;
+ALTER TABLE user_movies ADD CONSTRAINT user_movies_target_check CHECK (
+    num_nonnulls(movie_id, series_id, season_id, episode_id) = 1 AND
+    CASE target_type
+        WHEN 'movie' THEN movie_id IS NOT NULL
+        WHEN 'series' THEN series_id IS NOT NULL
+        WHEN 'season' THEN season_id IS NOT NULL
+        WHEN 'episode' THEN episode_id IS NOT NULL
+        ELSE FALSE
+    END);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_movies_user_target ON user_movies (user_id, target_type, target_id);
+CREATE INDEX IF NOT EXISTS idx_user_movies_target ON user_movies (target_type, target_id);
+
+ALTER TABLE reviews ALTER COLUMN movie_id DROP NOT NULL;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS series_id UUID REFERENCES series(id) ON DELETE CASCADE;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS season_id UUID REFERENCES series_seasons(id) ON DELETE CASCADE;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS episode_id UUID REFERENCES series_episodes(id) ON DELETE CASCADE;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS target_type VARCHAR(10) NOT NULL DEFAULT 'movie';
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS target_id UUID
+    GENERATED ALWAYS AS (COALESCE(movie_id, series_id, season_id, episode_id)) STORED;
+ALTER TABLE reviews ADD CONSTRAINT reviews_target_check CHECK (
+    num_nonnulls(movie_id, series_id, season_id, episode_id) = 1 AND
+    CASE target_type
+        WHEN 'movie' THEN movie_id IS NOT NULL
+        WHEN 'series' THEN series_id IS NOT NULL
+        WHEN 'season' THEN season_id IS NOT NULL
+        WHEN 'episode' THEN episode_id IS NOT NULL
+        ELSE FALSE
+    END);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reviews_user_target ON reviews (user_id, target_type, target_id);
+CREATE INDEX IF NOT EXISTS idx_reviews_target ON reviews (target_type, target_id);
+
+CREATE TABLE IF NOT EXISTS series_progress (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
+    episode_id UUID NOT NULL REFERENCES series_episodes(id) ON DELETE CASCADE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, series_id)
+);
@@ -0,0 +1,61 @@
+CREATE TABLE IF NOT EXISTS collections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slug VARCHAR(300) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_collections_slug ON collections (slug);
+
+CREATE TABLE IF NOT EXISTS collection_movies (
+    collection_id UUID NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL CHECK (position > 0),
+    PRIMARY KEY (collection_id, movie_id),
+    UNIQUE (collection_id, position)
+);
+
+CREATE INDEX IF NOT EXISTS idx_collection_movies_movie ON collection_movies (movie_id);
+
+CREATE TABLE IF NOT EXISTS movie_relations (
+    movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+    related_movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+    relation_type VARCHAR(20) NOT NULL,
+    PRIMARY KEY (movie_id, related_movie_id),
+    CHECK (movie_id <> related_movie_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_movie_relations_related ON movie_relations (related_movie_id);
+
+INSERT INTO collections (slug, name, description)
+VALUES ('star-wars-skywalker-saga', 'Star Wars Skywalker Saga', 'The story of the Skywalker family in episode order.')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO collection_movies (collection_id, movie_id, position)
+SELECT c.id, m.id, e.position
+FROM collections AS c
+CROSS JOIN (VALUES
+    ('Star Wars: Episode I - The Phantom Menace', 1),
+    ('Star Wars: Episode II - Attack of the Clones', 2),
+    ('Star Wars: Episode III - Revenge of the Sith', 3),
+    ('Star Wars: Episode IV - A New Hope', 4),
+    ('Star Wars: Episode V - The Empire Strikes Back', 5),
+    ('Star Wars: Episode VI - Return of the Jedi', 6)
+) AS e(title, position)
+JOIN movies AS m ON m.title = e.title
+WHERE c.slug = 'star-wars-skywalker-saga'
+ON CONFLICT DO NOTHING;
+
+INSERT INTO movie_relations (movie_id, related_movie_id, relation_type)
+SELECT a.movie_id, b.movie_id, 'sequel'
+FROM collection_movies AS a
+JOIN collection_movies AS b ON b.collection_id = a.collection_id AND b.position = a.position + 1
+JOIN collections AS c ON c.id = a.collection_id
+WHERE c.slug = 'star-wars-skywalker-saga'
+UNION ALL
+SELECT b.movie_id, a.movie_id, 'prequel'
+FROM collection_movies AS a
+JOIN collection_movies AS b ON b.collection_id = a.collection_id AND b.position = a.position + 1
+JOIN collections AS c ON c.id = a.collection_id
+WHERE c.slug = 'star-wars-skywalker-saga'
+ON CONFLICT DO NOTHING;
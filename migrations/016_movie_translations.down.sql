@@ -0,0 +1,23 @@
+DROP TRIGGER IF EXISTS movie_alternate_titles_refresh_search ON movie_alternate_titles;
+DROP TRIGGER IF EXISTS movie_translations_refresh_search ON movie_translations;
+DROP FUNCTION IF EXISTS movie_titles_changed();
+
+CREATE OR REPLACE FUNCTION movies_search_vector_refresh() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('english', COALESCE(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(NEW.director, '')), 'B') ||
+        setweight(to_tsvector('english', array_to_string(COALESCE(NEW.actors, '{}'), ' ')), 'B') ||
+        setweight(to_tsvector('english', COALESCE((SELECT string_agg(g.name, ' ')
+                                                   FROM movie_genres AS mg JOIN genres AS g ON g.id = mg.genre_id
+                                                   WHERE mg.movie_id = NEW.id), '')), 'C') ||
+        setweight(to_tsvector('english', COALESCE(NEW.description, '')), 'D');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP VIEW IF EXISTS movie_search_titles;
+DROP TABLE IF EXISTS movie_alternate_titles;
+DROP TABLE IF EXISTS movie_translations;
+
+UPDATE movies SET title = title;
@@ -0,0 +1,82 @@
+CREATE TABLE IF NOT EXISTS movie_translations (
+    movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+    language VARCHAR(35) NOT NULL,
+    title VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    tagline VARCHAR(500) NOT NULL DEFAULT '',
+    PRIMARY KEY (movie_id, language)
+);
+
+CREATE TABLE IF NOT EXISTS movie_alternate_titles (
+    movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    language VARCHAR(35) NOT NULL DEFAULT '',
+    PRIMARY KEY (movie_id, title)
+);
+
+CREATE INDEX IF NOT EXISTS idx_movie_translations_title_trgm ON movie_translations USING GIN (lower(title) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_movie_alternate_titles_title_trgm ON movie_alternate_titles USING GIN (lower(title) gin_trgm_ops);
+
+CREATE OR REPLACE VIEW movie_search_titles AS
+SELECT movie_id, title FROM movie_alternate_titles
+UNION ALL
+SELECT movie_id, title FROM movie_translations WHERE title != '';
+
+CREATE OR REPLACE FUNCTION movies_search_vector_refresh() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('english', COALESCE(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('simple', COALESCE((SELECT string_agg(st.title, ' ')
+                                                  FROM movie_search_titles AS st
+                                                  WHERE st.movie_id = NEW.id), '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(NEW.director, '')), 'B') ||
+        setweight(to_tsvector('english', array_to_string(COALESCE(NEW.actors, '{}'), ' ')), 'B') ||
+        setweight(to_tsvector('english', COALESCE((SELECT string_agg(g.name, ' ')
+                                                   FROM movie_genres AS mg JOIN genres AS g ON g.id = mg.genre_id
+                                                   WHERE mg.movie_id = NEW.id), '')), 'C') ||
+        setweight(to_tsvector('english', COALESCE(NEW.description, '')), 'D') ||
+        setweight(to_tsvector('simple', COALESCE((SELECT string_agg(concat_ws(' ', mt.description, mt.tagline), ' ')
+                                                  FROM movie_translations AS mt
+                                                  WHERE mt.movie_id = NEW.id), '')), 'D');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION movie_titles_changed() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE movies SET title = title WHERE id = OLD.movie_id;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        UPDATE movies SET title = title WHERE id = NEW.movie_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER movie_translations_refresh_search
+    AFTER INSERT OR UPDATE OR DELETE ON movie_translations
+    FOR EACH ROW EXECUTE FUNCTION movie_titles_changed();
+
+CREATE TRIGGER movie_alternate_titles_refresh_search
+    AFTER INSERT OR UPDATE OR DELETE ON movie_alternate_titles
+    FOR EACH ROW EXECUTE FUNCTION movie_titles_changed();
+
+INSERT INTO movie_translations (movie_id, language, title)
+SELECT m.id, 'ru', t.localized
+FROM (VALUES
+    ('Star Wars: Episode I - The Phantom Menace', 'Звёздные войны: Эпизод 1 — Скрытая угроза'),
+    ('Star Wars: Episode II - Attack of the Clones', 'Звёздные войны: Эпизод 2 — Атака клонов'),
+    ('Star Wars: Episode III - Revenge of the Sith', 'Звёздные войны: Эпизод 3 — Месть ситхов'),
+    ('Star Wars: Episode IV - A New Hope', 'Звёздные войны: Эпизод 4 — Новая надежда'),
+    ('Star Wars: Episode V - The Empire Strikes Back', 'Звёздные войны: Эпизод 5 — Империя наносит ответный удар'),
+    ('Star Wars: Episode VI - Return of the Jedi', 'Звёздные войны: Эпизод 6 — Возвращение джедая')
+) AS t(title, localized)
+JOIN movies AS m ON m.title = t.title
+ON CONFLICT DO NOTHING;
+
+INSERT INTO movie_alternate_titles (movie_id, title, language)
+SELECT m.id, 'Star Wars', 'en'
+FROM movies AS m
+WHERE m.title = 'Star Wars: Episode IV - A New Hope'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS review_stats;
+DROP TABLE IF EXISTS movie_stats;
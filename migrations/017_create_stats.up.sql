@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS movie_stats (
+                        movie_id UUID PRIMARY KEY REFERENCES movies(id) ON DELETE CASCADE,
+                        rating_sum BIGINT NOT NULL DEFAULT 0,
+                        rating_count INTEGER NOT NULL DEFAULT 0,
+                        review_count INTEGER NOT NULL DEFAULT 0,
+                        favorite_count INTEGER NOT NULL DEFAULT 0,
+                        watchlist_count INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS review_stats (
+                        review_id UUID PRIMARY KEY REFERENCES reviews(id) ON DELETE CASCADE,
+                        like_count INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO movie_stats (movie_id, rating_sum, rating_count, review_count, favorite_count, watchlist_count)
+SELECT m.id,
+       COALESCE(um.rating_sum, 0), COALESCE(um.rating_count, 0), COALESCE(rv.review_count, 0),
+       COALESCE(um.favorite_count, 0), COALESCE(um.watchlist_count, 0)
+FROM movies AS m
+LEFT JOIN (SELECT movie_id,
+                  SUM(user_rating) FILTER (WHERE user_rating != 0) AS rating_sum,
+                  COUNT(*) FILTER (WHERE user_rating != 0) AS rating_count,
+                  COUNT(*) FILTER (WHERE list_type = 'favorite') AS favorite_count,
+                  COUNT(*) FILTER (WHERE list_type = 'watchlist') AS watchlist_count
+           FROM user_movies
+           WHERE movie_id IS NOT NULL
+           GROUP BY movie_id) AS um ON um.movie_id = m.id
+LEFT JOIN (SELECT movie_id, COUNT(*) AS review_count
+           FROM reviews
+           WHERE movie_id IS NOT NULL
+           GROUP BY movie_id) AS rv ON rv.movie_id = m.id
+ON CONFLICT (movie_id) DO NOTHING;
+
+INSERT INTO review_stats (review_id, like_count)
+SELECT review_id, COUNT(*) FROM review_likes GROUP BY review_id
+ON CONFLICT (review_id) DO NOTHING;
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS movie_trending;
+
+DROP INDEX IF EXISTS idx_reviews_writing_date;
+DROP INDEX IF EXISTS idx_review_likes_created_at;
+DROP INDEX IF EXISTS idx_user_movies_listed_at;
+DROP INDEX IF EXISTS idx_user_movies_rated_at;
+
+ALTER TABLE review_likes DROP COLUMN IF EXISTS created_at;
+ALTER TABLE user_movies DROP COLUMN IF EXISTS listed_at;
+ALTER TABLE user_movies DROP COLUMN IF EXISTS rated_at;
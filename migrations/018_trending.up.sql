@@ -0,0 +1,18 @@
+ALTER TABLE user_movies ADD COLUMN IF NOT EXISTS rated_at TIMESTAMPTZ;
+ALTER TABLE user_movies ADD COLUMN IF NOT EXISTS listed_at TIMESTAMPTZ;
+ALTER TABLE review_likes ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS idx_user_movies_rated_at ON user_movies (rated_at) WHERE rated_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_user_movies_listed_at ON user_movies (listed_at) WHERE listed_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_review_likes_created_at ON review_likes (created_at);
+CREATE INDEX IF NOT EXISTS idx_reviews_writing_date ON reviews (writing_date);
+
+CREATE TABLE IF NOT EXISTS movie_trending (
+                        time_window VARCHAR(10) NOT NULL CHECK (time_window IN ('day', 'week', 'month')),
+                        movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+                        rank INTEGER NOT NULL,
+                        score DOUBLE PRECISION NOT NULL,
+                        computed_at TIMESTAMPTZ NOT NULL,
+                        PRIMARY KEY (time_window, movie_id),
+                        UNIQUE (time_window, rank)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_user_movies_user_rating;
+DROP TABLE IF EXISTS movie_similarities;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS movie_similarities (
+                        movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+                        similar_movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+                        score DOUBLE PRECISION NOT NULL,
+                        co_raters INTEGER NOT NULL,
+                        computed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                        PRIMARY KEY (movie_id, similar_movie_id),
+                        CHECK (movie_id != similar_movie_id)
+);
+CREATE INDEX IF NOT EXISTS idx_movie_similarities_similar_movie_id ON movie_similarities (similar_movie_id);
+CREATE INDEX IF NOT EXISTS idx_user_movies_user_rating ON user_movies (user_id, user_rating) WHERE movie_id IS NOT NULL;
@@ -0,0 +1,14 @@
+DROP TRIGGER IF EXISTS reviews_embedding_delete ON reviews;
+DROP TRIGGER IF EXISTS reviews_embedding_update ON reviews;
+DROP TRIGGER IF EXISTS reviews_embedding_insert ON reviews;
+DROP TRIGGER IF EXISTS movie_genres_embedding ON movie_genres;
+DROP TRIGGER IF EXISTS movies_embedding_delete ON movies;
+DROP TRIGGER IF EXISTS movies_embedding_update ON movies;
+DROP TRIGGER IF EXISTS movies_embedding_insert ON movies;
+
+DROP FUNCTION IF EXISTS movie_genres_embedding_enqueue();
+DROP FUNCTION IF EXISTS embedding_remove();
+DROP FUNCTION IF EXISTS embedding_enqueue();
+
+DROP TABLE IF EXISTS embedding_queue;
+DROP TABLE IF EXISTS embeddings;
@@ -0,0 +1,103 @@
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'vector') THEN
+        CREATE EXTENSION IF NOT EXISTS vector;
+    END IF;
+END;
+$$;
+
+CREATE TABLE IF NOT EXISTS embeddings (
+                        entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('movie', 'review')),
+                        entity_id UUID NOT NULL,
+                        model VARCHAR(100) NOT NULL,
+                        vector REAL[] NOT NULL,
+                        norm DOUBLE PRECISION NOT NULL,
+                        updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                        PRIMARY KEY (entity_type, entity_id)
+);
+CREATE INDEX IF NOT EXISTS idx_embeddings_model ON embeddings (model, entity_type);
+
+CREATE TABLE IF NOT EXISTS embedding_queue (
+                        entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('movie', 'review')),
+                        entity_id UUID NOT NULL,
+                        enqueued_at TIMESTAMPTZ NOT NULL DEFAULT clock_timestamp(),
+                        attempts INTEGER NOT NULL DEFAULT 0,
+                        PRIMARY KEY (entity_type, entity_id)
+);
+CREATE INDEX IF NOT EXISTS idx_embedding_queue_enqueued_at ON embedding_queue (attempts, enqueued_at);
+
+CREATE OR REPLACE FUNCTION embedding_enqueue() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO embedding_queue (entity_type, entity_id, enqueued_at, attempts)
+    VALUES (TG_ARGV[0], NEW.id, clock_timestamp(), 0)
+    ON CONFLICT (entity_type, entity_id) DO UPDATE SET enqueued_at = EXCLUDED.enqueued_at, attempts = 0;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION embedding_remove() RETURNS trigger AS $$
+BEGIN
+    DELETE FROM embedding_queue WHERE entity_type = TG_ARGV[0] AND entity_id = OLD.id;
+    DELETE FROM embeddings WHERE entity_type = TG_ARGV[0] AND entity_id = OLD.id;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS movies_embedding_insert ON movies;
+CREATE TRIGGER movies_embedding_insert
+    AFTER INSERT ON movies
+    FOR EACH ROW EXECUTE FUNCTION embedding_enqueue('movie');
+
+DROP TRIGGER IF EXISTS movies_embedding_update ON movies;
+CREATE TRIGGER movies_embedding_update
+    AFTER UPDATE OF title, tagline, description, director ON movies
+    FOR EACH ROW
+    WHEN (OLD.title IS DISTINCT FROM NEW.title OR OLD.tagline IS DISTINCT FROM NEW.tagline
+          OR OLD.description IS DISTINCT FROM NEW.description OR OLD.director IS DISTINCT FROM NEW.director)
+    EXECUTE FUNCTION embedding_enqueue('movie');
+
+DROP TRIGGER IF EXISTS movies_embedding_delete ON movies;
+CREATE TRIGGER movies_embedding_delete
+    AFTER DELETE ON movies
+    FOR EACH ROW EXECUTE FUNCTION embedding_remove('movie');
+
+CREATE OR REPLACE FUNCTION movie_genres_embedding_enqueue() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO embedding_queue (entity_type, entity_id, enqueued_at, attempts)
+    SELECT 'movie', m.id, clock_timestamp(), 0
+    FROM movies AS m
+    WHERE m.id = CASE WHEN TG_OP = 'DELETE' THEN OLD.movie_id ELSE NEW.movie_id END
+    ON CONFLICT (entity_type, entity_id) DO UPDATE SET enqueued_at = EXCLUDED.enqueued_at, attempts = 0;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS movie_genres_embedding ON movie_genres;
+CREATE TRIGGER movie_genres_embedding
+    AFTER INSERT OR DELETE ON movie_genres
+    FOR EACH ROW EXECUTE FUNCTION movie_genres_embedding_enqueue();
+
+DROP TRIGGER IF EXISTS reviews_embedding_insert ON reviews;
+CREATE TRIGGER reviews_embedding_insert
+    AFTER INSERT ON reviews
+    FOR EACH ROW
+    WHEN (NEW.movie_id IS NOT NULL)
+    EXECUTE FUNCTION embedding_enqueue('review');
+
+DROP TRIGGER IF EXISTS reviews_embedding_update ON reviews;
+CREATE TRIGGER reviews_embedding_update
+    AFTER UPDATE OF text ON reviews
+    FOR EACH ROW
+    WHEN (NEW.movie_id IS NOT NULL AND OLD.text IS DISTINCT FROM NEW.text)
+    EXECUTE FUNCTION embedding_enqueue('review');
+
+DROP TRIGGER IF EXISTS reviews_embedding_delete ON reviews;
+CREATE TRIGGER reviews_embedding_delete
+    AFTER DELETE ON reviews
+    FOR EACH ROW EXECUTE FUNCTION embedding_remove('review');
+
+INSERT INTO embedding_queue (entity_type, entity_id)
+SELECT 'movie', id FROM movies
+UNION ALL
+SELECT 'review', id FROM reviews WHERE movie_id IS NOT NULL
+ON CONFLICT (entity_type, entity_id) DO NOTHING;
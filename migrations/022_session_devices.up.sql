@@ -0,0 +1,10 @@
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS started_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS user_agent TEXT NOT NULL DEFAULT '';
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS ip VARCHAR(45) NOT NULL DEFAULT '';
+
+UPDATE sessions AS s SET started_at = f.started_at
+FROM (SELECT family_id, MIN(created_at) AS started_at FROM sessions GROUP BY family_id) AS f
+WHERE s.family_id = f.family_id;
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_active ON sessions (user_id) WHERE rotated_at IS NULL AND revoked_at IS NULL;
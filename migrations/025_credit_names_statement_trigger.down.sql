@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS movie_credits_refresh_names_delete ON movie_credits;
+DROP TRIGGER IF EXISTS movie_credits_refresh_names_update ON movie_credits;
+DROP TRIGGER IF EXISTS movie_credits_refresh_names_insert ON movie_credits;
+DROP FUNCTION IF EXISTS movie_credits_changed_batch();
+
+CREATE TRIGGER movie_credits_refresh_names
+    AFTER INSERT OR UPDATE OR DELETE ON movie_credits
+    FOR EACH ROW EXECUTE FUNCTION movie_credits_changed();
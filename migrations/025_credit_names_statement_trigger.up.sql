@@ -0,0 +1,32 @@
+DROP TRIGGER IF EXISTS movie_credits_refresh_names ON movie_credits;
+
+CREATE OR REPLACE FUNCTION movie_credits_changed_batch() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM movies_refresh_credit_names(c.movie_id)
+        FROM (SELECT DISTINCT movie_id FROM new_credits) AS c;
+    ELSIF TG_OP = 'DELETE' THEN
+        PERFORM movies_refresh_credit_names(c.movie_id)
+        FROM (SELECT DISTINCT movie_id FROM old_credits) AS c;
+    ELSE
+        PERFORM movies_refresh_credit_names(c.movie_id)
+        FROM (SELECT movie_id FROM old_credits UNION SELECT movie_id FROM new_credits) AS c;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER movie_credits_refresh_names_insert
+    AFTER INSERT ON movie_credits
+    REFERENCING NEW TABLE AS new_credits
+    FOR EACH STATEMENT EXECUTE FUNCTION movie_credits_changed_batch();
+
+CREATE TRIGGER movie_credits_refresh_names_update
+    AFTER UPDATE ON movie_credits
+    REFERENCING OLD TABLE AS old_credits NEW TABLE AS new_credits
+    FOR EACH STATEMENT EXECUTE FUNCTION movie_credits_changed_batch();
+
+CREATE TRIGGER movie_credits_refresh_names_delete
+    AFTER DELETE ON movie_credits
+    REFERENCING OLD TABLE AS old_credits
+    FOR EACH STATEMENT EXECUTE FUNCTION movie_credits_changed_batch();
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_genres_name_trgm;
+DROP INDEX IF EXISTS idx_people_name_trgm;
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_people_name_trgm ON people USING GIN (lower(name) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_genres_name_trgm ON genres USING GIN (lower(name) gin_trgm_ops);